@@ -32,6 +32,15 @@ const (
 	// resource.
 	AnnotationKeyDescription = "kargo.akuity.io/description"
 
+	// AnnotationKeyBreakGlass is an annotation key that can be set on a
+	// Promotion resource to create it despite a freeze or a promotion window
+	// of the Project forbidding promotion to its Stage at that time. The value
+	// of the annotation should explain why breaking the glass is necessary.
+	// Only subjects permitted to break-glass Stages may set it. Users promoting
+	// through the Kargo API server do not set it directly, but provide the
+	// explanation with their request instead.
+	AnnotationKeyBreakGlass = "kargo.akuity.io/break-glass"
+
 	AnnotationValueTrue = "true"
 )
 
@@ -22,6 +22,8 @@ const (
 	AnnotationKeyEventFreightCommits         = "event.kargo.akuity.io/freight-commits"
 	AnnotationKeyEventFreightImages          = "event.kargo.akuity.io/freight-images"
 	AnnotationKeyEventFreightCharts          = "event.kargo.akuity.io/freight-charts"
+	AnnotationKeyEventFreightArtifacts       = "event.kargo.akuity.io/freight-artifacts"
+	AnnotationKeyEventFreightFiles           = "event.kargo.akuity.io/freight-files"
 	AnnotationKeyEventStageName              = "event.kargo.akuity.io/stage-name"
 	AnnotationKeyEventAnalysisRunName        = "event.kargo.akuity.io/analysis-run-name"
 	AnnotationKeyEventVerificationPending    = "event.kargo.akuity.io/verification-pending"
@@ -41,6 +43,7 @@ const (
 	EventReasonFreightVerificationAborted      = "FreightVerificationAborted"
 	EventReasonFreightVerificationInconclusive = "FreightVerificationInconclusive"
 	EventReasonFreightVerificationUnknown      = "FreightVerificationUnknown"
+	EventReasonRollbackTriggered               = "RollbackTriggered"
 )
 
 const (
@@ -150,6 +153,22 @@ func NewPromotionEventAnnotations(
 				annotations[AnnotationKeyEventFreightCharts] = string(data)
 			}
 		}
+		if len(f.Artifacts) > 0 {
+			data, err := json.Marshal(f.Artifacts)
+			if err != nil {
+				logger.Error(err, "marshal freight artifacts in JSON")
+			} else {
+				annotations[AnnotationKeyEventFreightArtifacts] = string(data)
+			}
+		}
+		if len(f.Files) > 0 {
+			data, err := json.Marshal(f.Files)
+			if err != nil {
+				logger.Error(err, "marshal freight files in JSON")
+			} else {
+				annotations[AnnotationKeyEventFreightFiles] = string(data)
+			}
+		}
 	}
 	return annotations
 }
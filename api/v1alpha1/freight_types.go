@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/helm"
@@ -44,6 +45,12 @@ type Freight struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,5,rep,name=charts"`
+	// Artifacts describes specific versions of specific generic artifacts
+	// stored in OCI registries.
+	Artifacts []OCIArtifact `json:"artifacts,omitempty" protobuf:"bytes,10,rep,name=artifacts"`
+	// Files describes specific versions of specific files served over HTTP/S
+	// or by S3-compatible object stores.
+	Files []File `json:"files,omitempty" protobuf:"bytes,11,rep,name=files"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
 }
@@ -55,7 +62,7 @@ func (f *Freight) GetStatus() *FreightStatus {
 // GenerateID deterministically calculates a piece of Freight's ID based on its
 // contents and returns it.
 func (f *Freight) GenerateID() string {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.Artifacts) + len(f.Files)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		if commit.Tag != "" {
@@ -100,6 +107,22 @@ func (f *Freight) GenerateID() string {
 			),
 		)
 	}
+	for _, artifact := range f.Artifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, both tag and digest are incorporated into the
+			// canonical representation of an artifact.
+			fmt.Sprintf("%s:%s@%s", artifact.RepoURL, artifact.Tag, artifact.Digest),
+		)
+	}
+	for _, file := range f.Files {
+		artifacts = append(
+			artifacts,
+			// The ETag is incorporated into the canonical representation of a
+			// file because the content at a given URL may change over time.
+			fmt.Sprintf("%s@%s", file.URL, file.ETag),
+		)
+	}
 	slices.Sort(artifacts)
 	return fmt.Sprintf(
 		"%x",
@@ -136,6 +159,9 @@ type GitCommit struct {
 	Author string `json:"author,omitempty" protobuf:"bytes,7,opt,name=author"`
 	// Committer is the person who committed the commit.
 	Committer string `json:"committer,omitempty" protobuf:"bytes,8,opt,name=committer"`
+	// PullRequest references the pull request whose head is this commit, if
+	// the commit was discovered as the head of a pull request.
+	PullRequest *PullRequestReference `json:"pullRequest,omitempty" protobuf:"bytes,9,opt,name=pullRequest"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -154,7 +180,8 @@ func (g *GitCommit) DeepEquals(other *GitCommit) bool {
 		g.HealthCheckCommit == other.HealthCheckCommit &&
 		g.Message == other.Message &&
 		g.Author == other.Author &&
-		g.Committer == other.Committer
+		g.Committer == other.Committer &&
+		ptr.Equal(g.PullRequest, other.PullRequest)
 }
 
 // Equals returns a bool indicating whether two GitCommits are equivalent.
@@ -179,6 +206,11 @@ type FreightStatus struct {
 	// might wish to promote a piece of Freight to a given Stage without
 	// transiting the entire pipeline.
 	ApprovedFor map[string]ApprovedStage `json:"approvedFor,omitempty" protobuf:"bytes,2,rep,name=approvedFor" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// RolledBackFrom describes the Stages from which this Freight has been
+	// automatically rolled back after failing verification. Freight will not
+	// be automatically promoted to any of these Stages again unless it has
+	// been explicitly approved for them.
+	RolledBackFrom map[string]RolledBackStage `json:"rolledBackFrom,omitempty" protobuf:"bytes,3,rep,name=rolledBackFrom" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // VerifiedStage describes a Stage in which Freight has been verified.
@@ -188,6 +220,10 @@ type VerifiedStage struct{}
 // approved.
 type ApprovedStage struct{}
 
+// RolledBackStage describes a Stage from which Freight has been automatically
+// rolled back.
+type RolledBackStage struct{}
+
 // +kubebuilder:object:root=true
 
 // FreightList is a list of Freight resources.
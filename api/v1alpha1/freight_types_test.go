@@ -133,6 +133,19 @@ func TestGitCommitDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "pull requests differ",
+			a: &GitCommit{
+				RepoURL:     "fake-url",
+				ID:          "fake-commit-id",
+				PullRequest: &PullRequestReference{Number: 1},
+			},
+			b: &GitCommit{
+				RepoURL: "fake-url",
+				ID:      "fake-commit-id",
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &GitCommit{
@@ -144,6 +157,7 @@ func TestGitCommitDeepEquals(t *testing.T) {
 				Message:           "fake-message",
 				Author:            "fake-author",
 				Committer:         "fake-committer",
+				PullRequest:       &PullRequestReference{Number: 1, URL: "fake-pr-url"},
 			},
 			b: &GitCommit{
 				RepoURL:           "fake-url",
@@ -154,6 +168,7 @@ func TestGitCommitDeepEquals(t *testing.T) {
 				Message:           "fake-message",
 				Author:            "fake-author",
 				Committer:         "fake-committer",
+				PullRequest:       &PullRequestReference{Number: 1, URL: "fake-pr-url"},
 			},
 			expectedResult: true,
 		},
@@ -261,6 +276,21 @@ func TestFreightGenerateID(t *testing.T) {
 				Version: "fake-chart-version",
 			},
 		},
+		Artifacts: []OCIArtifact{
+			{
+				RepoURL: "fake-artifact-repo",
+				Tag:     "fake-artifact-tag",
+				Digest:  "fake-artifact-digest",
+			},
+		},
+		Files: []File{
+			{
+				RepoURL: "s3://fake-bucket/",
+				URL:     "s3://fake-bucket/fake-file-v1.0.0.tgz",
+				Version: "v1.0.0",
+				ETag:    "fake-etag",
+			},
+		},
 	}
 	id := freight.GenerateID()
 	expected := id
@@ -271,4 +301,10 @@ func TestFreightGenerateID(t *testing.T) {
 	// Changing anything should change the result
 	freight.Commits[0].ID = "a-different-fake-commit"
 	require.NotEqual(t, expected, freight.GenerateID())
+	expected = freight.GenerateID()
+	freight.Artifacts[0].Digest = "a-different-fake-artifact-digest"
+	require.NotEqual(t, expected, freight.GenerateID())
+	expected = freight.GenerateID()
+	freight.Files[0].ETag = "a-different-fake-etag"
+	require.NotEqual(t, expected, freight.GenerateID())
 }
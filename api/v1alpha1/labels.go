@@ -5,9 +5,13 @@ const (
 
 	// Credentials
 	CredentialTypeLabelKey        = "kargo.akuity.io/cred-type" // nolint: gosec
+	CredentialTypeLabelValueFile  = "file"
 	CredentialTypeLabelValueGit   = "git"
 	CredentialTypeLabelValueHelm  = "helm"
 	CredentialTypeLabelValueImage = "image"
+	// CredentialTypeLabelValueGitSigningKey labels Secrets containing keys
+	// with which Promotion steps may sign Git commits.
+	CredentialTypeLabelValueGitSigningKey = "git-signing-key" // nolint: gosec
 
 	// Kargo core API
 	FreightCollectionLabelKey = "kargo.akuity.io/freight-collection"
@@ -15,6 +19,10 @@ const (
 	PromotionLabelKey         = "kargo.akuity.io/promotion"
 	ShardLabelKey             = "kargo.akuity.io/shard"
 	StageLabelKey             = "kargo.akuity.io/stage"
+	// RollbackOfLabelKey labels Promotions created to roll a Stage back from a
+	// FreightCollection that failed verification with the ID of that
+	// FreightCollection.
+	RollbackOfLabelKey = "kargo.akuity.io/rollback-of"
 
 	// AnalysisRunTemplate labels
 	AnalysisRunTemplateLabelKey         = "kargo.akuity.io/analysis-run-template"
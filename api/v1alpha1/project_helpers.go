@@ -3,7 +3,9 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -29,3 +31,110 @@ func GetProject(
 	}
 	return &project, nil
 }
+
+// GetPromotionPolicy returns a pointer to the PromotionPolicy of the Project
+// for the specified Stage. If no such PromotionPolicy exists, nil is returned
+// instead.
+func (p *Project) GetPromotionPolicy(stage string) *PromotionPolicy {
+	if p.Spec == nil {
+		return nil
+	}
+	for i := range p.Spec.PromotionPolicies {
+		if p.Spec.PromotionPolicies[i].Stage == stage {
+			return &p.Spec.PromotionPolicies[i]
+		}
+	}
+	return nil
+}
+
+// IsPromotionPermitted returns a boolean indicating whether the
+// PromotionPolicy's Windows and Freezes permit promotion at the provided time.
+// If promotion is not permitted, a human-readable explanation is returned as
+// well. An error is returned if any of the Windows is invalid.
+func (p *PromotionPolicy) IsPromotionPermitted(t time.Time) (bool, string, error) {
+	for _, freeze := range p.Freezes {
+		if freeze.IsActive(t) {
+			reason := fmt.Sprintf(
+				"promotions are frozen from %s until %s",
+				freeze.Start.Format(time.RFC3339),
+				freeze.End.Format(time.RFC3339),
+			)
+			if freeze.Reason != "" {
+				reason = fmt.Sprintf("%s: %s", reason, freeze.Reason)
+			}
+			return false, reason, nil
+		}
+	}
+	var hasAllowWindows, inAllowWindow bool
+	for _, window := range p.Windows {
+		active, err := window.IsActive(t)
+		if err != nil {
+			return false, "", err
+		}
+		switch window.Kind {
+		case PromotionWindowKindDeny:
+			if active {
+				return false, fmt.Sprintf(
+					"promotions are denied by window with schedule %q", window.Schedule,
+				), nil
+			}
+		case PromotionWindowKindAllow:
+			hasAllowWindows = true
+			inAllowWindow = inAllowWindow || active
+		}
+	}
+	if hasAllowWindows && !inAllowWindow {
+		return false, "promotions are only permitted during allowed windows", nil
+	}
+	return true, "", nil
+}
+
+// IsActive returns a boolean indicating whether the PromotionWindow is open at
+// the provided time. A window is open at a given time if it last opened no
+// longer than its Duration before that time. An error is returned if the
+// Schedule or TimeZone is invalid.
+func (w *PromotionWindow) IsActive(t time.Time) (bool, error) {
+	schedule, err := w.parseSchedule()
+	if err != nil {
+		return false, err
+	}
+	// The window is open if it opened within the last Duration. Next returns
+	// the first activation strictly after the time it is passed.
+	opened := schedule.Next(t.Add(-w.Duration.Duration))
+	return !opened.IsZero() && !opened.After(t), nil
+}
+
+// Validate returns an error if the PromotionWindow's Schedule or TimeZone is
+// invalid.
+func (w *PromotionWindow) Validate() error {
+	_, err := w.parseSchedule()
+	return err
+}
+
+func (w *PromotionWindow) parseSchedule() (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", w.Schedule, err)
+	}
+	specSchedule, ok := schedule.(*cron.SpecSchedule)
+	if !ok {
+		return schedule, nil
+	}
+	switch {
+	case w.TimeZone != "":
+		if specSchedule.Location, err = time.LoadLocation(w.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", w.TimeZone, err)
+		}
+	case specSchedule.Location == time.Local:
+		// Schedules are interpreted in UTC rather than in the local time zone of
+		// whichever component happens to be evaluating them.
+		specSchedule.Location = time.UTC
+	}
+	return schedule, nil
+}
+
+// IsActive returns a boolean indicating whether the PromotionFreeze is in
+// effect at the provided time.
+func (f *PromotionFreeze) IsActive(t time.Time) bool {
+	return !t.Before(f.Start.Time) && t.Before(f.End.Time)
+}
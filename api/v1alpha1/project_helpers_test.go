@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -56,3 +57,153 @@ func TestGetProject(t *testing.T) {
 		})
 	}
 }
+
+func TestProject_GetPromotionPolicy(t *testing.T) {
+	project := &Project{
+		Spec: &ProjectSpec{
+			PromotionPolicies: []PromotionPolicy{
+				{Stage: "fake-stage", AutoPromotionEnabled: true},
+			},
+		},
+	}
+	policy := project.GetPromotionPolicy("fake-stage")
+	require.NotNil(t, policy)
+	require.True(t, policy.AutoPromotionEnabled)
+	require.Nil(t, project.GetPromotionPolicy("other-stage"))
+	require.Nil(t, (&Project{}).GetPromotionPolicy("fake-stage"))
+}
+
+func TestPromotionPolicy_IsPromotionPermitted(t *testing.T) {
+	// A Friday afternoon
+	testNow := time.Date(2024, time.October, 18, 17, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		policy     PromotionPolicy
+		assertions func(*testing.T, bool, string, error)
+	}{
+		{
+			name:   "no windows or freezes",
+			policy: PromotionPolicy{},
+			assertions: func(t *testing.T, permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+			},
+		},
+		{
+			name: "frozen",
+			policy: PromotionPolicy{
+				Freezes: []PromotionFreeze{{
+					Start:  metav1.NewTime(testNow.Add(-time.Hour)),
+					End:    metav1.NewTime(testNow.Add(time.Hour)),
+					Reason: "release",
+				}},
+			},
+			assertions: func(t *testing.T, permitted bool, reason string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+				require.Contains(t, reason, "promotions are frozen")
+				require.Contains(t, reason, "release")
+			},
+		},
+		{
+			name: "freeze has ended",
+			policy: PromotionPolicy{
+				Freezes: []PromotionFreeze{{
+					Start: metav1.NewTime(testNow.Add(-2 * time.Hour)),
+					End:   metav1.NewTime(testNow),
+				}},
+			},
+			assertions: func(t *testing.T, permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+			},
+		},
+		{
+			name: "deny window is open",
+			policy: PromotionPolicy{
+				Windows: []PromotionWindow{{
+					Kind:     PromotionWindowKindDeny,
+					Schedule: "0 16 * * 5",
+					Duration: metav1.Duration{Duration: 64 * time.Hour},
+				}},
+			},
+			assertions: func(t *testing.T, permitted bool, reason string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+				require.Contains(t, reason, "denied by window")
+			},
+		},
+		{
+			name: "deny window is open in another time zone",
+			policy: PromotionPolicy{
+				Windows: []PromotionWindow{{
+					Kind:     PromotionWindowKindDeny,
+					Schedule: "0 18 * * 5",
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+					// 17:00 UTC is 19:00 in Berlin during daylight saving time
+					TimeZone: "Europe/Berlin",
+				}},
+			},
+			assertions: func(t *testing.T, permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+			},
+		},
+		{
+			name: "outside of allow windows",
+			policy: PromotionPolicy{
+				Windows: []PromotionWindow{
+					{
+						Kind:     PromotionWindowKindAllow,
+						Schedule: "0 8 * * 1-5",
+						Duration: metav1.Duration{Duration: 8 * time.Hour},
+					},
+				},
+			},
+			assertions: func(t *testing.T, permitted bool, reason string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+				require.Contains(t, reason, "only permitted during allowed windows")
+			},
+		},
+		{
+			name: "inside of an allow window",
+			policy: PromotionPolicy{
+				Windows: []PromotionWindow{
+					{
+						Kind:     PromotionWindowKindAllow,
+						Schedule: "0 8 * * 1-5",
+						Duration: metav1.Duration{Duration: 8 * time.Hour},
+					},
+					{
+						Kind:     PromotionWindowKindAllow,
+						Schedule: "0 16 * * 5",
+						Duration: metav1.Duration{Duration: 2 * time.Hour},
+					},
+				},
+			},
+			assertions: func(t *testing.T, permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+			},
+		},
+		{
+			name: "invalid window",
+			policy: PromotionPolicy{
+				Windows: []PromotionWindow{{
+					Kind:     PromotionWindowKindAllow,
+					Schedule: "every friday",
+				}},
+			},
+			assertions: func(t *testing.T, _ bool, _ string, err error) {
+				require.ErrorContains(t, err, "invalid schedule")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			permitted, reason, err := testCase.policy.IsPromotionPermitted(testNow)
+			testCase.assertions(t, permitted, reason, err)
+		})
+	}
+}
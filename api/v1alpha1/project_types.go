@@ -69,6 +69,72 @@ type PromotionPolicy struct {
 	// users to define Stages that are automatically updated as soon as new
 	// artifacts are detected.
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty" protobuf:"varint,2,opt,name=autoPromotionEnabled"`
+	// Windows restricts the times at which Freight may be promoted into the
+	// Stage referenced by the Stage field. When any Allow windows are specified,
+	// promotion is only permitted while at least one of them is open. Promotion
+	// is never permitted while a Deny window is open. Windows apply to automatic
+	// as well as manual promotions.
+	Windows []PromotionWindow `json:"windows,omitempty" protobuf:"bytes,3,rep,name=windows"`
+	// Freezes lists absolute periods of time during which Freight may not be
+	// promoted into the Stage referenced by the Stage field, e.g. a holiday
+	// freeze. Freezes apply to automatic as well as manual promotions.
+	Freezes []PromotionFreeze `json:"freezes,omitempty" protobuf:"bytes,4,rep,name=freezes"`
+}
+
+// PromotionWindowKind is the kind of a PromotionWindow.
+//
+// +kubebuilder:validation:Enum=Allow;Deny
+type PromotionWindowKind string
+
+const (
+	// PromotionWindowKindAllow denotes a PromotionWindow during which promotion
+	// is permitted.
+	PromotionWindowKindAllow PromotionWindowKind = "Allow"
+	// PromotionWindowKindDeny denotes a PromotionWindow during which promotion is
+	// not permitted.
+	PromotionWindowKindDeny PromotionWindowKind = "Deny"
+)
+
+// PromotionWindow describes a recurring period of time during which promotion
+// is either permitted or denied.
+type PromotionWindow struct {
+	// Kind indicates whether promotion is permitted or denied while the window
+	// is open.
+	//
+	// +kubebuilder:validation:Required
+	Kind PromotionWindowKind `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Schedule is a cron expression in the standard five-field format that
+	// describes when the window opens. e.g. "0 16 * * 5" opens the window every
+	// Friday at 16:00.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is the amount of time the window remains open after each time it
+	// opens. e.g. "64h" keeps a window opening on Friday at 16:00 open until
+	// Monday at 08:00.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// TimeZone is the name of the IANA time zone the Schedule is interpreted in,
+	// e.g. "Europe/Berlin". When left unspecified, the Schedule is interpreted
+	// in UTC.
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+}
+
+// PromotionFreeze describes an absolute period of time during which promotion
+// is not permitted.
+type PromotionFreeze struct {
+	// Start is the time at which the freeze begins.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the time at which the freeze ends.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Reason is an optional, human-readable explanation of the freeze.
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
 }
 
 // ProjectStatus describes a Project's current status.
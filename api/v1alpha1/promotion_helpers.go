@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -116,3 +117,18 @@ func ComparePromotionPhase(a, b PromotionPhase) int {
 		return 0
 	}
 }
+
+// GetConfig returns the PromotionStep's Config as a map. If the PromotionStep
+// has no Config, nil is returned.
+func (s *PromotionStep) GetConfig() (map[string]any, error) {
+	if s.Config == nil || len(s.Config.Raw) == 0 {
+		return nil, nil
+	}
+	var cfg map[string]any
+	if err := json.Unmarshal(s.Config.Raw, &cfg); err != nil {
+		return nil, fmt.Errorf(
+			"error unmarshaling config of step %q: %w", s.Uses, err,
+		)
+	}
+	return cfg, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -285,3 +286,47 @@ func TestComparePromotionPhase(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotionStep_GetConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		step       PromotionStep
+		assertions func(*testing.T, map[string]any, error)
+	}{
+		{
+			name: "nil config",
+			step: PromotionStep{Uses: "fake-directive"},
+			assertions: func(t *testing.T, cfg map[string]any, err error) {
+				require.NoError(t, err)
+				require.Nil(t, cfg)
+			},
+		},
+		{
+			name: "invalid config",
+			step: PromotionStep{
+				Uses:   "fake-directive",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`["not", "an", "object"]`)},
+			},
+			assertions: func(t *testing.T, _ map[string]any, err error) {
+				require.ErrorContains(t, err, "error unmarshaling config of step")
+			},
+		},
+		{
+			name: "valid config",
+			step: PromotionStep{
+				Uses:   "fake-directive",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":"src","count":1}`)},
+			},
+			assertions: func(t *testing.T, cfg map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]any{"path": "src", "count": float64(1)}, cfg)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := tt.step.GetConfig()
+			tt.assertions(t, cfg, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -81,6 +82,146 @@ type PromotionSpec struct {
 	//
 	// +kubebuilder:validation:MinLength=1
 	Freight string `json:"freight" protobuf:"bytes,2,opt,name=freight"`
+	// Steps specifies the directives to be executed as part of this Promotion.
+	// The Steps are copied from the Stage referenced by the Stage field at the
+	// time the Promotion is created. When empty, the Stage's
+	// PromotionMechanisms are used instead.
+	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,3,rep,name=steps"`
+	// Vars is a list of variables that may be referenced by expressions in the
+	// configuration of the Steps. The Vars are copied from the Stage referenced
+	// by the Stage field at the time the Promotion is created.
+	Vars []PromotionVariable `json:"vars,omitempty" protobuf:"bytes,4,rep,name=vars"`
+	// PromotionTemplate references a PromotionTemplate whose steps are to be
+	// executed as part of this Promotion. The reference is copied from the
+	// Stage referenced by the Stage field at the time the Promotion is created.
+	PromotionTemplate *PromotionTemplateReference `json:"promotionTemplate,omitempty" protobuf:"bytes,5,opt,name=promotionTemplate"`
+	// DryRun indicates that this Promotion should only determine the changes
+	// it would make without committing, pushing or applying any of them. The
+	// changes are recorded in the Plan field of the Promotion's status. A
+	// dry-run Promotion does not wait for, nor hold up, other Promotions to the
+	// same Stage, and it never affects the state of the Stage.
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,6,opt,name=dryRun"`
+}
+
+// PromotionStep describes a directive to be executed as part of a Promotion,
+// or a group of directives to be executed concurrently.
+type PromotionStep struct {
+	// Uses identifies the directive that should execute this step. This field
+	// is mutually exclusive with the Parallel field, and exactly one of the two
+	// must be specified.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Uses string `json:"uses,omitempty" protobuf:"bytes,1,opt,name=uses"`
+	// As is an optional alias for the step. The output of a step with an alias
+	// is made available to subsequent steps under that alias. Expressions must
+	// use the index function to refer to the output of a step with an alias
+	// that contains hyphens (e.g. ${{ index .outputs "my-step" }}).
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	As string `json:"as,omitempty" protobuf:"bytes,2,opt,name=as"`
+	// Config is opaque configuration for the directive. Its structure is
+	// specific to the directive referenced by the Uses field.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,3,opt,name=config"`
+	// Timeout is the maximum amount of time a single attempt at executing the
+	// step may take. When left unspecified, attempts are not limited in
+	// duration.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+	// Retry describes how failed attempts at executing the step are retried.
+	// When left unspecified, failed attempts are not retried.
+	Retry *PromotionStepRetry `json:"retry,omitempty" protobuf:"bytes,5,opt,name=retry"`
+	// ContinueOnError indicates whether the Promotion should continue with the
+	// execution of subsequent steps when this step has failed.
+	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,6,opt,name=continueOnError"`
+	// Parallel describes a group of steps to be executed concurrently in lieu
+	// of a single directive. Each step of the group is executed against its own
+	// copy of the outputs of the preceding steps, and the outputs of the steps of
+	// the group are made available to subsequent steps under their aliases once
+	// all of them have completed. When specified, the ContinueOnError field
+	// applies to the failure of any of the steps of the group and all other
+	// fields must be left unspecified.
+	Parallel *PromotionStepGroup `json:"parallel,omitempty" protobuf:"bytes,7,opt,name=parallel"`
+}
+
+// PromotionStepGroup describes a group of steps to be executed concurrently.
+type PromotionStepGroup struct {
+	// MaxConcurrency is the maximum number of steps of the group that may be
+	// executed concurrently. This field is optional. When left unspecified, all
+	// steps of the group are executed concurrently.
+	//
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrency int64 `json:"maxConcurrency,omitempty" protobuf:"varint,1,opt,name=maxConcurrency"`
+	// Steps describes the steps of the group.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Steps []PromotionParallelStep `json:"steps" protobuf:"bytes,2,rep,name=steps"`
+}
+
+// PromotionParallelStep describes a directive to be executed as part of a
+// PromotionStepGroup.
+type PromotionParallelStep struct {
+	// Uses identifies the directive that should execute this step.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Uses string `json:"uses" protobuf:"bytes,1,opt,name=uses"`
+	// As is an optional alias for the step. The output of a step with an alias
+	// is made available to subsequent steps under that alias. Expressions must
+	// use the index function to refer to the output of a step with an alias
+	// that contains hyphens (e.g. ${{ index .outputs "my-step" }}).
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	As string `json:"as,omitempty" protobuf:"bytes,2,opt,name=as"`
+	// Config is opaque configuration for the directive. Its structure is
+	// specific to the directive referenced by the Uses field.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,3,opt,name=config"`
+	// Timeout is the maximum amount of time a single attempt at executing the
+	// step may take. When left unspecified, attempts are not limited in
+	// duration.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+	// Retry describes how failed attempts at executing the step are retried.
+	// When left unspecified, failed attempts are not retried.
+	Retry *PromotionStepRetry `json:"retry,omitempty" protobuf:"bytes,5,opt,name=retry"`
+	// ContinueOnError indicates whether the failure of this step should be
+	// ignored when determining whether the group it is part of has failed.
+	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,6,opt,name=continueOnError"`
+}
+
+// PromotionStepRetry describes how failed attempts at executing a
+// PromotionStep are retried.
+type PromotionStepRetry struct {
+	// Limit is the maximum number of times a failed attempt at executing the
+	// step is retried.
+	//
+	// +kubebuilder:validation:Minimum=0
+	Limit int64 `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+	// Backoff is the amount of time to wait before the first retry. It is
+	// doubled for every subsequent retry. This field is optional. When left
+	// unspecified, the field is implicitly treated as if its value were "10s".
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	Backoff *metav1.Duration `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// PromotionVariable describes a single variable that may be referenced by
+// expressions in the configuration of a PromotionStep.
+type PromotionVariable struct {
+	// Name is the name of the variable.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^[a-zA-Z_][a-zA-Z0-9_]*$
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the variable. It may itself contain expressions
+	// referencing the context of the Promotion or previously declared
+	// variables.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
 }
 
 // PromotionStatus describes the current state of the transition represented by
@@ -109,6 +250,103 @@ type PromotionStatus struct {
 	FreightCollection *FreightCollection `json:"freightCollection,omitempty" protobuf:"bytes,7,opt,name=freightCollection"`
 	// FinishedAt is the time when the promotion was completed.
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+	// Steps records the outcome of each of the Promotion's steps that has been
+	// executed. This field is only populated for Promotions that specify Steps.
+	Steps []PromotionStepStatus `json:"steps,omitempty" protobuf:"bytes,8,rep,name=steps"`
+	// CurrentStep is the index of the step that is currently being executed.
+	// When a Promotion is waiting on a step that has not yet completed, its
+	// execution is resumed from this step on subsequent reconciliations.
+	CurrentStep int64 `json:"currentStep,omitempty" protobuf:"varint,9,opt,name=currentStep"`
+	// ExpandedTemplate holds the steps of the PromotionTemplate referenced by
+	// the Promotion, and the variables they are executed with, as they were
+	// when the Promotion started. The steps are executed from this snapshot
+	// rather than from the PromotionTemplate, so that changes made to the
+	// PromotionTemplate while the Promotion is running do not affect it.
+	ExpandedTemplate *ExpandedPromotionTemplate `json:"expandedTemplate,omitempty" protobuf:"bytes,12,opt,name=expandedTemplate"`
+	// State holds the state shared between the Promotion's steps, as
+	// accumulated by the steps executed so far. It is used to restore the
+	// shared state when the execution of the steps is resumed.
+	State *apiextensionsv1.JSON `json:"state,omitempty" protobuf:"bytes,10,opt,name=state"`
+	// Plan describes the changes a dry-run Promotion would have made. This
+	// field is only populated for Promotions with the DryRun field set.
+	Plan *PromotionPlan `json:"plan,omitempty" protobuf:"bytes,11,opt,name=plan"`
+}
+
+// ExpandedPromotionTemplate describes the steps of a PromotionTemplate along
+// with the variables they are executed with on behalf of a Promotion.
+type ExpandedPromotionTemplate struct {
+	// Steps are the steps of the PromotionTemplate.
+	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// Vars are the variables of the Promotion, followed by the parameters of
+	// the PromotionTemplate.
+	Vars []PromotionVariable `json:"vars,omitempty" protobuf:"bytes,2,rep,name=vars"`
+}
+
+// PromotionPlan describes the changes a dry-run Promotion would have made.
+type PromotionPlan struct {
+	// GitRepoChanges describes the changes that would have been committed to
+	// Git repositories.
+	GitRepoChanges []GitRepoChange `json:"gitRepoChanges,omitempty" protobuf:"bytes,1,rep,name=gitRepoChanges"`
+	// ArgoCDAppChanges describes the changes that would have been made to Argo
+	// CD Applications.
+	ArgoCDAppChanges []ArgoCDAppChange `json:"argoCDAppChanges,omitempty" protobuf:"bytes,2,rep,name=argoCDAppChanges"`
+}
+
+// GitRepoChange describes the changes that would have been committed to a
+// Git repository.
+type GitRepoChange struct {
+	// RepoURL is the URL of the repository.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Branch is the branch the changes would have been committed to.
+	Branch string `json:"branch,omitempty" protobuf:"bytes,2,opt,name=branch"`
+	// Diff is a unified diff of the changes.
+	Diff string `json:"diff,omitempty" protobuf:"bytes,3,opt,name=diff"`
+}
+
+// ArgoCDAppChange describes the changes that would have been made to an Argo
+// CD Application.
+type ArgoCDAppChange struct {
+	// AppNamespace is the namespace of the Application.
+	AppNamespace string `json:"appNamespace,omitempty" protobuf:"bytes,1,opt,name=appNamespace"`
+	// AppName is the name of the Application.
+	AppName string `json:"appName" protobuf:"bytes,2,opt,name=appName"`
+	// Patch is a JSON merge patch of the Application's spec that would have
+	// been applied.
+	Patch string `json:"patch,omitempty" protobuf:"bytes,3,opt,name=patch"`
+}
+
+type PromotionStepResult string
+
+const (
+	// PromotionStepResultSuccess denotes a step that was executed successfully.
+	PromotionStepResultSuccess PromotionStepResult = "Success"
+	// PromotionStepResultFailure denotes a step that failed to execute.
+	PromotionStepResultFailure PromotionStepResult = "Failure"
+	// PromotionStepResultPending denotes a step that is waiting on some
+	// external state (such as an open PR being merged or closed) before it can
+	// complete.
+	PromotionStepResultPending PromotionStepResult = "Pending"
+)
+
+// PromotionStepStatus describes the outcome of the execution of a single
+// PromotionStep.
+type PromotionStepStatus struct {
+	// Uses is the name of the directive that executed the step.
+	Uses string `json:"uses" protobuf:"bytes,1,opt,name=uses"`
+	// As is the alias of the step, if it has one.
+	As string `json:"as,omitempty" protobuf:"bytes,2,opt,name=as"`
+	// Result is the high-level outcome of the step's execution.
+	Result PromotionStepResult `json:"result" protobuf:"bytes,3,opt,name=result"`
+	// Message is a display message about the step's execution. If the Result
+	// field has a value of Failure, this field can be expected to explain why.
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Output is the output of the step's execution, if any.
+	Output *apiextensionsv1.JSON `json:"output,omitempty" protobuf:"bytes,5,opt,name=output"`
+	// Attempts is the number of times the execution of the step was attempted.
+	Attempts int64 `json:"attempts,omitempty" protobuf:"varint,6,opt,name=attempts"`
+	// NextAttempt is the time at which the step is to be attempted again, if
+	// its last attempt failed and it is to be retried.
+	NextAttempt *metav1.Time `json:"nextAttempt,omitempty" protobuf:"bytes,7,opt,name=nextAttempt"`
 }
 
 // WithPhase returns a copy of PromotionStatus with the given phase
@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetPromotionTemplate returns a pointer to the PromotionTemplate resource
+// specified by the namespacedName argument. If no such resource is found, nil
+// is returned instead.
+func GetPromotionTemplate(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) (*PromotionTemplate, error) {
+	template := PromotionTemplate{}
+	if err := c.Get(ctx, namespacedName, &template); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting PromotionTemplate %q in namespace %q: %w",
+			namespacedName.Name,
+			namespacedName.Namespace,
+			err,
+		)
+	}
+	return &template, nil
+}
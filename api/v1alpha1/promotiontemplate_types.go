@@ -0,0 +1,75 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PromotionTemplate is a reusable sequence of PromotionSteps that can be
+// referenced by any number of Stages within the same Project.
+type PromotionTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec describes the parameters and steps of the PromotionTemplate.
+	//
+	// +kubebuilder:validation:Required
+	Spec PromotionTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// PromotionTemplateSpec describes the parameters and steps of a
+// PromotionTemplate.
+type PromotionTemplateSpec struct {
+	// Parameters declares the parameters that Stages referencing the
+	// PromotionTemplate may pass to it. The value of each parameter is made
+	// available to expressions in the configuration of the Steps as a variable
+	// of the same name (e.g. ${{ .vars.branch }}).
+	Parameters []PromotionTemplateParameter `json:"parameters,omitempty" protobuf:"bytes,1,rep,name=parameters"`
+	// Steps describes the sequence of directives to execute in order to
+	// incorporate Freight into a Stage referencing the PromotionTemplate.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Steps []PromotionStep `json:"steps" protobuf:"bytes,2,rep,name=steps"`
+}
+
+// PromotionTemplateParameter describes a single parameter of a
+// PromotionTemplate.
+type PromotionTemplateParameter struct {
+	// Name is the name of the parameter.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^[a-zA-Z_][a-zA-Z0-9_]*$
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Description is an optional, human-readable description of the parameter.
+	Description string `json:"description,omitempty" protobuf:"bytes,2,opt,name=description"`
+	// Required indicates whether Stages referencing the PromotionTemplate must
+	// specify a value for the parameter. Required parameters may not have a
+	// Default.
+	Required bool `json:"required,omitempty" protobuf:"varint,3,opt,name=required"`
+	// Default is the value of the parameter when a Stage referencing the
+	// PromotionTemplate does not specify one.
+	Default string `json:"default,omitempty" protobuf:"bytes,4,opt,name=default"`
+}
+
+// PromotionTemplateReference is a reference to a PromotionTemplate in the same
+// namespace as the referencing resource, along with the values of the
+// PromotionTemplate's parameters.
+type PromotionTemplateReference struct {
+	// Name is the name of the PromotionTemplate.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Parameters specifies values for the parameters declared by the
+	// PromotionTemplate. Like variables, the values may contain expressions,
+	// which may also refer to the variables of the referencing resource.
+	Parameters []PromotionVariable `json:"parameters,omitempty" protobuf:"bytes,2,rep,name=parameters"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionTemplateList contains a list of PromotionTemplates.
+type PromotionTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []PromotionTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
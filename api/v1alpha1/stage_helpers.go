@@ -74,6 +74,16 @@ func (r *VerificationRequest) String() string {
 	return string(b)
 }
 
+// IsControlFlow returns true if the Stage has neither PromotionMechanisms,
+// Steps nor a PromotionTemplate, i.e. it does not perform any actions to
+// incorporate Freight and only serves to aggregate Freight from upstream
+// Stages.
+func (s *Stage) IsControlFlow() bool {
+	return s.Spec.PromotionMechanisms == nil &&
+		len(s.Spec.Steps) == 0 &&
+		s.Spec.PromotionTemplate == nil
+}
+
 // GetStage returns a pointer to the Stage resource specified by the
 // namespacedName argument. If no such resource is found, nil is returned
 // instead.
@@ -12,6 +12,54 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+func TestStage_IsControlFlow(t *testing.T) {
+	tests := []struct {
+		name     string
+		stage    *Stage
+		expected bool
+	}{
+		{
+			name:     "no promotion mechanisms or steps",
+			stage:    &Stage{},
+			expected: true,
+		},
+		{
+			name: "promotion mechanisms",
+			stage: &Stage{
+				Spec: StageSpec{
+					PromotionMechanisms: &PromotionMechanisms{},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "steps",
+			stage: &Stage{
+				Spec: StageSpec{
+					Steps: []PromotionStep{{Uses: "fake-directive"}},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "promotion template",
+			stage: &Stage{
+				Spec: StageSpec{
+					PromotionTemplate: &PromotionTemplateReference{
+						Name: "fake-template",
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.stage.IsControlFlow())
+		})
+	}
+}
+
 func TestVerificationRequest_Equals(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -174,9 +174,41 @@ type StageSpec struct {
 	// single upstream Stage where they may otherwise have subscribed to multiple
 	// upstream Stages.
 	PromotionMechanisms *PromotionMechanisms `json:"promotionMechanisms,omitempty" protobuf:"bytes,2,opt,name=promotionMechanisms"`
+	// Steps describes a sequence of directives to execute in order to
+	// incorporate Freight into the Stage. This is an optional field and is
+	// mutually exclusive with the PromotionMechanisms and PromotionTemplate
+	// fields. When specified, the Steps are copied into every Promotion created
+	// for the Stage and are executed by the Promotion controller.
+	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,6,rep,name=steps"`
+	// PromotionTemplate references a PromotionTemplate in the Stage's namespace
+	// whose steps should be executed in order to incorporate Freight into the
+	// Stage. This is an optional field and is mutually exclusive with the
+	// PromotionMechanisms and Steps fields. The reference is copied into every
+	// Promotion created for the Stage and the PromotionTemplate is expanded by
+	// the Promotion controller at the time the Promotion is executed.
+	PromotionTemplate *PromotionTemplateReference `json:"promotionTemplate,omitempty" protobuf:"bytes,8,opt,name=promotionTemplate"`
+	// Vars is a list of variables that may be referenced by expressions in the
+	// configuration of the Steps. Like the Steps, they are copied into every
+	// Promotion created for the Stage.
+	Vars []PromotionVariable `json:"vars,omitempty" protobuf:"bytes,7,rep,name=vars"`
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// RollbackPolicy describes how the Stage should react to its current
+	// Freight failing verification. This is an optional field. When not
+	// specified, Freight that fails verification remains in the Stage.
+	RollbackPolicy *RollbackPolicy `json:"rollbackPolicy,omitempty" protobuf:"bytes,9,opt,name=rollbackPolicy"`
+}
+
+// RollbackPolicy describes how a Stage should react to its current Freight
+// failing verification.
+type RollbackPolicy struct {
+	// Enabled indicates whether a Stage should automatically be rolled back to
+	// the most recent Freight that was successfully verified in it when its
+	// current Freight fails verification. Freight that was rolled back from a
+	// Stage will not be automatically promoted to that Stage again until it has
+	// been explicitly (re-)approved for it.
+	Enabled bool `json:"enabled,omitempty" protobuf:"varint,1,opt,name=enabled"`
 }
 
 // FreightRequest expresses a Stage's need for Freight having originated from a
@@ -321,6 +353,8 @@ type PullRequestPromotionMechanism struct {
 	GitHub *GitHubPullRequest `json:"github,omitempty" protobuf:"bytes,1,opt,name=github"`
 	// GitLab indicates git provider is GitLab
 	GitLab *GitLabPullRequest `json:"gitlab,omitempty" protobuf:"bytes,2,opt,name=gitlab"`
+	// Bitbucket indicates git provider is Bitbucket (Cloud or Data Center)
+	Bitbucket *BitbucketPullRequest `json:"bitbucket,omitempty" protobuf:"bytes,3,opt,name=bitbucket"`
 }
 
 type GitHubPullRequest struct {
@@ -329,6 +363,9 @@ type GitHubPullRequest struct {
 type GitLabPullRequest struct {
 }
 
+type BitbucketPullRequest struct {
+}
+
 // KargoRenderPromotionMechanism describes how to use Kargo Render to
 // incorporate Freight into a Stage.
 type KargoRenderPromotionMechanism struct {
@@ -744,6 +781,9 @@ type StageStatus struct {
 	CurrentPromotion *PromotionReference `json:"currentPromotion,omitempty" protobuf:"bytes,7,opt,name=currentPromotion"`
 	// LastPromotion is a reference to the last completed promotion.
 	LastPromotion *PromotionReference `json:"lastPromotion,omitempty" protobuf:"bytes,10,opt,name=lastPromotion"`
+	// PendingRollback is the ID of the Stage's current FreightCollection if it
+	// failed verification and the Stage has yet to be rolled back from it.
+	PendingRollback string `json:"pendingRollback,omitempty" protobuf:"bytes,13,opt,name=pendingRollback"`
 }
 
 // FreightReference is a simplified representation of a piece of Freight -- not
@@ -761,6 +801,12 @@ type FreightReference struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,4,rep,name=charts"`
+	// Artifacts describes specific versions of specific generic artifacts
+	// stored in OCI registries.
+	Artifacts []OCIArtifact `json:"artifacts,omitempty" protobuf:"bytes,9,rep,name=artifacts"`
+	// Files describes specific versions of specific files served over HTTP/S
+	// or by S3-compatible object stores.
+	Files []File `json:"files,omitempty" protobuf:"bytes,10,rep,name=files"`
 }
 
 // FreightCollection is a collection of FreightReferences, each of which
@@ -892,6 +938,12 @@ type Chart struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
 	// Version specifies a particular version of the chart.
 	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// AppVersion specifies the version of the application packaged by this
+	// version of the chart, if the chart specifies one.
+	AppVersion string `json:"appVersion,omitempty" protobuf:"bytes,4,opt,name=appVersion"`
+	// Digest identifies this version of the chart more precisely than Version,
+	// if the digest is known.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,5,opt,name=digest"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -905,7 +957,73 @@ func (c *Chart) DeepEquals(other *Chart) bool {
 	}
 	return c.RepoURL == other.RepoURL &&
 		c.Name == other.Name &&
-		c.Version == other.Version
+		c.Version == other.Version &&
+		c.AppVersion == other.AppVersion &&
+		c.Digest == other.Digest
+}
+
+// OCIArtifact describes a specific version of a generic artifact stored in an
+// OCI registry.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag identifies a specific version of the artifact in the repository
+	// specified by RepoURL.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest identifies a specific version of the artifact in the repository
+	// specified by RepoURL. This is a more precise identifier than Tag.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// ArtifactType is the type of the artifact.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,4,opt,name=artifactType"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided OCIArtifact. I.e., all fields must be equal.
+func (a *OCIArtifact) DeepEquals(other *OCIArtifact) bool {
+	if a == nil && other == nil {
+		return true
+	}
+	if a == nil || other == nil {
+		return false
+	}
+	return a.RepoURL == other.RepoURL &&
+		a.Tag == other.Tag &&
+		a.Digest == other.Digest &&
+		a.ArtifactType == other.ArtifactType
+}
+
+// File describes a specific version of a file served over HTTP/S or by an
+// S3-compatible object store.
+type File struct {
+	// RepoURL describes the location in which the file can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// URL is the URL from which the file can be retrieved.
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// Version identifies the specific version of the file.
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// ETag is an opaque identifier of the file's content as reported by the
+	// server from which it can be retrieved. It changes whenever the content
+	// changes, but it is not a checksum of the content.
+	ETag string `json:"etag,omitempty" protobuf:"bytes,4,opt,name=etag"`
+	// Checksum is the SHA-256 checksum of the file's content, formatted as
+	// "sha256:<hex>".
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,5,opt,name=checksum"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided File. I.e., all fields must be equal.
+func (f *File) DeepEquals(other *File) bool {
+	if f == nil && other == nil {
+		return true
+	}
+	if f == nil || other == nil {
+		return false
+	}
+	return f.RepoURL == other.RepoURL &&
+		f.URL == other.URL &&
+		f.Version == other.Version &&
+		f.ETag == other.ETag &&
+		f.Checksum == other.Checksum
 }
 
 // Health describes the health of a Stage.
@@ -642,6 +642,38 @@ func TestChartDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "chart app versions differ",
+			a: &Chart{
+				RepoURL:    "fake-url",
+				Name:       "fake-name",
+				Version:    "v1.0.0",
+				AppVersion: "v1.0.0",
+			},
+			b: &Chart{
+				RepoURL:    "fake-url",
+				Name:       "fake-name",
+				Version:    "v1.0.0",
+				AppVersion: "v2.0.0",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "chart digests differ",
+			a: &Chart{
+				RepoURL: "fake-url",
+				Name:    "fake-name",
+				Version: "v1.0.0",
+				Digest:  "sha256:foo",
+			},
+			b: &Chart{
+				RepoURL: "fake-url",
+				Name:    "fake-name",
+				Version: "v1.0.0",
+				Digest:  "sha256:bar",
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &Chart{
@@ -665,3 +697,183 @@ func TestChartDeepEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestOCIArtifactDeepEquals(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              *OCIArtifact
+		b              *OCIArtifact
+		expectedResult bool
+	}{
+		{
+			name:           "a and b both nil",
+			expectedResult: true,
+		},
+		{
+			name:           "only a is nil",
+			b:              &OCIArtifact{},
+			expectedResult: false,
+		},
+		{
+			name:           "only b is nil",
+			a:              &OCIArtifact{},
+			expectedResult: false,
+		},
+		{
+			name: "repo URLs differ",
+			a: &OCIArtifact{
+				RepoURL: "foo",
+			},
+			b: &OCIArtifact{
+				RepoURL: "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "digests differ",
+			a: &OCIArtifact{
+				RepoURL: "fake-url",
+				Tag:     "v1.0.0",
+				Digest:  "fake-digest",
+			},
+			b: &OCIArtifact{
+				RepoURL: "fake-url",
+				Tag:     "v1.0.0",
+				Digest:  "different-fake-digest",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "artifact types differ",
+			a: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Digest:       "fake-digest",
+				ArtifactType: "foo",
+			},
+			b: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Digest:       "fake-digest",
+				ArtifactType: "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "perfect match",
+			a: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Tag:          "v1.0.0",
+				Digest:       "fake-digest",
+				ArtifactType: "fake-type",
+			},
+			b: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Tag:          "v1.0.0",
+				Digest:       "fake-digest",
+				ArtifactType: "fake-type",
+			},
+			expectedResult: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.a.DeepEquals(testCase.b))
+			require.Equal(t, testCase.expectedResult, testCase.b.DeepEquals(testCase.a))
+		})
+	}
+}
+
+func TestFileDeepEquals(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              *File
+		b              *File
+		expectedResult bool
+	}{
+		{
+			name:           "a and b both nil",
+			expectedResult: true,
+		},
+		{
+			name:           "only a is nil",
+			b:              &File{},
+			expectedResult: false,
+		},
+		{
+			name:           "only b is nil",
+			a:              &File{},
+			expectedResult: false,
+		},
+		{
+			name: "URLs differ",
+			a: &File{
+				RepoURL: "s3://fake-bucket/",
+				URL:     "s3://fake-bucket/foo",
+			},
+			b: &File{
+				RepoURL: "s3://fake-bucket/",
+				URL:     "s3://fake-bucket/bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "ETags differ",
+			a: &File{
+				RepoURL: "s3://fake-bucket/",
+				URL:     "s3://fake-bucket/foo",
+				Version: "v1.0.0",
+				ETag:    "fake-etag",
+			},
+			b: &File{
+				RepoURL: "s3://fake-bucket/",
+				URL:     "s3://fake-bucket/foo",
+				Version: "v1.0.0",
+				ETag:    "different-fake-etag",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "Checksums differ",
+			a: &File{
+				RepoURL:  "s3://fake-bucket/",
+				URL:      "s3://fake-bucket/foo",
+				Version:  "v1.0.0",
+				ETag:     "fake-etag",
+				Checksum: "sha256:fake-checksum",
+			},
+			b: &File{
+				RepoURL:  "s3://fake-bucket/",
+				URL:      "s3://fake-bucket/foo",
+				Version:  "v1.0.0",
+				ETag:     "fake-etag",
+				Checksum: "sha256:different-fake-checksum",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "perfect match",
+			a: &File{
+				RepoURL:  "s3://fake-bucket/",
+				URL:      "s3://fake-bucket/foo",
+				Version:  "v1.0.0",
+				ETag:     "fake-etag",
+				Checksum: "sha256:fake-checksum",
+			},
+			b: &File{
+				RepoURL:  "s3://fake-bucket/",
+				URL:      "s3://fake-bucket/foo",
+				Version:  "v1.0.0",
+				ETag:     "fake-etag",
+				Checksum: "sha256:fake-checksum",
+			},
+			expectedResult: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.a.DeepEquals(testCase.b))
+			require.Equal(t, testCase.expectedResult, testCase.b.DeepEquals(testCase.a))
+		})
+	}
+}
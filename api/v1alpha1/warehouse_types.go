@@ -2,13 +2,14 @@ package v1alpha1
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-// +kubebuilder:validation:Enum={Lexical,NewestFromBranch,NewestTag,SemVer}
+// +kubebuilder:validation:Enum={Lexical,NewestFromBranch,NewestTag,PullRequest,SemVer}
 type CommitSelectionStrategy string
 
 const (
 	CommitSelectionStrategyLexical          CommitSelectionStrategy = "Lexical"
 	CommitSelectionStrategyNewestFromBranch CommitSelectionStrategy = "NewestFromBranch"
 	CommitSelectionStrategyNewestTag        CommitSelectionStrategy = "NewestTag"
+	CommitSelectionStrategyPullRequest      CommitSelectionStrategy = "PullRequest"
 	CommitSelectionStrategySemVer           CommitSelectionStrategy = "SemVer"
 )
 
@@ -22,6 +23,35 @@ const (
 	ImageSelectionStrategySemVer      ImageSelectionStrategy = "SemVer"
 )
 
+// +kubebuilder:validation:Enum={In,NotIn,Exists,DoesNotExist,Matches}
+type ImageMetadataMatchOperator string
+
+const (
+	ImageMetadataMatchOperatorIn           ImageMetadataMatchOperator = "In"
+	ImageMetadataMatchOperatorNotIn        ImageMetadataMatchOperator = "NotIn"
+	ImageMetadataMatchOperatorExists       ImageMetadataMatchOperator = "Exists"
+	ImageMetadataMatchOperatorDoesNotExist ImageMetadataMatchOperator = "DoesNotExist"
+	ImageMetadataMatchOperatorMatches      ImageMetadataMatchOperator = "Matches"
+)
+
+// +kubebuilder:validation:Enum={Digest,Lexical,SemVer}
+type OCIArtifactSelectionStrategy string
+
+const (
+	OCIArtifactSelectionStrategyDigest  OCIArtifactSelectionStrategy = "Digest"
+	OCIArtifactSelectionStrategyLexical OCIArtifactSelectionStrategy = "Lexical"
+	OCIArtifactSelectionStrategySemVer  OCIArtifactSelectionStrategy = "SemVer"
+)
+
+// +kubebuilder:validation:Enum={Lexical,NewestModified,SemVer}
+type FileSelectionStrategy string
+
+const (
+	FileSelectionStrategyLexical        FileSelectionStrategy = "Lexical"
+	FileSelectionStrategyNewestModified FileSelectionStrategy = "NewestModified"
+	FileSelectionStrategySemVer         FileSelectionStrategy = "SemVer"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name=Shard,type=string,JSONPath=`.spec.shard`
@@ -76,6 +106,84 @@ type WarehouseSpec struct {
 	//
 	// +kubebuilder:validation:MinItems=1
 	Subscriptions []RepoSubscription `json:"subscriptions" protobuf:"bytes,1,rep,name=subscriptions"`
+	// FreightMatchRules optionally describes rules that artifacts discovered for
+	// different subscriptions must satisfy in order to be combined into a single
+	// piece of Freight. When left unspecified, Freight is created from the newest
+	// artifact discovered for each subscription. When specified, Freight is
+	// created from the newest combination of discovered artifacts that satisfies
+	// ALL rules. If no such combination exists, no Freight is created and the
+	// unsatisfied rules are reported in the Warehouse's Ready condition.
+	//
+	// +kubebuilder:validation:Optional
+	FreightMatchRules []FreightMatchRule `json:"freightMatchRules,omitempty" protobuf:"bytes,5,rep,name=freightMatchRules"`
+}
+
+// FreightMatchRule describes a rule requiring the version of an artifact
+// discovered for one subscription to match the version of an artifact
+// discovered for another subscription.
+type FreightMatchRule struct {
+	// Name is an optional, human-readable name for the rule. When specified, it
+	// is used to refer to the rule when reporting that it could not be
+	// satisfied.
+	//
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Source references the subscription whose artifact versions must match
+	// those of the subscription referenced by Target.
+	//
+	// +kubebuilder:validation:Required
+	Source FreightMatchArtifact `json:"source" protobuf:"bytes,2,opt,name=source"`
+	// Target references the subscription whose artifact versions must match
+	// those of the subscription referenced by Source.
+	//
+	// +kubebuilder:validation:Required
+	Target FreightMatchArtifact `json:"target" protobuf:"bytes,3,opt,name=target"`
+}
+
+// +kubebuilder:validation:Enum={Chart,File,Git,Image,OCIArtifact}
+type FreightMatchArtifactKind string
+
+const (
+	FreightMatchArtifactKindChart       FreightMatchArtifactKind = "Chart"
+	FreightMatchArtifactKindFile        FreightMatchArtifactKind = "File"
+	FreightMatchArtifactKindGit         FreightMatchArtifactKind = "Git"
+	FreightMatchArtifactKindImage       FreightMatchArtifactKind = "Image"
+	FreightMatchArtifactKindOCIArtifact FreightMatchArtifactKind = "OCIArtifact"
+)
+
+// FreightMatchArtifact references the artifacts discovered for one of a
+// Warehouse's subscriptions and describes how to derive a comparable version
+// from each of them. The version of a commit is its tag or, if it was not
+// selected by tag, its ID. The version of an image or OCI artifact is its tag.
+// The version of a chart or file is its version.
+type FreightMatchArtifact struct {
+	// Kind is the kind of subscription being referenced.
+	//
+	// +kubebuilder:validation:Required
+	Kind FreightMatchArtifactKind `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// RepoURL is the RepoURL of the subscription being referenced. If more than
+	// one subscription of the specified Kind has this RepoURL, the first one is
+	// referenced.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,2,opt,name=repoURL"`
+	// Name is the name of the chart being referenced. This field is required
+	// when Kind is Chart and the subscription points to a classic chart
+	// repository, and MUST otherwise be empty.
+	//
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// TrimPrefix is an optional prefix to remove from each version before it is
+	// compared. e.g. Setting this to "v" makes an image tagged "v1.2.3" match a
+	// chart versioned "1.2.3".
+	//
+	// +kubebuilder:validation:Optional
+	TrimPrefix string `json:"trimPrefix,omitempty" protobuf:"bytes,4,opt,name=trimPrefix"`
+	// TrimSuffix is an optional suffix to remove from each version before it is
+	// compared.
+	//
+	// +kubebuilder:validation:Optional
+	TrimSuffix string `json:"trimSuffix,omitempty" protobuf:"bytes,5,opt,name=trimSuffix"`
 }
 
 // FreightCreationPolicy defines how Freight is created by a Warehouse.
@@ -90,7 +198,9 @@ const (
 )
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
-// container image repository, or a Helm chart repository.
+// container image repository, a Helm chart repository, a repository of
+// generic artifacts within an OCI registry, or a location from which files are
+// served over HTTP/S or by an S3-compatible object store.
 type RepoSubscription struct {
 	// Git describes a subscriptions to a Git repository.
 	Git *GitSubscription `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
@@ -98,6 +208,14 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+	// OCIArtifact describes a subscription to a repository of generic artifacts
+	// (e.g. Flux OCI bundles, WASM modules, or Terraform modules) within an OCI
+	// registry.
+	OCIArtifact *OCIArtifactSubscription `json:"ociArtifact,omitempty" protobuf:"bytes,4,opt,name=ociArtifact"`
+	// File describes a subscription to files (e.g. tarballs or JSON manifests)
+	// listed in a bucket of an S3-compatible object store or in a simple HTTP/S
+	// index.
+	File *FileSubscription `json:"file,omitempty" protobuf:"bytes,5,opt,name=file"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
@@ -117,9 +235,13 @@ type GitSubscription struct {
 	// Branch references a particular branch of the repository. The value in this
 	// field only has any effect when the CommitSelectionStrategy is
 	// NewestFromBranch or left unspecified (which is implicitly the same as
-	// NewestFromBranch). This field is optional. When left unspecified, (and the
-	// CommitSelectionStrategy is NewestFromBranch or unspecified), the
-	// subscription is implicitly to the repository's default branch.
+	// NewestFromBranch), or PullRequest. This field is optional. When left
+	// unspecified, (and the CommitSelectionStrategy is NewestFromBranch or
+	// unspecified), the subscription is implicitly to the repository's default
+	// branch. When the CommitSelectionStrategy is PullRequest, only pull requests
+	// targeting this branch are considered. When left unspecified (and the
+	// CommitSelectionStrategy is PullRequest), pull requests targeting any
+	// branch are considered.
 	//
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^\w+([-/]\w+)*$`
@@ -192,6 +314,73 @@ type GitSubscription struct {
 	// subset of them.
 	// +kubebuilder:validation:Optional
 	ExcludePaths []string `json:"excludePaths,omitempty" protobuf:"bytes,9,rep,name=excludePaths"`
+	// AllowAuthors is a list of regular expressions that can optionally be used
+	// to limit the commits that are considered in determining the newest commit
+	// of interest to those whose author matches at least one of them. Authors
+	// are matched in the format "Name <email>". The value in this field only has
+	// any effect when the CommitSelectionStrategy is NewestFromBranch or left
+	// unspecified (which is implicitly the same as NewestFromBranch). This field
+	// is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowAuthors []string `json:"allowAuthors,omitempty" protobuf:"bytes,12,rep,name=allowAuthors"`
+	// IgnoreAuthors is a list of regular expressions that can optionally be used
+	// to exclude commits whose author matches any of them from consideration in
+	// determining the newest commit of interest. This is useful for ignoring
+	// commits made by bots. Authors are matched in the format "Name <email>".
+	// IgnoreAuthors takes precedence over AllowAuthors. The value in this field
+	// only has any effect when the CommitSelectionStrategy is NewestFromBranch or
+	// left unspecified (which is implicitly the same as NewestFromBranch). This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreAuthors []string `json:"ignoreAuthors,omitempty" protobuf:"bytes,13,rep,name=ignoreAuthors"`
+	// AllowCommitters is a list of regular expressions that can optionally be
+	// used to limit the commits that are considered in determining the newest
+	// commit of interest to those whose committer matches at least one of them.
+	// Committers are matched in the format "Name <email>". The value in this
+	// field only has any effect when the CommitSelectionStrategy is
+	// NewestFromBranch or left unspecified (which is implicitly the same as
+	// NewestFromBranch). This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowCommitters []string `json:"allowCommitters,omitempty" protobuf:"bytes,14,rep,name=allowCommitters"`
+	// IgnoreCommitters is a list of regular expressions that can optionally be
+	// used to exclude commits whose committer matches any of them from
+	// consideration in determining the newest commit of interest. Committers are
+	// matched in the format "Name <email>". IgnoreCommitters takes precedence
+	// over AllowCommitters. The value in this field only has any effect when the
+	// CommitSelectionStrategy is NewestFromBranch or left unspecified (which is
+	// implicitly the same as NewestFromBranch). This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreCommitters []string `json:"ignoreCommitters,omitempty" protobuf:"bytes,15,rep,name=ignoreCommitters"`
+	// IgnoreMessages is a list of regular expressions that can optionally be
+	// used to exclude commits whose message matches any of them from
+	// consideration in determining the newest commit of interest. Patterns are
+	// matched against the full commit message, including its subject (ex.
+	// "\[skip kargo\]"). The value in this field only has any effect when the
+	// CommitSelectionStrategy is NewestFromBranch or left unspecified (which is
+	// implicitly the same as NewestFromBranch). This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreMessages []string `json:"ignoreMessages,omitempty" protobuf:"bytes,16,rep,name=ignoreMessages"`
+	// PullRequestLabels is a list of labels that can optionally be used to limit
+	// the pull requests that are considered to those having all of them. The
+	// value in this field only has any effect when the CommitSelectionStrategy
+	// is PullRequest. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	PullRequestLabels []string `json:"pullRequestLabels,omitempty" protobuf:"bytes,17,rep,name=pullRequestLabels"`
+	// Provider is the name of the Git provider hosting the repository. The value
+	// in this field only has any effect when the CommitSelectionStrategy is
+	// PullRequest, in which case the provider's API is used to list open pull
+	// requests. This field is optional. When left unspecified, Kargo will try
+	// to infer the provider from the RepoURL.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=github;gitlab;bitbucket;gitea;azure
+	Provider string `json:"provider,omitempty" protobuf:"bytes,18,opt,name=provider"`
 	// DiscoveryLimit is an optional limit on the number of commits that can be
 	// discovered for this subscription. The limit is applied after filtering
 	// commits based on the AllowTags and IgnoreTags fields.
@@ -288,6 +477,291 @@ type ImageSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+	// Verification optionally specifies how the Cosign signatures of discovered
+	// images are to be verified. When specified, image references that are
+	// unsigned or whose signatures cannot be verified are still reported in the
+	// Warehouse's status, but will never become part of Freight. Such references
+	// do not count toward the DiscoveryLimit, but no more of them than the
+	// DiscoveryLimit are reported.
+	//
+	// +kubebuilder:validation:Optional
+	Verification *ImageVerification `json:"verification,omitempty" protobuf:"bytes,11,opt,name=verification"`
+	// LabelMatchExpressions is an optional list of expressions that the labels
+	// of a discovered image's config must all satisfy for the image to be
+	// considered. For multi-platform images, the labels of the image matching
+	// the Platform, or of the most recently created image if no Platform is
+	// specified, are evaluated.
+	//
+	// +kubebuilder:validation:Optional
+	LabelMatchExpressions []ImageMetadataMatchExpression `json:"labelMatchExpressions,omitempty" protobuf:"bytes,12,rep,name=labelMatchExpressions"`
+	// AnnotationMatchExpressions is an optional list of expressions that the
+	// annotations of a discovered image's manifest must all satisfy for the
+	// image to be considered. For multi-platform images, the annotations of the
+	// image index take precedence over those of the individual images.
+	//
+	// +kubebuilder:validation:Optional
+	AnnotationMatchExpressions []ImageMetadataMatchExpression `json:"annotationMatchExpressions,omitempty" protobuf:"bytes,13,rep,name=annotationMatchExpressions"`
+}
+
+// ImageMetadataMatchExpression describes a requirement that an image label or
+// annotation must satisfy.
+type ImageMetadataMatchExpression struct {
+	// Key is the key of the label or annotation the expression applies to, e.g.
+	// "org.opencontainers.image.source".
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Operator describes the relationship between the value of the label or
+	// annotation and the Values. In and NotIn require the value to be, or not to
+	// be, exactly equal to one of the Values. Exists and DoesNotExist require the
+	// label or annotation to be present, or absent, regardless of its value, and
+	// do not permit any Values. Matches requires the value to match at least one
+	// of the Values, which are treated as regular expressions.
+	Operator ImageMetadataMatchOperator `json:"operator" protobuf:"bytes,2,opt,name=operator"`
+	// Values is a list of values. It must be non-empty for the In, NotIn, and
+	// Matches operators and empty for the Exists and DoesNotExist operators.
+	//
+	// +kubebuilder:validation:Optional
+	Values []string `json:"values,omitempty" protobuf:"bytes,3,rep,name=values"`
+}
+
+// ImageVerification describes how the Cosign signatures of images discovered
+// for an ImageSubscription are to be verified. At least one of the
+// PublicKeysSecret or Keyless fields must be specified. If both are specified,
+// an image bearing a valid signature satisfying either is considered verified.
+type ImageVerification struct {
+	// PublicKeysSecret is the name of a Secret in the Warehouse's namespace
+	// holding one or more PEM-encoded Cosign public keys. Every value in the
+	// Secret's data is treated as a public key. An image is considered verified
+	// if it bears a valid signature made with any one of these keys.
+	//
+	// +kubebuilder:validation:Optional
+	PublicKeysSecret string `json:"publicKeysSecret,omitempty" protobuf:"bytes,1,opt,name=publicKeysSecret"`
+	// Keyless specifies how to verify signatures made using ephemeral keys
+	// bound to an identity by a signing certificate.
+	//
+	// +kubebuilder:validation:Optional
+	Keyless *KeylessVerification `json:"keyless,omitempty" protobuf:"bytes,2,opt,name=keyless"`
+	// Attestations optionally lists in-toto attestations an image must bear, in
+	// addition to a valid signature, to be considered verified. Attestations
+	// must be signed with one of the public keys or by one of the identities
+	// that are trusted to sign images.
+	//
+	// +kubebuilder:validation:Optional
+	Attestations []ImageAttestation `json:"attestations,omitempty" protobuf:"bytes,3,rep,name=attestations"`
+}
+
+// ImageAttestation describes an in-toto attestation an image must bear.
+type ImageAttestation struct {
+	// PredicateType is the predicate type of the attestation, e.g.
+	// "https://slsa.dev/provenance/v1".
+	//
+	// +kubebuilder:validation:MinLength=1
+	PredicateType string `json:"predicateType" protobuf:"bytes,1,opt,name=predicateType"`
+}
+
+// KeylessVerification describes how to verify "keyless" Cosign signatures.
+// Signatures are verified offline using the signing certificate and the
+// transparency log bundle attached to them. No transparency log is contacted
+// at discovery time.
+type KeylessVerification struct {
+	// TrustedRootSecret is the name of a Secret in the Warehouse's namespace
+	// holding the trust material used to verify keyless signatures. The
+	// "fulcio.pem" key must contain the PEM-encoded certificates of the
+	// certificate authorities trusted to issue signing certificates. The
+	// "rekor.pub" key must contain the PEM-encoded public key of the
+	// transparency log trusted to timestamp signatures.
+	//
+	// +kubebuilder:validation:MinLength=1
+	TrustedRootSecret string `json:"trustedRootSecret" protobuf:"bytes,1,opt,name=trustedRootSecret"`
+	// Identities is a list of identities that are trusted to sign images. An
+	// image is considered verified if it bears a valid signature made by any one
+	// of these identities.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Identities []KeylessIdentity `json:"identities" protobuf:"bytes,2,rep,name=identities"`
+}
+
+// KeylessIdentity describes an identity that is trusted to sign images. One of
+// Issuer or IssuerRegex and one of Subject or SubjectRegex must be specified.
+type KeylessIdentity struct {
+	// Issuer is the exact OIDC issuer that must have authenticated the signer,
+	// e.g. "https://token.actions.githubusercontent.com".
+	//
+	// +kubebuilder:validation:Optional
+	Issuer string `json:"issuer,omitempty" protobuf:"bytes,1,opt,name=issuer"`
+	// IssuerRegex is a regular expression the OIDC issuer that authenticated
+	// the signer must match.
+	//
+	// +kubebuilder:validation:Optional
+	IssuerRegex string `json:"issuerRegex,omitempty" protobuf:"bytes,2,opt,name=issuerRegex"`
+	// Subject is the exact subject (e.g. an email address or a workflow URI)
+	// the signing certificate must have been issued to.
+	//
+	// +kubebuilder:validation:Optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,3,opt,name=subject"`
+	// SubjectRegex is a regular expression the subject the signing certificate
+	// was issued to must match.
+	//
+	// +kubebuilder:validation:Optional
+	SubjectRegex string `json:"subjectRegex,omitempty" protobuf:"bytes,4,opt,name=subjectRegex"`
+}
+
+// OCIArtifactSubscription defines a subscription to a repository of generic
+// artifacts within an OCI registry.
+type OCIArtifactSubscription struct {
+	// RepoURL specifies the URL of the artifact repository to subscribe to. The
+	// value in this field MUST NOT include a tag. This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version of the artifact specified by the RepoURL field. This field is
+	// optional. When left unspecified, the field is implicitly treated as if its
+	// value were "SemVer".
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy OCIArtifactSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,2,opt,name=selectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. A "strict" semver tag is one containing ALL of major, minor,
+	// and patch version components. This is enabled by default, but only has any
+	// effect when the SelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,3,opt,name=strictSemvers"`
+	// SemverConstraint specifies constraints on what new artifact versions are
+	// permissible. The value in this field only has any effect when the
+	// SelectionStrategy is SemVer or left unspecified (which is implicitly the
+	// same as SemVer). When the SelectionStrategy is Digest, this field MUST
+	// instead specify the name of the (presumably mutable) tag whose current
+	// digest should be tracked.
+	//
+	// +kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,4,opt,name=semverConstraint"`
+	// AllowTags is a regular expression that can optionally be used to limit the
+	// tags that are considered in determining the newest version of an artifact.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowTags string `json:"allowTags,omitempty" protobuf:"bytes,5,opt,name=allowTags"`
+	// IgnoreTags is a list of tags that must be ignored when determining the
+	// newest version of an artifact. No regular expressions or glob patterns are
+	// supported yet. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreTags []string `json:"ignoreTags,omitempty" protobuf:"bytes,6,rep,name=ignoreTags"`
+	// ArtifactType optionally limits discovery to artifacts of the specified
+	// type. An artifact's type is the artifactType of its manifest or, when that
+	// is not set, the media type of its config, e.g.
+	// "application/vnd.cncf.flux.config.v1+json".
+	//
+	// +kubebuilder:validation:Optional
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,7,opt,name=artifactType"`
+	// Annotations optionally limits discovery to artifacts whose manifests carry
+	// ALL of the specified annotations with exactly the specified values.
+	//
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,8,rep,name=annotations"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,9,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of artifact references
+	// that can be discovered for this subscription. The limit is applied after
+	// filtering artifacts based on the AllowTags, IgnoreTags, ArtifactType and
+	// Annotations fields. When left unspecified, the field is implicitly treated
+	// as if its value were "20". The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+}
+
+// FileSubscription defines a subscription to files listed in a bucket of an
+// S3-compatible object store or in a simple HTTP/S index.
+type FileSubscription struct {
+	// RepoURL specifies the location of the files to subscribe to. It may be
+	// an s3:// URL specifying a bucket and, optionally, a key prefix (e.g.
+	// s3://my-bucket/my-app/) OR the http:// or https:// URL of an HTML page
+	// (e.g. a web server's directory listing) that links to the files. A key
+	// prefix always denotes a "directory" whose immediate children are listed,
+	// so s3://my-bucket/my-app and s3://my-bucket/my-app/ are equivalent. This
+	// field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(((https?)|(s3))://)([\w\d\.\-]+)(:[\d]+)?(/.*)*$`
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Endpoint optionally specifies the URL of an S3-compatible object store
+	// (e.g. https://minio.example.com). When left unspecified, AWS S3 is
+	// assumed. This field only has any effect when RepoURL is an s3:// URL.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,2,opt,name=endpoint"`
+	// Region optionally specifies the region of the bucket specified by RepoURL.
+	// When left unspecified, the field is implicitly treated as if its value
+	// were "us-east-1". This field only has any effect when RepoURL is an s3://
+	// URL.
+	//
+	// +kubebuilder:validation:Optional
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version of the file. This field is optional. When left unspecified, the
+	// field is implicitly treated as if its value were "SemVer".
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy FileSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,4,opt,name=selectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semver versions should be
+	// considered. A "strict" semver is one containing ALL of major, minor, and
+	// patch version components. This is enabled by default, but only has any
+	// effect when the SelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,5,opt,name=strictSemvers"`
+	// SemverConstraint specifies constraints on what new file versions are
+	// permissible. The value in this field only has any effect when the
+	// SelectionStrategy is SemVer or left unspecified (which is implicitly the
+	// same as SemVer). This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,6,opt,name=semverConstraint"`
+	// VersionRegex is a regular expression that can optionally be used to
+	// extract a file's version from its name. When the expression contains a
+	// capture group, the version is the value captured by the first group.
+	// Otherwise, the version is the entire match. When left unspecified, the
+	// version is the first semantic version found in the file's name. This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	VersionRegex string `json:"versionRegex,omitempty" protobuf:"bytes,7,opt,name=versionRegex"`
+	// AllowFiles is a regular expression that can optionally be used to limit
+	// the file names that are considered in determining the newest version of a
+	// file. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowFiles string `json:"allowFiles,omitempty" protobuf:"bytes,8,opt,name=allowFiles"`
+	// IgnoreFiles is a list of file names that must be ignored when determining
+	// the newest version of a file. No regular expressions or glob patterns are
+	// supported yet. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreFiles []string `json:"ignoreFiles,omitempty" protobuf:"bytes,9,rep,name=ignoreFiles"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the server. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,10,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of files that can be
+	// discovered for this subscription. The limit is applied after filtering
+	// files based on the AllowFiles, IgnoreFiles and SemverConstraint fields.
+	// When left unspecified, the field is implicitly treated as if its value
+	// were "20". The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,11,opt,name=discoveryLimit"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
@@ -328,6 +802,29 @@ type ChartSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,4,opt,name=discoveryLimit"`
+	// Verification optionally specifies how the provenance of discovered chart
+	// versions is to be verified. When specified, chart versions that have no
+	// provenance file or whose provenance file cannot be verified are ignored
+	// and will never become part of Freight.
+	//
+	// +kubebuilder:validation:Optional
+	Verification *ChartVerification `json:"verification,omitempty" protobuf:"bytes,5,opt,name=verification"`
+}
+
+// ChartVerification describes how the provenance of chart versions discovered
+// for a ChartSubscription is to be verified. A chart version is considered
+// verified if its provenance file (.prov) bears a valid signature made with a
+// trusted key and attests to the digest of the packaged chart, in the same
+// manner as `helm verify`.
+type ChartVerification struct {
+	// KeyringSecret is the name of a Secret in the Warehouse's namespace
+	// holding one or more OpenPGP keyrings, in binary or ASCII armored form.
+	// Every value in the Secret's data is treated as a keyring. A chart version
+	// is considered verified if its provenance file was signed with any one of
+	// the keys in these keyrings.
+	//
+	// +kubebuilder:validation:MinLength=1
+	KeyringSecret string `json:"keyringSecret" protobuf:"bytes,1,opt,name=keyringSecret"`
 }
 
 // WarehouseStatus describes a Warehouse's most recently observed state.
@@ -384,6 +881,16 @@ type DiscoveredArtifacts struct {
 	//
 	// +optional
 	Charts []ChartDiscoveryResult `json:"charts,omitempty" protobuf:"bytes,3,rep,name=charts"`
+	// OCIArtifacts holds the artifact references discovered by the Warehouse for
+	// the OCI artifact subscriptions.
+	//
+	// +optional
+	OCIArtifacts []OCIArtifactDiscoveryResult `json:"ociArtifacts,omitempty" protobuf:"bytes,5,rep,name=ociArtifacts"`
+	// Files holds the files discovered by the Warehouse for the file
+	// subscriptions.
+	//
+	// +optional
+	Files []FileDiscoveryResult `json:"files,omitempty" protobuf:"bytes,6,rep,name=files"`
 }
 
 // GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -424,8 +931,23 @@ type DiscoveredCommit struct {
 	// Committer is the person who committed the commit.
 	Committer string `json:"committer,omitempty" protobuf:"bytes,6,opt,name=committer"`
 	// CreatorDate is the commit creation date as specified by the commit, or
-	// the tagger date if the commit belongs to an annotated tag.
+	// the tagger date if the commit belongs to an annotated tag. For commits
+	// discovered using the PullRequest CommitSelectionStrategy, it is the date
+	// the pull request was last updated.
 	CreatorDate *metav1.Time `json:"creatorDate,omitempty" protobuf:"bytes,7,opt,name=creatorDate"`
+	// PullRequest references the pull request whose head is this commit. This
+	// field is only populated when the CommitSelectionStrategy of the
+	// GitSubscription is PullRequest.
+	PullRequest *PullRequestReference `json:"pullRequest,omitempty" protobuf:"bytes,8,opt,name=pullRequest"`
+}
+
+// PullRequestReference is a reference to a pull request (or merge request).
+type PullRequestReference struct {
+	// Number is the number of the pull request, which is unique within the
+	// repository.
+	Number int64 `json:"number" protobuf:"varint,1,opt,name=number"`
+	// URL is the URL of the pull request.
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
 }
 
 // ImageDiscoveryResult represents the result of an image discovery operation
@@ -470,6 +992,19 @@ type DiscoveredImageReference struct {
 	// CreatedAt is the time the image was created. This field is optional, and
 	// not populated for every ImageSelectionStrategy.
 	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,4,opt,name=createdAt"`
+	// VerificationFailure explains why the image failed signature verification.
+	// This field is only populated if the ImageSubscription specifies a
+	// Verification policy and the image did not satisfy it. References with a
+	// non-empty VerificationFailure are not eligible to become part of Freight.
+	VerificationFailure string `json:"verificationFailure,omitempty" protobuf:"bytes,5,opt,name=verificationFailure"`
+	// Labels holds the labels of the image that were evaluated by the
+	// ImageSubscription's LabelMatchExpressions. This field is only populated if
+	// the ImageSubscription specifies LabelMatchExpressions.
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations holds the annotations of the image that were evaluated by the
+	// ImageSubscription's AnnotationMatchExpressions. This field is only
+	// populated if the ImageSubscription specifies AnnotationMatchExpressions.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,7,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // ChartDiscoveryResult represents the result of a chart discovery operation for
@@ -493,6 +1028,33 @@ type ChartDiscoveryResult struct {
 	//
 	// +optional
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
+	// References holds additional details about each of the discovered
+	// versions, in the same order as the Versions field.
+	//
+	// +optional
+	References []DiscoveredChartReference `json:"references,omitempty" protobuf:"bytes,5,rep,name=references"`
+}
+
+// DiscoveredChartReference represents a chart version discovered by a
+// Warehouse for a ChartSubscription.
+type DiscoveredChartReference struct {
+	// Version is the version of the chart.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// AppVersion is the version of the application packaged by the chart, as
+	// specified by the appVersion field of the chart's Chart.yaml. This field is
+	// optional, and only populated if the chart specifies an appVersion.
+	AppVersion string `json:"appVersion,omitempty" protobuf:"bytes,2,opt,name=appVersion"`
+	// Digest is the digest of the chart. For charts in classic chart
+	// repositories, this is the digest of the packaged chart recorded in the
+	// repository's index. For charts in OCI registries, this is the digest of
+	// the chart's manifest. This field is optional, and only populated if the
+	// digest is known.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// CreatedAt is the time the chart version was created. This field is
+	// optional, and only populated if the creation time is known.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,4,opt,name=createdAt"`
 }
 
 // +kubebuilder:object:root=true
@@ -503,3 +1065,77 @@ type WarehouseList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []Warehouse `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// OCIArtifactDiscoveryResult represents the result of an artifact discovery
+// operation for an OCIArtifactSubscription.
+type OCIArtifactDiscoveryResult struct {
+	// RepoURL is the repository URL of the artifact, as specified in the
+	// OCIArtifactSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// References is a list of artifact references discovered by the Warehouse
+	// for the OCIArtifactSubscription. An empty list indicates that the
+	// discovery operation was successful, but no artifacts matching the
+	// OCIArtifactSubscription criteria were found.
+	//
+	// +optional
+	References []DiscoveredOCIArtifactReference `json:"references" protobuf:"bytes,2,rep,name=references"`
+}
+
+// DiscoveredOCIArtifactReference represents an artifact reference discovered
+// by a Warehouse for an OCIArtifactSubscription.
+type DiscoveredOCIArtifactReference struct {
+	// Tag is the tag of the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Digest is the digest of the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+	Digest string `json:"digest" protobuf:"bytes,2,opt,name=digest"`
+	// ArtifactType is the type of the artifact.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,3,opt,name=artifactType"`
+}
+
+// FileDiscoveryResult represents the result of a file discovery operation for
+// a FileSubscription.
+type FileDiscoveryResult struct {
+	// RepoURL is the location of the files, as specified in the
+	// FileSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Files is a list of files discovered by the Warehouse for the
+	// FileSubscription. An empty list indicates that the discovery operation
+	// was successful, but no files matching the FileSubscription criteria were
+	// found.
+	//
+	// +optional
+	Files []DiscoveredFile `json:"files" protobuf:"bytes,2,rep,name=files"`
+}
+
+// DiscoveredFile represents a file discovered by a Warehouse for a
+// FileSubscription.
+type DiscoveredFile struct {
+	// URL is the URL from which the file can be retrieved.
+	//
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Version is the version of the file. This is the version extracted from
+	// the file's name or, if no version could be extracted, the file's name.
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// ETag is an opaque identifier of the file's content as reported by the
+	// server. It changes whenever the content changes, but it is not a checksum
+	// of the content.
+	ETag string `json:"etag,omitempty" protobuf:"bytes,3,opt,name=etag"`
+	// LastModified is the time the file was last modified. This field is
+	// optional, and only populated if the server reports it.
+	LastModified *metav1.Time `json:"lastModified,omitempty" protobuf:"bytes,4,opt,name=lastModified"`
+	// Checksum is the SHA-256 checksum of the file's content, formatted as
+	// "sha256:<hex>".
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,5,opt,name=checksum"`
+}
@@ -5,7 +5,8 @@
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -98,6 +99,21 @@ func (in *ApprovedStage) DeepCopy() *ApprovedStage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDAppChange) DeepCopyInto(out *ArgoCDAppChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppChange.
+func (in *ArgoCDAppChange) DeepCopy() *ArgoCDAppChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDAppChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppHealthStatus) DeepCopyInto(out *ArgoCDAppHealthStatus) {
 	*out = *in
@@ -301,6 +317,21 @@ func (in *ArgoCDSourceUpdate) DeepCopy() *ArgoCDSourceUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketPullRequest) DeepCopyInto(out *BitbucketPullRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketPullRequest.
+func (in *BitbucketPullRequest) DeepCopy() *BitbucketPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -324,6 +355,13 @@ func (in *ChartDiscoveryResult) DeepCopyInto(out *ChartDiscoveryResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]DiscoveredChartReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartDiscoveryResult.
@@ -339,6 +377,11 @@ func (in *ChartDiscoveryResult) DeepCopy() *ChartDiscoveryResult {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartSubscription) DeepCopyInto(out *ChartSubscription) {
 	*out = *in
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ChartVerification)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartSubscription.
@@ -351,6 +394,21 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartVerification) DeepCopyInto(out *ChartVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVerification.
+func (in *ChartVerification) DeepCopy() *ChartVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 	*out = *in
@@ -376,6 +434,20 @@ func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifactDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]FileDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredArtifacts.
@@ -388,6 +460,25 @@ func (in *DiscoveredArtifacts) DeepCopy() *DiscoveredArtifacts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredChartReference) DeepCopyInto(out *DiscoveredChartReference) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredChartReference.
+func (in *DiscoveredChartReference) DeepCopy() *DiscoveredChartReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredChartReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveredCommit) DeepCopyInto(out *DiscoveredCommit) {
 	*out = *in
@@ -395,6 +486,11 @@ func (in *DiscoveredCommit) DeepCopyInto(out *DiscoveredCommit) {
 		in, out := &in.CreatorDate, &out.CreatorDate
 		*out = (*in).DeepCopy()
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(PullRequestReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredCommit.
@@ -407,6 +503,25 @@ func (in *DiscoveredCommit) DeepCopy() *DiscoveredCommit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredFile) DeepCopyInto(out *DiscoveredFile) {
+	*out = *in
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredFile.
+func (in *DiscoveredFile) DeepCopy() *DiscoveredFile {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveredImageReference) DeepCopyInto(out *DiscoveredImageReference) {
 	*out = *in
@@ -414,6 +529,20 @@ func (in *DiscoveredImageReference) DeepCopyInto(out *DiscoveredImageReference)
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredImageReference.
@@ -426,6 +555,105 @@ func (in *DiscoveredImageReference) DeepCopy() *DiscoveredImageReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredOCIArtifactReference) DeepCopyInto(out *DiscoveredOCIArtifactReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredOCIArtifactReference.
+func (in *DiscoveredOCIArtifactReference) DeepCopy() *DiscoveredOCIArtifactReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredOCIArtifactReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpandedPromotionTemplate) DeepCopyInto(out *ExpandedPromotionTemplate) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make([]PromotionVariable, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpandedPromotionTemplate.
+func (in *ExpandedPromotionTemplate) DeepCopy() *ExpandedPromotionTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpandedPromotionTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *File) DeepCopyInto(out *File) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
+func (in *File) DeepCopy() *File {
+	if in == nil {
+		return nil
+	}
+	out := new(File)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileDiscoveryResult) DeepCopyInto(out *FileDiscoveryResult) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]DiscoveredFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileDiscoveryResult.
+func (in *FileDiscoveryResult) DeepCopy() *FileDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(FileDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSubscription) DeepCopyInto(out *FileSubscription) {
+	*out = *in
+	if in.IgnoreFiles != nil {
+		in, out := &in.IgnoreFiles, &out.IgnoreFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSubscription.
+func (in *FileSubscription) DeepCopy() *FileSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -435,7 +663,9 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 	if in.Commits != nil {
 		in, out := &in.Commits, &out.Commits
 		*out = make([]GitCommit, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -447,6 +677,16 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]File, len(*in))
+		copy(*out, *in)
+	}
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -554,6 +794,38 @@ func (in *FreightList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightMatchArtifact) DeepCopyInto(out *FreightMatchArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightMatchArtifact.
+func (in *FreightMatchArtifact) DeepCopy() *FreightMatchArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightMatchArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightMatchRule) DeepCopyInto(out *FreightMatchRule) {
+	*out = *in
+	out.Source = in.Source
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightMatchRule.
+func (in *FreightMatchRule) DeepCopy() *FreightMatchRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightMatchRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightOrigin) DeepCopyInto(out *FreightOrigin) {
 	*out = *in
@@ -576,7 +848,9 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 	if in.Commits != nil {
 		in, out := &in.Commits, &out.Commits
 		*out = make([]GitCommit, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -588,6 +862,16 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]File, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightReference.
@@ -654,6 +938,13 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.RolledBackFrom != nil {
+		in, out := &in.RolledBackFrom, &out.RolledBackFrom
+		*out = make(map[string]RolledBackStage, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightStatus.
@@ -669,6 +960,11 @@ func (in *FreightStatus) DeepCopy() *FreightStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitCommit) DeepCopyInto(out *GitCommit) {
 	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(PullRequestReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCommit.
@@ -733,6 +1029,21 @@ func (in *GitLabPullRequest) DeepCopy() *GitLabPullRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepoChange) DeepCopyInto(out *GitRepoChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoChange.
+func (in *GitRepoChange) DeepCopy() *GitRepoChange {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepoChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 	*out = *in
@@ -791,6 +1102,36 @@ func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowAuthors != nil {
+		in, out := &in.AllowAuthors, &out.AllowAuthors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreAuthors != nil {
+		in, out := &in.IgnoreAuthors, &out.IgnoreAuthors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowCommitters != nil {
+		in, out := &in.AllowCommitters, &out.AllowCommitters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreCommitters != nil {
+		in, out := &in.IgnoreCommitters, &out.IgnoreCommitters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreMessages != nil {
+		in, out := &in.IgnoreMessages, &out.IgnoreMessages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullRequestLabels != nil {
+		in, out := &in.PullRequestLabels, &out.PullRequestLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -919,6 +1260,21 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAttestation) DeepCopyInto(out *ImageAttestation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAttestation.
+func (in *ImageAttestation) DeepCopy() *ImageAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageDiscoveryResult) DeepCopyInto(out *ImageDiscoveryResult) {
 	*out = *in
@@ -942,17 +1298,56 @@ func (in *ImageDiscoveryResult) DeepCopy() *ImageDiscoveryResult {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
+func (in *ImageMetadataMatchExpression) DeepCopyInto(out *ImageMetadataMatchExpression) {
 	*out = *in
-	if in.IgnoreTags != nil {
-		in, out := &in.IgnoreTags, &out.IgnoreTags
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
-func (in *ImageSubscription) DeepCopy() *ImageSubscription {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageMetadataMatchExpression.
+func (in *ImageMetadataMatchExpression) DeepCopy() *ImageMetadataMatchExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageMetadataMatchExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
+	*out = *in
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelMatchExpressions != nil {
+		in, out := &in.LabelMatchExpressions, &out.LabelMatchExpressions
+		*out = make([]ImageMetadataMatchExpression, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnnotationMatchExpressions != nil {
+		in, out := &in.AnnotationMatchExpressions, &out.AnnotationMatchExpressions
+		*out = make([]ImageMetadataMatchExpression, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
+func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	if in == nil {
 		return nil
 	}
@@ -961,6 +1356,31 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(KeylessVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]ImageAttestation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KargoRenderImageUpdate) DeepCopyInto(out *KargoRenderImageUpdate) {
 	*out = *in
@@ -1008,6 +1428,41 @@ func (in *KargoRenderPromotionMechanism) DeepCopy() *KargoRenderPromotionMechani
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessIdentity) DeepCopyInto(out *KeylessIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessIdentity.
+func (in *KeylessIdentity) DeepCopy() *KeylessIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessVerification) DeepCopyInto(out *KeylessVerification) {
+	*out = *in
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]KeylessIdentity, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessVerification.
+func (in *KeylessVerification) DeepCopy() *KeylessVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeImageUpdate) DeepCopyInto(out *KustomizeImageUpdate) {
 	*out = *in
@@ -1055,6 +1510,68 @@ func (in *KustomizePromotionMechanism) DeepCopy() *KustomizePromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactDiscoveryResult) DeepCopyInto(out *OCIArtifactDiscoveryResult) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]DiscoveredOCIArtifactReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactDiscoveryResult.
+func (in *OCIArtifactDiscoveryResult) DeepCopy() *OCIArtifactDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSubscription) DeepCopyInto(out *OCIArtifactSubscription) {
+	*out = *in
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSubscription.
+func (in *OCIArtifactSubscription) DeepCopy() *OCIArtifactSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -1124,7 +1641,9 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	if in.PromotionPolicies != nil {
 		in, out := &in.PromotionPolicies, &out.PromotionPolicies
 		*out = make([]PromotionPolicy, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1180,6 +1699,23 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionFreeze) DeepCopyInto(out *PromotionFreeze) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionFreeze.
+func (in *PromotionFreeze) DeepCopy() *PromotionFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -1246,9 +1782,76 @@ func (in *PromotionMechanisms) DeepCopy() *PromotionMechanisms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionParallelStep) DeepCopyInto(out *PromotionParallelStep) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(PromotionStepRetry)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionParallelStep.
+func (in *PromotionParallelStep) DeepCopy() *PromotionParallelStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionParallelStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPlan) DeepCopyInto(out *PromotionPlan) {
+	*out = *in
+	if in.GitRepoChanges != nil {
+		in, out := &in.GitRepoChanges, &out.GitRepoChanges
+		*out = make([]GitRepoChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDAppChanges != nil {
+		in, out := &in.ArgoCDAppChanges, &out.ArgoCDAppChanges
+		*out = make([]ArgoCDAppChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPlan.
+func (in *PromotionPlan) DeepCopy() *PromotionPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Freezes != nil {
+		in, out := &in.Freezes, &out.Freezes
+		*out = make([]PromotionFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
@@ -1293,6 +1896,23 @@ func (in *PromotionReference) DeepCopy() *PromotionReference {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make([]PromotionVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.PromotionTemplate != nil {
+		in, out := &in.PromotionTemplate, &out.PromotionTemplate
+		*out = new(PromotionTemplateReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -1329,6 +1949,28 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExpandedTemplate != nil {
+		in, out := &in.ExpandedTemplate, &out.ExpandedTemplate
+		*out = new(ExpandedPromotionTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PromotionPlan)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -1341,6 +1983,258 @@ func (in *PromotionStatus) DeepCopy() *PromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStep) DeepCopyInto(out *PromotionStep) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(PromotionStepRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = new(PromotionStepGroup)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStep.
+func (in *PromotionStep) DeepCopy() *PromotionStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepGroup) DeepCopyInto(out *PromotionStepGroup) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionParallelStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepGroup.
+func (in *PromotionStepGroup) DeepCopy() *PromotionStepGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepRetry) DeepCopyInto(out *PromotionStepRetry) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepRetry.
+func (in *PromotionStepRetry) DeepCopy() *PromotionStepRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepStatus) DeepCopyInto(out *PromotionStepStatus) {
+	*out = *in
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextAttempt != nil {
+		in, out := &in.NextAttempt, &out.NextAttempt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepStatus.
+func (in *PromotionStepStatus) DeepCopy() *PromotionStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplate) DeepCopyInto(out *PromotionTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplate.
+func (in *PromotionTemplate) DeepCopy() *PromotionTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplateList) DeepCopyInto(out *PromotionTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PromotionTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateList.
+func (in *PromotionTemplateList) DeepCopy() *PromotionTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplateParameter) DeepCopyInto(out *PromotionTemplateParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateParameter.
+func (in *PromotionTemplateParameter) DeepCopy() *PromotionTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplateReference) DeepCopyInto(out *PromotionTemplateReference) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]PromotionVariable, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateReference.
+func (in *PromotionTemplateReference) DeepCopy() *PromotionTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplateSpec) DeepCopyInto(out *PromotionTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]PromotionTemplateParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateSpec.
+func (in *PromotionTemplateSpec) DeepCopy() *PromotionTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionVariable) DeepCopyInto(out *PromotionVariable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionVariable.
+func (in *PromotionVariable) DeepCopy() *PromotionVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWindow) DeepCopyInto(out *PromotionWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWindow.
+func (in *PromotionWindow) DeepCopy() *PromotionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestPromotionMechanism) DeepCopyInto(out *PullRequestPromotionMechanism) {
 	*out = *in
@@ -1354,6 +2248,11 @@ func (in *PullRequestPromotionMechanism) DeepCopyInto(out *PullRequestPromotionM
 		*out = new(GitLabPullRequest)
 		**out = **in
 	}
+	if in.Bitbucket != nil {
+		in, out := &in.Bitbucket, &out.Bitbucket
+		*out = new(BitbucketPullRequest)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestPromotionMechanism.
@@ -1366,6 +2265,21 @@ func (in *PullRequestPromotionMechanism) DeepCopy() *PullRequestPromotionMechani
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestReference) DeepCopyInto(out *PullRequestReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestReference.
+func (in *PullRequestReference) DeepCopy() *PullRequestReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	*out = *in
@@ -1382,7 +2296,17 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartSubscription)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactSubscription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSubscription)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1396,6 +2320,36 @@ func (in *RepoSubscription) DeepCopy() *RepoSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackPolicy) DeepCopyInto(out *RollbackPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackPolicy.
+func (in *RollbackPolicy) DeepCopy() *RollbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolledBackStage) DeepCopyInto(out *RolledBackStage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolledBackStage.
+func (in *RolledBackStage) DeepCopy() *RolledBackStage {
+	if in == nil {
+		return nil
+	}
+	out := new(RolledBackStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -1470,11 +2424,33 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(PromotionMechanisms)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PromotionTemplate != nil {
+		in, out := &in.PromotionTemplate, &out.PromotionTemplate
+		*out = new(PromotionTemplateReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make([]PromotionVariable, len(*in))
+		copy(*out, *in)
+	}
 	if in.Verification != nil {
 		in, out := &in.Verification, &out.Verification
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RollbackPolicy != nil {
+		in, out := &in.RollbackPolicy, &out.RollbackPolicy
+		*out = new(RollbackPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -1692,6 +2668,11 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreightMatchRules != nil {
+		in, out := &in.FreightMatchRules, &out.FreightMatchRules
+		*out = make([]FreightMatchRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
@@ -1709,7 +2690,7 @@ func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -20,6 +20,7 @@ import (
 	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/commitstatuses"
 	"github.com/akuity/kargo/internal/controller/promotions"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/stages"
@@ -31,6 +32,9 @@ import (
 	"github.com/akuity/kargo/internal/types"
 	versionpkg "github.com/akuity/kargo/internal/version"
 
+	_ "github.com/akuity/kargo/internal/gitprovider/azure"
+	_ "github.com/akuity/kargo/internal/gitprovider/bitbucket"
+	_ "github.com/akuity/kargo/internal/gitprovider/gitea"
 	_ "github.com/akuity/kargo/internal/gitprovider/github"
 )
 
@@ -295,11 +299,23 @@ func (o *controllerOptions) setupReconcilers(
 	if err := warehouses.SetupReconcilerWithManager(
 		kargoMgr,
 		credentialsDB,
-		o.ShardName,
+		warehouses.ReconcilerConfigFromEnv(),
 	); err != nil {
 		return fmt.Errorf("error setting up Warehouses reconciler: %w", err)
 	}
 
+	commitStatusesReconcilerCfg := commitstatuses.ReconcilerConfigFromEnv()
+	if commitStatusesReconcilerCfg.Enabled {
+		if err := commitstatuses.SetupReconcilerWithManager(
+			ctx,
+			kargoMgr,
+			credentialsDB,
+			commitStatusesReconcilerCfg,
+		); err != nil {
+			return fmt.Errorf("error setting up commit status reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/akuity/kargo/internal/webhook/freight"
 	"github.com/akuity/kargo/internal/webhook/project"
 	"github.com/akuity/kargo/internal/webhook/promotion"
+	"github.com/akuity/kargo/internal/webhook/promotiontemplate"
 	"github.com/akuity/kargo/internal/webhook/stage"
 	"github.com/akuity/kargo/internal/webhook/warehouse"
 )
@@ -123,6 +124,9 @@ func (o *webhooksServerOptions) run(ctx context.Context) error {
 	if err = promotion.SetupWebhookWithManager(ctx, webhookCfg, mgr); err != nil {
 		return fmt.Errorf("setup Promotion webhook: %w", err)
 	}
+	if err = promotiontemplate.SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("setup PromotionTemplate webhook: %w", err)
+	}
 	if err = stage.SetupWebhookWithManager(webhookCfg, mgr); err != nil {
 		return fmt.Errorf("setup Stage webhook: %w", err)
 	}
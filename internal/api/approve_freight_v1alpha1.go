@@ -109,6 +109,9 @@ func (s *server) ApproveFreight(
 	}
 
 	newStatus.ApprovedFor[stageName] = kargoapi.ApprovedStage{}
+	// Explicit approval makes Freight that was previously rolled back from the
+	// Stage eligible for promotion to it again.
+	delete(newStatus.RolledBackFrom, stageName)
 
 	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
 		return nil, fmt.Errorf("patch status: %w", err)
@@ -371,6 +371,71 @@ func TestApproveFreight(t *testing.T) {
 				require.Equal(t, kargoapi.EventReasonFreightApproved, event.Reason)
 			},
 		},
+		{
+			name: "re-approval of rolled back Freight",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							RolledBackFrom: map[string]kargoapi.RolledBackStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					newStatus kargoapi.FreightStatus,
+				) error {
+					if _, ok := newStatus.RolledBackFrom["fake-stage"]; ok {
+						return errors.New("rollback not cleared")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonFreightApproved, event.Reason)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/oidc"
+	"github.com/akuity/kargo/internal/api/receiver"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
 )
@@ -25,6 +26,7 @@ type ServerConfig struct {
 	OIDCConfig                  *oidc.Config
 	AdminConfig                 *AdminConfig
 	DexProxyConfig              *dex.ProxyConfig
+	WebhookReceiverConfig       *receiver.Config
 	ArgoCDConfig                ArgoCDConfig
 	PermissiveCORSPolicyEnabled bool
 	RolloutsIntegrationEnabled  bool
@@ -50,6 +52,10 @@ func ServerConfigFromEnv() ServerConfig {
 		dexProxyCfg := dex.ProxyConfigFromEnv()
 		cfg.DexProxyConfig = &dexProxyCfg
 	}
+	if types.MustParseBool(os.GetEnv("WEBHOOK_RECEIVER_ENABLED", "false")) {
+		receiverCfg := receiver.ConfigFromEnv()
+		cfg.WebhookReceiverConfig = &receiverCfg
+	}
 	envconfig.MustProcess("", &cfg.ArgoCDConfig)
 	cfg.PermissiveCORSPolicyEnabled =
 		types.MustParseBool(os.GetEnv("PERMISSIVE_CORS_POLICY_ENABLED", "false"))
@@ -67,13 +67,14 @@ func (s *server) validateCredentials(creds credentials) error {
 		return err
 	}
 	switch creds.credType {
-	case kargoapi.CredentialTypeLabelValueGit,
+	case kargoapi.CredentialTypeLabelValueFile,
+		kargoapi.CredentialTypeLabelValueGit,
 		kargoapi.CredentialTypeLabelValueHelm,
 		kargoapi.CredentialTypeLabelValueImage:
 	default:
 		return connect.NewError(
 			connect.CodeInvalidArgument,
-			errors.New("type should be one of git, helm, or image"),
+			errors.New("type should be one of file, git, helm, or image"),
 		)
 	}
 	if creds.repoURL == "" {
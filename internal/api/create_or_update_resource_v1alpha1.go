@@ -61,6 +61,16 @@ func (s *server) createOrUpdateResource(
 	}
 
 	if existingObj == nil { // Create the resource
+		// The API server creates the resource using its own identity, so the
+		// Promotion webhook cannot enforce the promotion policy on the user's
+		// behalf.
+		if err := s.checkPromotionPolicyForObject(ctx, obj); err != nil {
+			return &svcv1alpha1.CreateOrUpdateResourceResult{
+				Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
+					Error: fmt.Errorf("create resource: %w", err).Error(),
+				},
+			}, err
+		}
 		if err := s.client.Create(ctx, obj); err != nil {
 			return &svcv1alpha1.CreateOrUpdateResourceResult{
 				Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
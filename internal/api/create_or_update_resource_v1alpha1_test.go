@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateOrUpdateResource_PromotionPolicy(t *testing.T) {
+	testNow := time.Date(2024, 12, 24, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			SkipAuthorization: true,
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+	s := &server{
+		client: kubeClient,
+		getProjectFn: func(
+			context.Context,
+			client.Client,
+			string,
+		) (*kargoapi.Project, error) {
+			return &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						Stage: "fake-stage",
+						Freezes: []kargoapi.PromotionFreeze{{
+							Start:  metav1.NewTime(testNow.Add(-time.Hour)),
+							End:    metav1.NewTime(testNow.Add(time.Hour)),
+							Reason: "holidays",
+						}},
+					}},
+				},
+			}, nil
+		},
+		nowFn: func() time.Time {
+			return testNow
+		},
+	}
+	s.checkPromotionPolicyFn = s.checkPromotionPolicy
+
+	_, err = s.CreateOrUpdateResource(
+		ctx,
+		connect.NewRequest(&svcv1alpha1.CreateOrUpdateResourceRequest{
+			Manifest: []byte(`apiVersion: kargo.akuity.io/v1alpha1
+kind: Promotion
+metadata:
+  namespace: fake-namespace
+  name: fake-promotion
+spec:
+  stage: fake-stage
+  freight: fake-freight
+`),
+		}),
+	)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	require.ErrorContains(t, err, "holidays")
+}
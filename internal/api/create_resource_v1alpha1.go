@@ -77,6 +77,17 @@ func (s *server) createResource(
 	// If we get to here, the resource does not already exists, so we can create
 	// it.
 
+	// The API server creates the resource using its own identity, so the
+	// Promotion webhook cannot enforce the promotion policy on the user's
+	// behalf.
+	if err = s.checkPromotionPolicyForObject(ctx, obj); err != nil {
+		return &svcv1alpha1.CreateResourceResult{
+			Result: &svcv1alpha1.CreateResourceResult_Error{
+				Error: fmt.Errorf("create resource: %w", err).Error(),
+			},
+		}, err
+	}
+
 	if err = s.client.Create(ctx, obj); err != nil {
 		return &svcv1alpha1.CreateResourceResult{
 			Result: &svcv1alpha1.CreateResourceResult_Error{
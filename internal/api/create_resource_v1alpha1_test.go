@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateResource_PromotionPolicy(t *testing.T) {
+	testNow := time.Date(2024, 12, 24, 12, 0, 0, 0, time.UTC)
+	frozenProject := &kargoapi.Project{
+		Spec: &kargoapi.ProjectSpec{
+			PromotionPolicies: []kargoapi.PromotionPolicy{{
+				Stage: "fake-stage",
+				Freezes: []kargoapi.PromotionFreeze{{
+					Start:  metav1.NewTime(testNow.Add(-time.Hour)),
+					End:    metav1.NewTime(testNow.Add(time.Hour)),
+					Reason: "holidays",
+				}},
+			}},
+		},
+	}
+	// newPromoManifest returns the manifest of a Promotion to the frozen Stage
+	// with the provided break-glass annotation, if any.
+	newPromoManifest := func(breakGlass string, dryRun bool) string {
+		manifest := `apiVersion: kargo.akuity.io/v1alpha1
+kind: Promotion
+metadata:
+  namespace: fake-namespace
+  name: fake-promotion
+`
+		if breakGlass != "" {
+			manifest += fmt.Sprintf("  annotations:\n    %s: %s\n", kargoapi.AnnotationKeyBreakGlass, breakGlass)
+		}
+		manifest += `spec:
+  stage: fake-stage
+  freight: fake-freight
+`
+		if dryRun {
+			manifest += "  dryRun: true\n"
+		}
+		return manifest
+	}
+	testCases := []struct {
+		name       string
+		manifest   string
+		authorized bool
+		assertions func(*testing.T, error, client.Client)
+	}{
+		{
+			name:     "frozen",
+			manifest: newPromoManifest("", false),
+			assertions: func(t *testing.T, err error, c client.Client) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, "holidays")
+				promo := &kargoapi.Promotion{}
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: "fake-namespace", Name: "fake-promotion"},
+					promo,
+				)
+				require.Error(t, err)
+			},
+		},
+		{
+			name:     "frozen dry run",
+			manifest: newPromoManifest("", true),
+			assertions: func(t *testing.T, err error, _ client.Client) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "frozen with break-glass by unauthorized user",
+			manifest: newPromoManifest("hotfix", false),
+			assertions: func(t *testing.T, err error, _ client.Client) {
+				require.ErrorContains(t, err, "not authorized")
+			},
+		},
+		{
+			name:       "frozen with break-glass by authorized user",
+			manifest:   newPromoManifest("hotfix", false),
+			authorized: true,
+			assertions: func(t *testing.T, err error, c client.Client) {
+				require.NoError(t, err)
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: "fake-namespace", Name: "fake-promotion"},
+					promo,
+				))
+				require.Equal(t, "hotfix", promo.Annotations[kargoapi.AnnotationKeyBreakGlass])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			var internalClient client.Client
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						internalClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			s := &server{
+				client: kubeClient,
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return frozenProject, nil
+				},
+				nowFn: func() time.Time {
+					return testNow
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					if !testCase.authorized {
+						return errors.New("not authorized")
+					}
+					return nil
+				},
+			}
+			s.checkPromotionPolicyFn = s.checkPromotionPolicy
+			_, err = s.CreateResource(
+				ctx,
+				connect.NewRequest(&svcv1alpha1.CreateResourceRequest{
+					Manifest: []byte(testCase.manifest),
+				}),
+			)
+			testCase.assertions(t, err, internalClient)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/receiver"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -39,6 +40,11 @@ func (s *server) GetProject(
 		return nil, err
 	}
 
+	receiverURL, err := s.getProjectReceiverURL(ctx, name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	switch req.Msg.GetFormat() {
 	case svcv1alpha1.RawFormat_RAW_FORMAT_JSON, svcv1alpha1.RawFormat_RAW_FORMAT_YAML:
 		_, raw, err := objectOrRaw(&u, req.Msg.GetFormat())
@@ -49,6 +55,7 @@ func (s *server) GetProject(
 			Result: &svcv1alpha1.GetProjectResponse_Raw{
 				Raw: raw,
 			},
+			ReceiverUrl: receiverURL,
 		}), nil
 	default:
 		p := kargoapi.Project{}
@@ -63,6 +70,24 @@ func (s *server) GetProject(
 			Result: &svcv1alpha1.GetProjectResponse_Project{
 				Project: obj,
 			},
+			ReceiverUrl: receiverURL,
 		}), nil
 	}
 }
+
+// getProjectReceiverURL returns the URL of the webhook receiver for the
+// specified Project. An empty string is returned if the webhook receiver is
+// not enabled or the Project has no receiver secret.
+func (s *server) getProjectReceiverURL(ctx context.Context, project string) (string, error) {
+	if s.cfg.WebhookReceiverConfig == nil {
+		return "", nil
+	}
+	// The receiver secret is read using the internal client because the URL
+	// only grants the ability to trigger Warehouse refreshes, which should not
+	// require permission to read Secrets in the Project namespace.
+	secret, err := receiver.GetSecret(ctx, s.client.InternalClient(), project)
+	if err != nil || secret == nil {
+		return "", err
+	}
+	return receiver.URL(s.cfg.WebhookReceiverConfig.BaseURL, project, secret), nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,13 +17,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/receiver"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
 func TestGetProject(t *testing.T) {
 	testCases := map[string]struct {
 		req         *svcv1alpha1.GetProjectRequest
+		cfg         config.ServerConfig
 		objects     []client.Object
 		interceptor interceptor.Funcs
 		assertions  func(*testing.T, *connect.Response[svcv1alpha1.GetProjectResponse], error)
@@ -98,6 +102,65 @@ func TestGetProject(t *testing.T) {
 
 				require.NotNil(t, c.Msg.GetProject())
 				require.Equal(t, "kargo-demo", c.Msg.GetProject().Name)
+				require.Empty(t, c.Msg.GetReceiverUrl())
+			},
+		},
+		"existing Project without receiver secret": {
+			req: &svcv1alpha1.GetProjectRequest{
+				Name: "kargo-demo",
+			},
+			cfg: config.ServerConfig{
+				WebhookReceiverConfig: &receiver.Config{
+					BaseURL: "https://kargo.example.com",
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "kargo-demo",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c *connect.Response[svcv1alpha1.GetProjectResponse], err error) {
+				require.NoError(t, err)
+				require.NotNil(t, c)
+				require.Empty(t, c.Msg.GetReceiverUrl())
+			},
+		},
+		"existing Project with receiver": {
+			req: &svcv1alpha1.GetProjectRequest{
+				Name: "kargo-demo",
+			},
+			cfg: config.ServerConfig{
+				WebhookReceiverConfig: &receiver.Config{
+					BaseURL: "https://kargo.example.com",
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "kargo-demo",
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kargo-demo",
+						Name:      receiver.SecretName,
+					},
+					Data: map[string][]byte{
+						receiver.SecretDataKey: []byte("fake-secret"),
+					},
+				},
+			},
+			assertions: func(t *testing.T, c *connect.Response[svcv1alpha1.GetProjectResponse], err error) {
+				require.NoError(t, err)
+				require.NotNil(t, c)
+				require.NotNil(t, c.Msg.GetProject())
+				require.Equal(
+					t,
+					receiver.URL("https://kargo.example.com", "kargo-demo", []byte("fake-secret")),
+					c.Msg.GetReceiverUrl(),
+				)
 			},
 		},
 		"raw format JSON": {
@@ -204,6 +267,7 @@ func TestGetProject(t *testing.T) {
 
 			svr := &server{
 				client: client,
+				cfg:    testCase.cfg,
 			}
 			res, err := (svr).GetProject(ctx, connect.NewRequest(testCase.req))
 			testCase.assertions(t, res, err)
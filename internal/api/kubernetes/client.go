@@ -251,6 +251,9 @@ func newDefaultInternalClient(
 	if err = kubeclient.IndexEventsByInvolvedObjectAPIGroup(ctx, cluster); err != nil {
 		return nil, fmt.Errorf("error indexing Events by InvolvedObject's API group: %w", err)
 	}
+	if err = kubeclient.IndexWarehousesBySubscribedURLs(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("error indexing Warehouses by subscribed URLs: %w", err)
+	}
 
 	go func() {
 		err = cluster.Start(ctx)
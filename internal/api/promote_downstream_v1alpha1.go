@@ -128,17 +128,31 @@ func (s *server) PromoteDownstream(
 		); err != nil {
 			return nil, err
 		}
+		if downstream.IsControlFlow() {
+			continue
+		}
+		if err := s.checkPromotionPolicyFn(
+			ctx,
+			&downstream,
+			req.Msg.GetBreakGlass(),
+		); err != nil {
+			return nil, err
+		}
 	}
 
 	promoteErrs := make([]error, 0, len(downstreams))
 	createdPromos := make([]*kargoapi.Promotion, 0, len(downstreams))
 	for _, downstream := range downstreams {
 		newPromo := kargo.NewPromotion(ctx, downstream, freight.Name)
-		if downstream.Spec.PromotionMechanisms == nil {
+		if downstream.IsControlFlow() {
 			// Avoid creating a Promotion if the downstream Stage has no
-			// PromotionMechanisms, and is a "control flow" Stage.
+			// PromotionMechanisms, Steps or PromotionTemplate, and is a
+			// "control flow" Stage.
 			continue
 		}
+		if breakGlass := req.Msg.GetBreakGlass(); breakGlass != "" {
+			setBreakGlassAnnotation(&newPromo, breakGlass)
+		}
 		if err := s.createPromotionFn(ctx, &newPromo); err != nil {
 			promoteErrs = append(promoteErrs, err)
 			continue
@@ -411,6 +411,165 @@ func TestPromoteDownstream(t *testing.T) {
 				require.Equal(t, "not authorized", err.Error())
 			},
 		},
+		{
+			name: "promotion not permitted by promotion policy",
+			req: &svcv1alpha1.PromoteDownstreamRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+					return true
+				},
+				findDownstreamStagesFn: func(context.Context, *kargoapi.Stage) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							Spec: kargoapi.StageSpec{
+								PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return connect.NewError(
+						connect.CodePermissionDenied,
+						errors.New("not permitted"),
+					)
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Promotion should not be created")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteDownstreamResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "break-glass",
+			req: &svcv1alpha1.PromoteDownstreamRequest{
+				Project:    "fake-project",
+				Stage:      "fake-stage",
+				Freight:    "fake-freight",
+				BreakGlass: "hotfix",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+					return true
+				},
+				findDownstreamStagesFn: func(context.Context, *kargoapi.Stage) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							Spec: kargoapi.StageSpec{
+								PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				checkPromotionPolicyFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					breakGlass string,
+				) error {
+					if breakGlass != "hotfix" {
+						return errors.New("break-glass reason not passed on")
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteDownstreamResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetPromotions(), 1)
+				require.Equal(
+					t,
+					"hotfix",
+					res.Msg.GetPromotions()[0].Annotations[kargoapi.AnnotationKeyBreakGlass],
+				)
+			},
+		},
 		{
 			name: "error creating Promotion",
 			req: &svcv1alpha1.PromoteDownstreamRequest{
@@ -459,6 +618,13 @@ func TestPromoteDownstream(t *testing.T) {
 				) error {
 					return nil
 				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -528,6 +694,13 @@ func TestPromoteDownstream(t *testing.T) {
 				) error {
 					return nil
 				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
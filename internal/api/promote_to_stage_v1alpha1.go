@@ -121,11 +121,26 @@ func (s *server) PromoteToStage(
 		return nil, err
 	}
 
+	// A dry run does not promote anything, so it is not subject to the
+	// promotion windows and freezes of the Project.
+	breakGlass := req.Msg.GetBreakGlass()
+	if !req.Msg.GetDryRun() {
+		if err := s.checkPromotionPolicyFn(ctx, stage, breakGlass); err != nil {
+			return nil, err
+		}
+	}
+
 	promotion := kargo.NewPromotion(ctx, *stage, freight.Name)
+	promotion.Spec.DryRun = req.Msg.GetDryRun()
+	if breakGlass != "" {
+		setBreakGlassAnnotation(&promotion, breakGlass)
+	}
 	if err := s.createPromotionFn(ctx, &promotion); err != nil {
 		return nil, fmt.Errorf("create promotion: %w", err)
 	}
-	s.recordPromotionCreatedEvent(ctx, &promotion, freight)
+	if !promotion.Spec.DryRun {
+		s.recordPromotionCreatedEvent(ctx, &promotion, freight)
+	}
 	return connect.NewResponse(&svcv1alpha1.PromoteToStageResponse{
 		Promotion: &promotion,
 	}), nil
@@ -317,6 +317,139 @@ func TestPromoteToStage(t *testing.T) {
 				require.Equal(t, "not authorized", err.Error())
 			},
 		},
+		{
+			name: "promotion not permitted by promotion policy",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return connect.NewError(
+						connect.CodePermissionDenied,
+						errors.New("not permitted"),
+					)
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "break-glass",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project:    "fake-project",
+				Stage:      "fake-stage",
+				Freight:    "fake-freight",
+				BreakGlass: "hotfix",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				checkPromotionPolicyFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					breakGlass string,
+				) error {
+					if breakGlass != "hotfix" {
+						return errors.New("break-glass reason not passed on")
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"hotfix",
+					res.Msg.GetPromotion().Annotations[kargoapi.AnnotationKeyBreakGlass],
+				)
+			},
+		},
 		{
 			name: "error creating Promotion",
 			req: &svcv1alpha1.PromoteToStageRequest{
@@ -356,6 +489,13 @@ func TestPromoteToStage(t *testing.T) {
 				) error {
 					return nil
 				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -413,6 +553,13 @@ func TestPromoteToStage(t *testing.T) {
 				) error {
 					return nil
 				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -436,6 +583,74 @@ func TestPromoteToStage(t *testing.T) {
 				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
 			},
 		},
+		{
+			name: "dry run",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				DryRun:  true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				checkPromotionPolicyFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					string,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.NotNil(t, res.Msg.GetPromotion())
+				require.True(t, res.Msg.GetPromotion().Spec.DryRun)
+				require.Empty(t, recorder.Events)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
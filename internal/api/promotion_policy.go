@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// breakGlassVerb is the custom verb on Stages a user must be permitted to use
+// in order to promote to a Stage while the promotion policy of its Project does
+// not permit it.
+const breakGlassVerb = "break-glass"
+
+// checkPromotionPolicy returns an error if the PromotionPolicy of the Project
+// does not currently permit promotion to the provided Stage. If a reason for
+// breaking the glass is provided, promotion is permitted regardless, provided
+// the user is permitted to break-glass the Stage.
+//
+// Promotions created by the API server are exempt from the promotion policy
+// check performed by the Promotion webhook, as the webhook only knows the
+// identity of the API server and not that of the user on whose behalf it
+// creates them. This is why the policy is enforced here instead.
+func (s *server) checkPromotionPolicy(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	breakGlass string,
+) error {
+	project, err := s.getProjectFn(ctx, s.client.InternalClient(), stage.Namespace)
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+	if project == nil {
+		return nil
+	}
+	policy := project.GetPromotionPolicy(stage.Name)
+	if policy == nil {
+		return nil
+	}
+	permitted, reason, err := policy.IsPromotionPermitted(s.nowFn())
+	if err != nil {
+		return fmt.Errorf("evaluate promotion policy: %w", err)
+	}
+	if permitted {
+		return nil
+	}
+
+	if breakGlass == "" {
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf(
+				"promotion to Stage %q is not permitted at this time: %s",
+				stage.Name,
+				reason,
+			),
+		)
+	}
+
+	if err = s.authorizeFn(
+		ctx,
+		breakGlassVerb,
+		kargoapi.GroupVersion.WithResource("stages"),
+		"",
+		types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      stage.Name,
+		},
+	); err != nil {
+		return err
+	}
+
+	logging.LoggerFromContext(ctx).Info(
+		"promotion policy overridden by break-glass request",
+		"namespace", stage.Namespace,
+		"stage", stage.Name,
+		"reason", reason,
+		"breakGlass", breakGlass,
+	)
+	return nil
+}
+
+// checkPromotionPolicyForObject returns an error if the provided object is a
+// Promotion that the PromotionPolicy of its Project does not currently permit.
+// This applies the promotion policy to Promotions created from manifests, whose
+// reason for breaking the glass, if any, is taken from their break-glass
+// annotation.
+func (s *server) checkPromotionPolicyForObject(
+	ctx context.Context,
+	obj *unstructured.Unstructured,
+) error {
+	if obj.GroupVersionKind().GroupKind() != kargoapi.GroupVersion.WithKind("Promotion").GroupKind() {
+		return nil
+	}
+	promo := &kargoapi.Promotion{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, promo); err != nil {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("convert Promotion: %w", err),
+		)
+	}
+	// A dry run does not promote anything, so it is not subject to the
+	// promotion windows and freezes of the Project.
+	if promo.Spec.DryRun {
+		return nil
+	}
+	return s.checkPromotionPolicyFn(
+		ctx,
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+		},
+		promo.Annotations[kargoapi.AnnotationKeyBreakGlass],
+	)
+}
+
+// setBreakGlassAnnotation records the provided reason for breaking the glass on
+// the provided Promotion.
+func setBreakGlassAnnotation(promo *kargoapi.Promotion, breakGlass string) {
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	promo.Annotations[kargoapi.AnnotationKeyBreakGlass] = breakGlass
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+)
+
+func TestCheckPromotionPolicy(t *testing.T) {
+	testNow := time.Date(2024, 12, 24, 12, 0, 0, 0, time.UTC)
+	frozenProject := &kargoapi.Project{
+		Spec: &kargoapi.ProjectSpec{
+			PromotionPolicies: []kargoapi.PromotionPolicy{{
+				Stage: "fake-stage",
+				Freezes: []kargoapi.PromotionFreeze{{
+					Start:  metav1.NewTime(testNow.Add(-time.Hour)),
+					End:    metav1.NewTime(testNow.Add(time.Hour)),
+					Reason: "holidays",
+				}},
+			}},
+		},
+	}
+	testCases := []struct {
+		name       string
+		project    *kargoapi.Project
+		breakGlass string
+		authorized bool
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "Project not found",
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "no promotion policy for Stage",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						Stage: "other-stage",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "frozen",
+			project: frozenProject,
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, "is not permitted at this time")
+				require.ErrorContains(t, err, "holidays")
+			},
+		},
+		{
+			name:       "frozen with break-glass by unauthorized user",
+			project:    frozenProject,
+			breakGlass: "hotfix",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "not authorized")
+			},
+		},
+		{
+			name:       "frozen with break-glass by authorized user",
+			project:    frozenProject,
+			breakGlass: "hotfix",
+			authorized: true,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			s := &server{
+				client: kubeClient,
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return testCase.project, nil
+				},
+				nowFn: func() time.Time {
+					return testNow
+				},
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					_ schema.GroupVersionResource,
+					_ string,
+					key client.ObjectKey,
+				) error {
+					require.Equal(t, breakGlassVerb, verb)
+					require.Equal(t, "fake-stage", key.Name)
+					if !testCase.authorized {
+						return errors.New("not authorized")
+					}
+					return nil
+				},
+			}
+			testCase.assertions(
+				t,
+				s.checkPromotionPolicy(
+					ctx,
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-stage",
+						},
+					},
+					testCase.breakGlass,
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package receiver
+
+import "github.com/kelseyhightower/envconfig"
+
+// Config represents configuration for the webhook receiver.
+type Config struct {
+	// BaseURL is the externally reachable base URL of the API server, used to
+	// construct per-Project receiver URLs. e.g. https://kargo.example.com
+	BaseURL string `envconfig:"WEBHOOK_RECEIVER_BASE_URL" required:"true"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
@@ -0,0 +1,257 @@
+package receiver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	providerDockerHub = "dockerhub"
+	providerECR       = "ecr"
+	providerGitHub    = "github"
+	providerGitLab    = "gitlab"
+	providerHarbor    = "harbor"
+	providerQuay      = "quay"
+)
+
+// provider encapsulates the handling of events sent by a single Git hosting
+// provider or container registry.
+type provider struct {
+	name string
+	// verify verifies that the event was sent by a sender in possession of the
+	// provided receiver secret. It is nil for providers that do not sign their
+	// events, in which case the token embedded in the receiver URL is the only
+	// means of authentication.
+	verify func(header http.Header, body, secret []byte) error
+	// parse returns the repositories referenced by the event. Events that are
+	// not of interest yield no repositories.
+	parse func(header http.Header, body []byte) (repoRefs, error)
+}
+
+// providers maps the names by which providers are referenced in receiver URLs
+// to the providers themselves.
+var providers = map[string]*provider{
+	providerGitHub: {
+		name:   providerGitHub,
+		verify: verifyGitHubSignature,
+		parse:  parseGitHubEvent,
+	},
+	providerGitLab: {
+		name:   providerGitLab,
+		verify: verifyTokenHeader("X-Gitlab-Token"),
+		parse:  parseGitLabEvent,
+	},
+	// ECR does not send webhooks itself. Its events are expected to be relayed
+	// by an EventBridge API destination configured to send the receiver secret
+	// in the Authorization header.
+	providerECR: {
+		name:   providerECR,
+		verify: verifyTokenHeader("Authorization"),
+		parse:  parseECREvent,
+	},
+	providerHarbor: {
+		name:   providerHarbor,
+		verify: verifyTokenHeader("Authorization"),
+		parse:  parseHarborEvent,
+	},
+	// Quay and Docker Hub do not sign their events.
+	providerQuay: {
+		name:  providerQuay,
+		parse: parseQuayEvent,
+	},
+	providerDockerHub: {
+		name:  providerDockerHub,
+		parse: parseDockerHubEvent,
+	},
+}
+
+// verifyGitHubSignature verifies the HMAC-SHA256 signature GitHub sends in
+// the X-Hub-Signature-256 header.
+func verifyGitHubSignature(header http.Header, body, secret []byte) error {
+	sig, ok := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+	if !ok {
+		return errors.New("missing or malformed X-Hub-Signature-256 header")
+	}
+	decoded, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.New("missing or malformed X-Hub-Signature-256 header")
+	}
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	if !hmac.Equal(decoded, mac.Sum(nil)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+// verifyTokenHeader returns a function that verifies that the named header
+// contains the receiver secret. This is used for providers that authenticate
+// events using a shared token instead of a signature.
+func verifyTokenHeader(name string) func(http.Header, []byte, []byte) error {
+	return func(header http.Header, _, secret []byte) error {
+		value := header.Get(name)
+		if value == "" {
+			return fmt.Errorf("missing %s header", name)
+		}
+		if !hmac.Equal([]byte(value), secret) {
+			return fmt.Errorf("invalid %s header", name)
+		}
+		return nil
+	}
+}
+
+func parseGitHubEvent(header http.Header, body []byte) (repoRefs, error) {
+	switch header.Get("X-GitHub-Event") {
+	case "push":
+		payload := struct {
+			Repository struct {
+				CloneURL string `json:"clone_url"`
+				HTMLURL  string `json:"html_url"`
+				SSHURL   string `json:"ssh_url"`
+			} `json:"repository"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return repoRefs{}, fmt.Errorf("error unmarshaling GitHub push event: %w", err)
+		}
+		return repoRefs{
+			git: nonEmpty(
+				payload.Repository.CloneURL,
+				payload.Repository.HTMLURL,
+				payload.Repository.SSHURL,
+			),
+		}, nil
+	case "package":
+		payload := struct {
+			Package struct {
+				PackageType    string `json:"package_type"`
+				PackageVersion struct {
+					PackageURL string `json:"package_url"`
+				} `json:"package_version"`
+			} `json:"package"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return repoRefs{}, fmt.Errorf("error unmarshaling GitHub package event: %w", err)
+		}
+		if !strings.EqualFold(payload.Package.PackageType, "container") {
+			return repoRefs{}, nil
+		}
+		return repoRefs{
+			images: nonEmpty(payload.Package.PackageVersion.PackageURL),
+		}, nil
+	}
+	// Other events (e.g. ping) are acknowledged but otherwise ignored.
+	return repoRefs{}, nil
+}
+
+func parseGitLabEvent(header http.Header, body []byte) (repoRefs, error) {
+	switch header.Get("X-Gitlab-Event") {
+	case "Push Hook", "Tag Push Hook":
+		payload := struct {
+			Project struct {
+				GitHTTPURL string `json:"git_http_url"`
+				GitSSHURL  string `json:"git_ssh_url"`
+			} `json:"project"`
+		}{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return repoRefs{}, fmt.Errorf("error unmarshaling GitLab push event: %w", err)
+		}
+		return repoRefs{
+			git: nonEmpty(payload.Project.GitHTTPURL, payload.Project.GitSSHURL),
+		}, nil
+	}
+	return repoRefs{}, nil
+}
+
+func parseHarborEvent(_ http.Header, body []byte) (repoRefs, error) {
+	payload := struct {
+		Type      string `json:"type"`
+		EventData struct {
+			Resources []struct {
+				ResourceURL string `json:"resource_url"`
+			} `json:"resources"`
+		} `json:"event_data"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return repoRefs{}, fmt.Errorf("error unmarshaling Harbor event: %w", err)
+	}
+	if payload.Type != "PUSH_ARTIFACT" {
+		return repoRefs{}, nil
+	}
+	refs := repoRefs{}
+	for _, resource := range payload.EventData.Resources {
+		if resource.ResourceURL != "" {
+			refs.images = append(refs.images, resource.ResourceURL)
+		}
+	}
+	return refs, nil
+}
+
+func parseQuayEvent(_ http.Header, body []byte) (repoRefs, error) {
+	payload := struct {
+		DockerURL string `json:"docker_url"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return repoRefs{}, fmt.Errorf("error unmarshaling Quay event: %w", err)
+	}
+	return repoRefs{images: nonEmpty(payload.DockerURL)}, nil
+}
+
+func parseDockerHubEvent(_ http.Header, body []byte) (repoRefs, error) {
+	payload := struct {
+		Repository struct {
+			RepoName string `json:"repo_name"`
+		} `json:"repository"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return repoRefs{}, fmt.Errorf("error unmarshaling Docker Hub event: %w", err)
+	}
+	return repoRefs{images: nonEmpty(payload.Repository.RepoName)}, nil
+}
+
+func parseECREvent(_ http.Header, body []byte) (repoRefs, error) {
+	payload := struct {
+		Account string `json:"account"`
+		Region  string `json:"region"`
+		Detail  struct {
+			ActionType     string `json:"action-type"`
+			Result         string `json:"result"`
+			RepositoryName string `json:"repository-name"`
+		} `json:"detail"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return repoRefs{}, fmt.Errorf("error unmarshaling ECR event: %w", err)
+	}
+	if payload.Detail.ActionType != "PUSH" || payload.Detail.Result != "SUCCESS" {
+		return repoRefs{}, nil
+	}
+	if payload.Account == "" || payload.Region == "" || payload.Detail.RepositoryName == "" {
+		return repoRefs{}, errors.New("ECR event is missing account, region, or repository name")
+	}
+	return repoRefs{
+		images: []string{
+			fmt.Sprintf(
+				"%s.dkr.ecr.%s.amazonaws.com/%s",
+				payload.Account,
+				payload.Region,
+				payload.Detail.RepositoryName,
+			),
+		},
+	}, nil
+}
+
+// nonEmpty returns the provided values, omitting any that are empty.
+func nonEmpty(values ...string) []string {
+	var nonEmptyValues []string
+	for _, v := range values {
+		if v != "" {
+			nonEmptyValues = append(nonEmptyValues, v)
+		}
+	}
+	return nonEmptyValues
+}
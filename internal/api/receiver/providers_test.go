@@ -0,0 +1,246 @@
+package receiver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviders(t *testing.T) {
+	for name, p := range providers {
+		require.Equal(t, name, p.name)
+		require.NotNil(t, p.parse, name)
+	}
+	// Only providers that do not sign their events may lack verification
+	for _, name := range []string{
+		providerGitHub,
+		providerGitLab,
+		providerECR,
+		providerHarbor,
+	} {
+		require.NotNil(t, providers[name].verify, name)
+	}
+	require.Nil(t, providers[providerDockerHub].verify)
+	require.Nil(t, providers[providerQuay].verify)
+}
+
+func TestVerifyGitHubSignature(t *testing.T) {
+	secret := []byte("fake-secret")
+	body := []byte(`{"fake":"payload"}`)
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	validSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	testCases := []struct {
+		name      string
+		signature string
+		errMsg    string
+	}{
+		{
+			name:   "missing signature",
+			errMsg: "missing or malformed",
+		},
+		{
+			name:      "malformed signature",
+			signature: "sha256=not-hex",
+			errMsg:    "missing or malformed",
+		},
+		{
+			name:      "signature mismatch",
+			signature: "sha256=" + hex.EncodeToString([]byte("wrong")),
+			errMsg:    "signature mismatch",
+		},
+		{
+			name:      "valid signature",
+			signature: validSignature,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			header := http.Header{}
+			if testCase.signature != "" {
+				header.Set("X-Hub-Signature-256", testCase.signature)
+			}
+			err := verifyGitHubSignature(header, body, secret)
+			if testCase.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, testCase.errMsg)
+		})
+	}
+}
+
+func TestVerifyTokenHeader(t *testing.T) {
+	verify := verifyTokenHeader("X-Gitlab-Token")
+	secret := []byte("fake-secret")
+	require.ErrorContains(t, verify(http.Header{}, nil, secret), "missing X-Gitlab-Token header")
+	require.ErrorContains(
+		t,
+		verify(http.Header{"X-Gitlab-Token": []string{"wrong"}}, nil, secret),
+		"invalid X-Gitlab-Token header",
+	)
+	require.NoError(t, verify(http.Header{"X-Gitlab-Token": []string{"fake-secret"}}, nil, secret))
+}
+
+func TestParseEvents(t *testing.T) {
+	testCases := []struct {
+		name       string
+		parse      func(http.Header, []byte) (repoRefs, error)
+		header     http.Header
+		body       string
+		assertions func(*testing.T, repoRefs, error)
+	}{
+		{
+			name:   "GitHub ping",
+			parse:  parseGitHubEvent,
+			header: http.Header{"X-Github-Event": []string{"ping"}},
+			body:   `{"zen":"Keep it logically awesome."}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Empty(t, refs.normalizedURLs())
+			},
+		},
+		{
+			name:   "GitHub push",
+			parse:  parseGitHubEvent,
+			header: http.Header{"X-Github-Event": []string{"push"}},
+			body: `{"repository":{
+				"clone_url":"https://github.com/example/repo.git",
+				"html_url":"https://github.com/example/repo",
+				"ssh_url":"git@github.com:example/repo.git"
+			}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"https://github.com/example/repo", "ssh://git@github.com/example/repo"},
+					refs.normalizedURLs(),
+				)
+			},
+		},
+		{
+			name:   "GitHub container package",
+			parse:  parseGitHubEvent,
+			header: http.Header{"X-Github-Event": []string{"package"}},
+			body: `{"package":{
+				"package_type":"CONTAINER",
+				"package_version":{"package_url":"ghcr.io/example/app:v1.0.0"}
+			}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"ghcr.io/example/app"}, refs.normalizedURLs())
+			},
+		},
+		{
+			name:   "GitHub npm package",
+			parse:  parseGitHubEvent,
+			header: http.Header{"X-Github-Event": []string{"package"}},
+			body:   `{"package":{"package_type":"npm"}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Empty(t, refs.normalizedURLs())
+			},
+		},
+		{
+			name:   "GitLab tag push",
+			parse:  parseGitLabEvent,
+			header: http.Header{"X-Gitlab-Event": []string{"Tag Push Hook"}},
+			body:   `{"project":{"git_http_url":"https://gitlab.com/example/repo.git"}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"https://gitlab.com/example/repo"}, refs.normalizedURLs())
+			},
+		},
+		{
+			name:   "GitLab merge request",
+			parse:  parseGitLabEvent,
+			header: http.Header{"X-Gitlab-Event": []string{"Merge Request Hook"}},
+			body:   `{}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Empty(t, refs.normalizedURLs())
+			},
+		},
+		{
+			name:  "Harbor push",
+			parse: parseHarborEvent,
+			body: `{"type":"PUSH_ARTIFACT","event_data":{"resources":[
+				{"resource_url":"harbor.example.com/library/app:v1.0.0"}
+			]}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"harbor.example.com/library/app"}, refs.normalizedURLs())
+			},
+		},
+		{
+			name:  "Harbor delete",
+			parse: parseHarborEvent,
+			body:  `{"type":"DELETE_ARTIFACT","event_data":{"resources":[{"resource_url":"harbor.example.com/app"}]}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Empty(t, refs.normalizedURLs())
+			},
+		},
+		{
+			name:  "Quay",
+			parse: parseQuayEvent,
+			body:  `{"docker_url":"quay.io/example/app","updated_tags":["latest"]}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"quay.io/example/app"}, refs.normalizedURLs())
+			},
+		},
+		{
+			name:  "Docker Hub",
+			parse: parseDockerHubEvent,
+			body:  `{"push_data":{"tag":"latest"},"repository":{"repo_name":"example/app"}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"index.docker.io/example/app"}, refs.normalizedURLs())
+			},
+		},
+		{
+			name:  "ECR push",
+			parse: parseECREvent,
+			body: `{"source":"aws.ecr","account":"123456789012","region":"us-east-1","detail":{
+				"action-type":"PUSH","result":"SUCCESS","repository-name":"example/app"
+			}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"123456789012.dkr.ecr.us-east-1.amazonaws.com/example/app"},
+					refs.normalizedURLs(),
+				)
+			},
+		},
+		{
+			name:  "ECR failed push",
+			parse: parseECREvent,
+			body:  `{"source":"aws.ecr","detail":{"action-type":"PUSH","result":"FAILURE"}}`,
+			assertions: func(t *testing.T, refs repoRefs, err error) {
+				require.NoError(t, err)
+				require.Empty(t, refs.normalizedURLs())
+			},
+		},
+		{
+			name:  "ECR push without repository",
+			parse: parseECREvent,
+			body:  `{"source":"aws.ecr","detail":{"action-type":"PUSH","result":"SUCCESS"}}`,
+			assertions: func(t *testing.T, _ repoRefs, err error) {
+				require.ErrorContains(t, err, "missing account, region, or repository name")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			refs, err := testCase.parse(testCase.header, []byte(testCase.body))
+			testCase.assertions(t, refs, err)
+		})
+	}
+}
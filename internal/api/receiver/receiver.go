@@ -0,0 +1,277 @@
+package receiver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// PathPrefix is the path prefix under which the receiver is served.
+	PathPrefix = "/webhooks/"
+
+	// SecretName is the name of the Secret in a Project namespace that holds
+	// the receiver secret of the Project. Receiver URLs are derived from this
+	// secret and it is used to verify the signatures of received events.
+	SecretName = "kargo-webhook-receiver"
+	// SecretDataKey is the key within the Secret named SecretName under which
+	// the receiver secret is stored.
+	SecretDataKey = "secret"
+	// UnsignedProvidersDataKey is the key within the Secret named SecretName
+	// under which an optional, comma-separated list of the names of providers
+	// that do not sign their events (e.g. "dockerhub,quay") is stored. Events
+	// from such providers are authenticated only by the token embedded in the
+	// receiver URL, so they are rejected unless the provider is listed here.
+	UnsignedProvidersDataKey = "unsignedProviders"
+
+	// maxPayloadSize is the maximum size of an event payload that the receiver
+	// will accept.
+	maxPayloadSize = 2 << 20 // 2 MiB
+)
+
+// URL returns the base URL at which the receiver accepts events for the
+// provided Project. Senders must append the name of the provider sending the
+// events (e.g. "github") to it as a final path segment. The URL embeds a token
+// derived from the Project's receiver secret, so it changes whenever the
+// secret is rotated.
+func URL(baseURL, project string, secret []byte) string {
+	return fmt.Sprintf(
+		"%s%s%s/%s",
+		strings.TrimSuffix(baseURL, "/"),
+		PathPrefix,
+		project,
+		token(project, secret),
+	)
+}
+
+// GetSecret returns the receiver secret of the provided Project. If the
+// Project has no receiver secret, nil is returned.
+func GetSecret(ctx context.Context, c client.Client, project string) ([]byte, error) {
+	secret, err := getSecret(ctx, c, project)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	return secret.Data[SecretDataKey], nil
+}
+
+// getSecret returns the Secret holding the receiver configuration of the
+// provided Project. If the Project has no such Secret, or the Secret holds no
+// receiver secret, nil is returned.
+func getSecret(ctx context.Context, c client.Client, project string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(
+		ctx,
+		types.NamespacedName{Namespace: project, Name: SecretName},
+		secret,
+	); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			SecretName, project, err,
+		)
+	}
+	if len(secret.Data[SecretDataKey]) == 0 {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// allowsUnsignedProvider returns true if the provided receiver configuration
+// Secret explicitly permits events from the named provider, which does not
+// sign its events. It returns false otherwise.
+func allowsUnsignedProvider(secret *corev1.Secret, name string) bool {
+	for _, allowed := range strings.Split(string(secret.Data[UnsignedProvidersDataKey]), ",") {
+		if strings.TrimSpace(allowed) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// token returns the token that authenticates requests to the receiver URL of
+// the provided Project.
+func token(project string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(project))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type handler struct {
+	client client.Client
+}
+
+// NewHandler returns an http.Handler that receives push events from supported
+// Git hosting providers and container registries and refreshes all Warehouses
+// in the affected Project that subscribe to a repository referenced by the
+// event. The provider that sent an event is identified by the final segment of
+// the request path and is never inferred from the event itself. The provided
+// client must support querying Warehouses using the
+// kubeclient.WarehousesBySubscribedURLsIndexField selector.
+func NewHandler(c client.Client) http.Handler {
+	h := &handler{client: c}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+PathPrefix+"{project}/{token}/{provider}", h.handleEvent)
+	return mux
+}
+
+func (h *handler) handleEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	project := r.PathValue("project")
+	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
+
+	secret, err := getSecret(ctx, h.client, project)
+	if err != nil {
+		logger.Error(err, "error getting webhook receiver secret")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	// Respond identically for Projects without a receiver and for invalid
+	// tokens, so as not to reveal which Projects have a receiver configured.
+	if secret == nil || !hmac.Equal(
+		[]byte(r.PathValue("token")),
+		[]byte(token(project, secret.Data[SecretDataKey])),
+	) {
+		http.NotFound(w, r)
+		return
+	}
+
+	p, ok := providers[r.PathValue("provider")]
+	if !ok {
+		http.Error(
+			w,
+			fmt.Sprintf("unsupported provider %q", r.PathValue("provider")),
+			http.StatusNotFound,
+		)
+		return
+	}
+	logger = logger.WithValues("provider", p.name)
+	if p.verify == nil && !allowsUnsignedProvider(secret, p.name) {
+		logger.Debug("rejected webhook event from unsigned provider")
+		http.Error(
+			w,
+			fmt.Sprintf(
+				"provider %q does not sign its events and is not enabled for this receiver",
+				p.name,
+			),
+			http.StatusForbidden,
+		)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading payload: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	if p.verify != nil {
+		if err = p.verify(r.Header, body, secret.Data[SecretDataKey]); err != nil {
+			logger.Debug("rejected webhook event", "reason", err.Error())
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+	refs, err := p.parse(r.Header, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	refreshed, err := h.refreshWarehouses(ctx, project, refs.normalizedURLs())
+	if err != nil {
+		logger.Error(err, "error refreshing Warehouses")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	logger.Debug("handled webhook event", "warehouses", refreshed)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		Refreshed []string `json:"refreshed"`
+	}{Refreshed: refreshed})
+}
+
+// refreshWarehouses refreshes all Warehouses in the provided Project that
+// subscribe to any of the repositories with the provided normalized URLs. It
+// returns the names of the refreshed Warehouses.
+func (h *handler) refreshWarehouses(
+	ctx context.Context,
+	project string,
+	repoURLs []string,
+) ([]string, error) {
+	refreshed := []string{}
+	for _, repoURL := range repoURLs {
+		warehouses := &kargoapi.WarehouseList{}
+		if err := h.client.List(
+			ctx,
+			warehouses,
+			client.InNamespace(project),
+			client.MatchingFields{kubeclient.WarehousesBySubscribedURLsIndexField: repoURL},
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error listing Warehouses subscribed to %q in namespace %q: %w",
+				repoURL, project, err,
+			)
+		}
+		for _, warehouse := range warehouses.Items {
+			if slices.Contains(refreshed, warehouse.Name) {
+				continue
+			}
+			if _, err := kargoapi.RefreshWarehouse(
+				ctx,
+				h.client,
+				types.NamespacedName{Namespace: project, Name: warehouse.Name},
+			); err != nil {
+				return nil, fmt.Errorf(
+					"error refreshing Warehouse %q in namespace %q: %w",
+					warehouse.Name, project, err,
+				)
+			}
+			refreshed = append(refreshed, warehouse.Name)
+		}
+	}
+	slices.Sort(refreshed)
+	return refreshed, nil
+}
+
+// repoRefs holds the URLs of the repositories referenced by an event.
+type repoRefs struct {
+	git    []string
+	images []string
+}
+
+// normalizedURLs returns the normalized, de-duplicated URLs of all referenced
+// repositories. Image repository URLs that cannot be parsed are dropped, as no
+// Warehouse can be subscribed to them.
+func (r repoRefs) normalizedURLs() []string {
+	urls := make([]string, 0, len(r.git)+len(r.images))
+	for _, repoURL := range r.git {
+		urls = append(urls, git.NormalizeURL(repoURL))
+	}
+	for _, repoURL := range r.images {
+		if url, err := image.NormalizeURL(repoURL); err == nil {
+			urls = append(urls, url)
+		}
+	}
+	slices.Sort(urls)
+	return slices.Compact(urls)
+}
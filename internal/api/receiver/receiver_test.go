@@ -0,0 +1,335 @@
+package receiver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
+)
+
+func TestURL(t *testing.T) {
+	secret := []byte("fake-secret")
+	url := URL("https://kargo.example.com/", "fake-project", secret)
+	require.Equal(
+		t,
+		"https://kargo.example.com/webhooks/fake-project/"+token("fake-project", secret),
+		url,
+	)
+	// The token must differ between Projects and secrets
+	require.NotEqual(t, token("fake-project", secret), token("other-project", secret))
+	require.NotEqual(t, token("fake-project", secret), token("fake-project", []byte("other")))
+}
+
+func TestGetSecret(t *testing.T) {
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name: "Secret not found",
+			assertions: func(t *testing.T, secret []byte, err error) {
+				require.NoError(t, err)
+				require.Nil(t, secret)
+			},
+		},
+		{
+			name: "Secret has no data",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      SecretName,
+					},
+				},
+			},
+			assertions: func(t *testing.T, secret []byte, err error) {
+				require.NoError(t, err)
+				require.Nil(t, secret)
+			},
+		},
+		{
+			name: "success",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      SecretName,
+					},
+					Data: map[string][]byte{SecretDataKey: []byte("fake-secret")},
+				},
+			},
+			assertions: func(t *testing.T, secret []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []byte("fake-secret"), secret)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithObjects(testCase.objects...).Build()
+			secret, err := GetSecret(context.Background(), c, "fake-project")
+			testCase.assertions(t, secret, err)
+		})
+	}
+}
+
+func TestAllowsUnsignedProvider(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			UnsignedProvidersDataKey: []byte("dockerhub, quay"),
+		},
+	}
+	require.True(t, allowsUnsignedProvider(secret, providerDockerHub))
+	require.True(t, allowsUnsignedProvider(secret, providerQuay))
+	require.False(t, allowsUnsignedProvider(secret, providerGitHub))
+	require.False(t, allowsUnsignedProvider(&corev1.Secret{}, providerQuay))
+}
+
+func TestHandler(t *testing.T) {
+	const testProject = "fake-project"
+	testSecret := []byte("fake-secret")
+	basePath := PathPrefix + testProject + "/" + token(testProject, testSecret)
+	validPath := basePath + "/" + providerGitHub
+
+	const gitHubPushPayload = `{"repository":{"clone_url":"https://github.com/example/repo.git"}}`
+	gitHubSignature := func(body string) string {
+		mac := hmac.New(sha256.New, testSecret)
+		_, _ = mac.Write([]byte(body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		header     http.Header
+		body       string
+		assertions func(*testing.T, *httptest.ResponseRecorder, client.Client)
+	}{
+		{
+			name:   "method not allowed",
+			method: http.MethodGet,
+			path:   validPath,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name:   "Project without receiver",
+			method: http.MethodPost,
+			path:   PathPrefix + "other-project/" + token("other-project", testSecret) + "/" + providerGitHub,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+			},
+		},
+		{
+			name:   "invalid token",
+			method: http.MethodPost,
+			path:   PathPrefix + testProject + "/" + token(testProject, []byte("wrong-secret")) + "/" + providerGitHub,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+			},
+		},
+		{
+			name:   "provider not specified",
+			method: http.MethodPost,
+			path:   basePath,
+			header: http.Header{"X-Github-Event": []string{"push"}},
+			body:   gitHubPushPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+			},
+		},
+		{
+			name:   "unknown provider",
+			method: http.MethodPost,
+			path:   basePath + "/unknown",
+			body:   `{}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+				require.Contains(t, rr.Body.String(), `unsupported provider "unknown"`)
+			},
+		},
+		{
+			name:   "unsigned provider not enabled",
+			method: http.MethodPost,
+			path:   basePath + "/" + providerDockerHub,
+			// A GitHub event sent to the URL of an unsigned provider must not be
+			// handled as a GitHub event.
+			header: http.Header{"X-Github-Event": []string{"push"}},
+			body:   `{"callback_url":"https://registry.hub.docker.com/fake","repository":{"repo_name":"example/app"}}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusForbidden, rr.Code)
+				require.Contains(t, rr.Body.String(), "is not enabled for this receiver")
+			},
+		},
+		{
+			name:   "missing signature",
+			method: http.MethodPost,
+			path:   validPath,
+			header: http.Header{"X-Github-Event": []string{"push"}},
+			body:   gitHubPushPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+			},
+		},
+		{
+			name:   "invalid signature",
+			method: http.MethodPost,
+			path:   validPath,
+			header: http.Header{
+				"X-Github-Event":      []string{"push"},
+				"X-Hub-Signature-256": []string{gitHubSignature("tampered")},
+			},
+			body: gitHubPushPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+				warehouse := &kargoapi.Warehouse{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						client.ObjectKey{Namespace: testProject, Name: "git-warehouse"},
+						warehouse,
+					),
+				)
+				_, ok := warehouse.Annotations[kargoapi.AnnotationKeyRefresh]
+				require.False(t, ok)
+			},
+		},
+		{
+			name:   "subscribed Warehouses are refreshed",
+			method: http.MethodPost,
+			path:   validPath,
+			header: http.Header{
+				"X-Github-Event":      []string{"push"},
+				"X-Hub-Signature-256": []string{gitHubSignature(gitHubPushPayload)},
+			},
+			body: gitHubPushPayload,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(t, `{"refreshed":["git-warehouse"]}`, rr.Body.String())
+				for name, refreshed := range map[string]bool{
+					"git-warehouse":   true,
+					"image-warehouse": false,
+				} {
+					warehouse := &kargoapi.Warehouse{}
+					require.NoError(
+						t,
+						c.Get(
+							context.Background(),
+							client.ObjectKey{Namespace: testProject, Name: name},
+							warehouse,
+						),
+					)
+					_, ok := warehouse.Annotations[kargoapi.AnnotationKeyRefresh]
+					require.Equal(t, refreshed, ok, name)
+				}
+			},
+		},
+		{
+			name:   "event from enabled unsigned provider",
+			method: http.MethodPost,
+			path:   basePath + "/" + providerQuay,
+			body:   `{"docker_url":"quay.io/example/app","updated_tags":["latest"]}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(t, `{"refreshed":["image-warehouse"]}`, rr.Body.String())
+			},
+		},
+		{
+			name:   "event without subscribed Warehouses",
+			method: http.MethodPost,
+			path:   basePath + "/" + providerQuay,
+			body:   `{"docker_url":"quay.io/example/other","updated_tags":["latest"]}`,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(t, `{"refreshed":[]}`, rr.Body.String())
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testProject,
+							Name:      SecretName,
+						},
+						Data: map[string][]byte{
+							SecretDataKey:            testSecret,
+							UnsignedProvidersDataKey: []byte(providerQuay),
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testProject,
+							Name:      "git-warehouse",
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								Git: &kargoapi.GitSubscription{
+									RepoURL: "https://github.com/example/repo",
+								},
+							}},
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testProject,
+							Name:      "image-warehouse",
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								Image: &kargoapi.ImageSubscription{
+									RepoURL: "quay.io/example/app",
+								},
+							}},
+						},
+					},
+				).
+				WithIndex(
+					&kargoapi.Warehouse{},
+					kubeclient.WarehousesBySubscribedURLsIndexField,
+					func(obj client.Object) []string {
+						sub := obj.(*kargoapi.Warehouse).Spec.Subscriptions[0] // nolint: forcetypeassert
+						if sub.Git != nil {
+							return []string{sub.Git.RepoURL}
+						}
+						return []string{sub.Image.RepoURL}
+					},
+				).
+				Build()
+
+			req := httptest.NewRequest(testCase.method, testCase.path, strings.NewReader(testCase.body))
+			for k, v := range testCase.header {
+				req.Header[k] = v
+			}
+			rr := httptest.NewRecorder()
+			NewHandler(c).ServeHTTP(rr, req)
+			testCase.assertions(t, rr, c)
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/option"
 	"github.com/akuity/kargo/internal/api/rbac"
+	"github.com/akuity/kargo/internal/api/receiver"
 	"github.com/akuity/kargo/internal/api/validation"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	httputil "github.com/akuity/kargo/internal/http"
@@ -89,6 +90,19 @@ type server struct {
 		...client.CreateOption,
 	) error
 
+	// Promotion policies:
+	checkPromotionPolicyFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		breakGlass string,
+	) error
+	getProjectFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error)
+	nowFn func() time.Time
+
 	// Promote downstream:
 	findDownstreamStagesFn func(ctx context.Context, stage *kargoapi.Stage) ([]kargoapi.Stage, error)
 
@@ -175,6 +189,9 @@ func NewServer(
 	s.getFreightByNameOrAliasFn = kargoapi.GetFreightByNameOrAlias
 	s.isFreightAvailableFn = kargoapi.IsFreightAvailable
 	s.createPromotionFn = kubeClient.Create
+	s.checkPromotionPolicyFn = s.checkPromotionPolicy
+	s.getProjectFn = kargoapi.GetProject
+	s.nowFn = time.Now
 	s.findDownstreamStagesFn = s.findDownstreamStages
 	s.listFreightFn = kubeClient.List
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
@@ -213,6 +230,9 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 		}
 		mux.Handle("/dex/", dexProxy)
 	}
+	if s.cfg.WebhookReceiverConfig != nil {
+		mux.Handle(receiver.PathPrefix, receiver.NewHandler(s.client.InternalClient()))
+	}
 
 	handler := h2c.NewHandler(mux, &http2.Server{})
 
@@ -35,6 +35,8 @@ type promotionOptions struct {
 	Stage          string
 	DownstreamFrom string
 	Wait           bool
+	DryRun         bool
+	BreakGlass     string
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -78,6 +80,12 @@ kargo promote --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from of the QA stage in the default project
 kargo config set-project my-project
 kargo promote --freight-alias=wonky-wombat --downstream-from=qas
+
+# Show the changes promoting a piece of freight to the QA stage would make without making them
+kargo promote --project=my-project --freight=abc123 --stage=qa --dry-run
+
+# Promote a piece of freight to the QA stage while promotions to it are frozen
+kargo promote --project=my-project --freight=abc123 --stage=qa --break-glass="hotfix for outage"
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -123,12 +131,28 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 		),
 	)
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
+	option.DryRun(
+		cmd.Flags(), &o.DryRun,
+		fmt.Sprintf(
+			"Show the changes the promotion would make without making them. Implies --%s. "+
+				"If set, --%s must not be set.",
+			option.WaitFlag,
+			option.DownstreamFromFlag,
+		),
+	)
+
+	option.BreakGlass(
+		cmd.Flags(), &o.BreakGlass,
+		"Promote despite the promotion windows or freezes of the project, giving the "+
+			"provided reason. Requires permission to break-glass the stage(s).",
+	)
 
 	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag)
 
 	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.DryRunFlag, option.DownstreamFromFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -177,18 +201,24 @@ func (o *promotionOptions) run(ctx context.Context) error {
 					Freight:      o.FreightName,
 					FreightAlias: o.FreightAlias,
 					Stage:        o.Stage,
+					DryRun:       o.DryRun,
+					BreakGlass:   o.BreakGlass,
 				},
 			),
 		)
 		if err != nil {
 			return fmt.Errorf("promote stage: %w", err)
 		}
-		if o.Wait {
-			if err = waitForPromotion(ctx, kargoSvcCli, res.Msg.GetPromotion()); err != nil {
+		promo := res.Msg.GetPromotion()
+		if o.Wait || o.DryRun {
+			if promo, err = waitForPromotion(ctx, kargoSvcCli, promo); err != nil {
 				return fmt.Errorf("wait for promotion: %w", err)
 			}
 		}
-		_ = printer.PrintObj(res.Msg.GetPromotion(), o.IOStreams.Out)
+		if o.DryRun && (o.PrintFlags.OutputFormat == nil || *o.PrintFlags.OutputFormat == "") {
+			return printPlan(o.IOStreams, promo)
+		}
+		_ = printer.PrintObj(promo, o.IOStreams.Out)
 		return nil
 	case o.DownstreamFrom != "":
 		res, err := kargoSvcCli.PromoteDownstream(
@@ -199,6 +229,7 @@ func (o *promotionOptions) run(ctx context.Context) error {
 					Freight:      o.FreightName,
 					FreightAlias: o.FreightAlias,
 					Stage:        o.DownstreamFrom,
+					BreakGlass:   o.BreakGlass,
 				},
 			),
 		)
@@ -227,20 +258,23 @@ func waitForPromotions(
 	for _, promo := range p {
 		promo := promo
 		g.Go(func() error {
-			return waitForPromotion(ctx, kargoSvcCli, promo)
+			_, err := waitForPromotion(ctx, kargoSvcCli, promo)
+			return err
 		})
 	}
 	return g.Wait()
 }
 
+// waitForPromotion waits for the provided Promotion to reach a terminal phase
+// and returns the Promotion as it was observed in that phase.
 func waitForPromotion(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
 	p *kargoapi.Promotion,
-) error {
+) (*kargoapi.Promotion, error) {
 	if p == nil || p.Status.Phase.IsTerminal() {
 		// No need to wait for a promotion that is already terminal.
-		return nil
+		return p, nil
 	}
 
 	res, err := kargoSvcCli.WatchPromotion(ctx, connect.NewRequest(&v1alpha1.WatchPromotionRequest{
@@ -248,7 +282,7 @@ func waitForPromotion(
 		Name:    p.Name,
 	}))
 	if err != nil {
-		return fmt.Errorf("watch promotion: %w", err)
+		return nil, fmt.Errorf("watch promotion: %w", err)
 	}
 	defer func() {
 		if conn, connErr := res.Conn(); connErr == nil {
@@ -258,13 +292,42 @@ func waitForPromotion(
 	for {
 		if !res.Receive() {
 			if err = res.Err(); err != nil {
-				return fmt.Errorf("watch promotion: %w", err)
+				return nil, fmt.Errorf("watch promotion: %w", err)
 			}
-			return errors.New("unexpected end of watch stream")
+			return nil, errors.New("unexpected end of watch stream")
 		}
 		msg := res.Msg()
 		if msg.GetPromotion().Status.Phase.IsTerminal() {
-			return nil
+			return msg.GetPromotion(), nil
 		}
 	}
 }
+
+// printPlan prints the changes recorded by a dry-run Promotion in a human
+// readable form.
+func printPlan(streams genericiooptions.IOStreams, p *kargoapi.Promotion) error {
+	if p.Status.Phase != kargoapi.PromotionPhaseSucceeded {
+		return fmt.Errorf("dry run ended in phase %q: %s", p.Status.Phase, p.Status.Message)
+	}
+	if p.Status.Plan == nil ||
+		(len(p.Status.Plan.GitRepoChanges) == 0 && len(p.Status.Plan.ArgoCDAppChanges) == 0) {
+		_, _ = fmt.Fprintln(streams.Out, "No changes.")
+		return nil
+	}
+	for _, change := range p.Status.Plan.GitRepoChanges {
+		_, _ = fmt.Fprintf(streams.Out, "# Git repository %s", change.RepoURL)
+		if change.Branch != "" {
+			_, _ = fmt.Fprintf(streams.Out, " (branch %s)", change.Branch)
+		}
+		_, _ = fmt.Fprintln(streams.Out)
+		_, _ = fmt.Fprintln(streams.Out, change.Diff)
+	}
+	for _, change := range p.Status.Plan.ArgoCDAppChanges {
+		_, _ = fmt.Fprintf(
+			streams.Out,
+			"# Argo CD Application %s/%s\n%s\n",
+			change.AppNamespace, change.AppName, change.Patch,
+		)
+	}
+	return nil
+}
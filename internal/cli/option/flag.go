@@ -19,9 +19,15 @@ const (
 	// as-kubernetes-resources flag.
 	AsKubernetesResourcesShortFlag = "k"
 
+	// BreakGlassFlag is the flag name for the break-glass flag.
+	BreakGlassFlag = "break-glass"
+
 	// Claim is a flag name for the claim flag
 	ClaimFlag = "claim"
 
+	// DryRunFlag is the flag name for the dry-run flag.
+	DryRunFlag = "dry-run"
+
 	// FilenameFlag is the flag name for the filename flag.
 	FilenameFlag = "filename"
 	// FilenameShortFlag is the short flag name for the filename flag.
@@ -135,6 +141,11 @@ func AsKubernetesResources(fs *pflag.FlagSet, asKubernetesResources *bool, usage
 	)
 }
 
+// BreakGlass adds the BreakGlassFlag to the provided flag set.
+func BreakGlass(fs *pflag.FlagSet, breakGlass *string, usage string) {
+	fs.StringVar(breakGlass, BreakGlassFlag, "", usage)
+}
+
 // Claims adds a multi-value ClaimFlag to the provided flag set.
 func Claims(fs *pflag.FlagSet, claims *[]string, usage string) {
 	fs.StringSliceVar(claims, ClaimFlag, nil, usage)
@@ -145,6 +156,11 @@ func Description(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, DescriptionFlag, "", usage)
 }
 
+// DryRun adds the DryRunFlag to the provided flag set.
+func DryRun(fs *pflag.FlagSet, dryRun *bool, usage string) {
+	fs.BoolVar(dryRun, DryRunFlag, false, usage)
+}
+
 // Filenames adds the FilenameFlag and FilenameShortFlag to the provided flag set.
 func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
@@ -0,0 +1,445 @@
+package commitstatuses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/gitprovider"
+	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// contextPrefix is the prefix of the context of all commit statuses reported
+// by Kargo. It is followed by the names of the Project and Stage the status is
+// reported for.
+const contextPrefix = "kargo"
+
+// ReconcilerConfig represents configuration for the commit status reconciler.
+type ReconcilerConfig struct {
+	ShardName string `envconfig:"SHARD_NAME"`
+	// Enabled indicates whether the outcome of Promotions, and of the
+	// verification that follows them, should be reported to Git providers as
+	// statuses of the commits included in the promoted Freight.
+	Enabled bool `envconfig:"COMMIT_STATUSES_ENABLED" default:"false"`
+	// UIBaseURL is the base URL of the Kargo UI. If non-empty, commit statuses
+	// link to the Stage they were reported for.
+	UIBaseURL string `envconfig:"UI_BASE_URL"`
+}
+
+// ReconcilerConfigFromEnv returns a ReconcilerConfig populated from
+// environment variables.
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// reconciler reports the outcome of Promotions, and of the verification that
+// follows them, as statuses of the commits included in the promoted Freight.
+type reconciler struct {
+	client        client.Client
+	credentialsDB credentials.Database
+	cfg           ReconcilerConfig
+
+	// The following behaviors are overridable for testing purposes:
+
+	setCommitStatusFn func(
+		ctx context.Context,
+		namespace string,
+		sub *kargoapi.GitSubscription,
+		commit kargoapi.GitCommit,
+		status gitprovider.CommitStatus,
+	) error
+
+	newGitProviderServiceFn func(
+		repoURL string,
+		opts *gitprovider.GitProviderOptions,
+	) (gitprovider.GitProviderService, error)
+}
+
+// SetupReconcilerWithManager initializes a reconciler for reporting commit
+// statuses and registers it with the provided Manager.
+func SetupReconcilerWithManager(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+	credentialsDB credentials.Database,
+	cfg ReconcilerConfig,
+) error {
+	shardRequirement, err := controller.GetShardRequirement(cfg.ShardName)
+	if err != nil {
+		return fmt.Errorf("error creating shard requirement: %w", err)
+	}
+	shardSelector := labels.NewSelector().Add(*shardRequirement)
+
+	// Promotions are already reconciled by the Promotion reconciler, so this
+	// controller must be named explicitly.
+	c, err := ctrl.NewControllerManagedBy(kargoMgr).
+		Named("commit_statuses").
+		WithOptions(controller.CommonOptions()).
+		Build(newReconciler(kargoMgr.GetClient(), credentialsDB, cfg))
+	if err != nil {
+		return fmt.Errorf("error building commit status reconciler: %w", err)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+
+	// Watch Promotions for which the phase changed
+	if err = c.Watch(
+		source.Kind(
+			kargoMgr.GetCache(),
+			&kargoapi.Promotion{},
+			&handler.TypedEnqueueRequestForObject[*kargoapi.Promotion]{},
+			kargo.NewPromoPhaseChangedPredicate(logger),
+			inShard[*kargoapi.Promotion](shardSelector),
+		),
+	); err != nil {
+		return fmt.Errorf("unable to watch Promotions: %w", err)
+	}
+
+	// Watch Stages for which the phase of the current verification changed and
+	// enqueue the Stage's last Promotion
+	if err = c.Watch(
+		source.Kind(
+			kargoMgr.GetCache(),
+			&kargoapi.Stage{},
+			handler.TypedEnqueueRequestsFromMapFunc(lastPromotionForStage),
+			verificationChanged[*kargoapi.Stage]{},
+			inShard[*kargoapi.Stage](shardSelector),
+		),
+	); err != nil {
+		return fmt.Errorf("unable to watch Stages: %w", err)
+	}
+
+	return nil
+}
+
+// inShard returns a predicate that filters out events for objects that do not
+// belong to the shard matched by the provided selector.
+func inShard[T client.Object](shardSelector labels.Selector) predicate.TypedPredicate[T] {
+	return predicate.NewTypedPredicateFuncs(func(obj T) bool {
+		return shardSelector.Matches(labels.Set(obj.GetLabels()))
+	})
+}
+
+func newReconciler(
+	kubeClient client.Client,
+	credentialsDB credentials.Database,
+	cfg ReconcilerConfig,
+) *reconciler {
+	r := &reconciler{
+		client:                  kubeClient,
+		credentialsDB:           credentialsDB,
+		cfg:                     cfg,
+		newGitProviderServiceFn: gitprovider.NewGitProviderService,
+	}
+	r.setCommitStatusFn = r.setCommitStatus
+	return r
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"namespace", req.NamespacedName.Namespace,
+		"promotion", req.NamespacedName.Name,
+	)
+	ctx = logging.ContextWithLogger(ctx, logger)
+	logger.Debug("reporting commit statuses for Promotion")
+
+	promo, err := kargoapi.GetPromotion(ctx, r.client, req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if promo == nil {
+		// Ignore if not found. This can happen if the Promotion was deleted
+		// after the current reconciliation request was issued.
+		return ctrl.Result{}, nil
+	}
+	if promo.Spec.DryRun {
+		// A dry-run Promotion changes nothing, so there is nothing to report.
+		return ctrl.Result{}, nil
+	}
+
+	stage, err := kargoapi.GetStage(
+		ctx,
+		r.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if stage == nil {
+		return ctrl.Result{}, nil
+	}
+
+	status := buildCommitStatus(promo, stage, r.cfg.UIBaseURL)
+	if status == nil {
+		logger.Debug("nothing to report for Promotion", "phase", promo.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+
+	freight, err := kargoapi.GetFreight(
+		ctx,
+		r.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		},
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if freight == nil || len(freight.Commits) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	// The Warehouse the Freight originated from is consulted to learn which
+	// Git provider hosts each repository. It is not an error if it no longer
+	// exists, as the provider can often be inferred from the repository URL.
+	var warehouse *kargoapi.Warehouse
+	if freight.Origin.Kind == kargoapi.FreightOriginKindWarehouse {
+		if warehouse, err = kargoapi.GetWarehouse(
+			ctx,
+			r.client,
+			types.NamespacedName{
+				Namespace: freight.Namespace,
+				Name:      freight.Origin.Name,
+			},
+		); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var errs []error
+	for _, commit := range freight.Commits {
+		if commit.ID == "" {
+			continue
+		}
+		if err = r.setCommitStatusFn(
+			ctx,
+			promo.Namespace,
+			getGitSubscription(warehouse, commit.RepoURL),
+			commit,
+			*status,
+		); err != nil {
+			logger.Error(
+				err, "error reporting commit status",
+				"repo", commit.RepoURL,
+				"commit", commit.ID,
+			)
+			errs = append(errs, err)
+		}
+	}
+
+	logger.Debug("done reporting commit statuses for Promotion")
+
+	// Controller runtime automatically gives us a progressive backoff if err is
+	// not nil
+	return ctrl.Result{}, errors.Join(errs...)
+}
+
+// setCommitStatus sets the provided status on the provided commit using the
+// Git provider hosting the commit's repository. If the Git provider cannot be
+// determined, nothing is reported.
+func (r *reconciler) setCommitStatus(
+	ctx context.Context,
+	namespace string,
+	sub *kargoapi.GitSubscription,
+	commit kargoapi.GitCommit,
+	status gitprovider.CommitStatus,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	opts := &gitprovider.GitProviderOptions{}
+	if sub != nil {
+		opts.Name = sub.Provider
+		opts.InsecureSkipTLSVerify = sub.InsecureSkipTLSVerify
+	}
+	creds, found, err := r.credentialsDB.Get(
+		ctx,
+		namespace,
+		credentials.TypeGit,
+		commit.RepoURL,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"error obtaining credentials for git repo %q: %w",
+			commit.RepoURL, err,
+		)
+	}
+	if found {
+		opts.Token = creds.Password
+	}
+
+	gpSvc, err := r.newGitProviderServiceFn(commit.RepoURL, opts)
+	if err != nil {
+		// Retrying will not help if the Git provider is unknown
+		logger.Debug(
+			"not reporting commit status",
+			"repo", commit.RepoURL,
+			"reason", err.Error(),
+		)
+		return nil
+	}
+
+	if err = gpSvc.SetCommitStatus(ctx, commit.ID, status); err != nil {
+		return fmt.Errorf(
+			"error setting status of commit %q in git repo %q: %w",
+			commit.ID, commit.RepoURL, err,
+		)
+	}
+	return nil
+}
+
+// getGitSubscription returns the Warehouse's subscription to the Git
+// repository with the provided URL, or nil if the Warehouse is nil or has no
+// such subscription.
+func getGitSubscription(
+	warehouse *kargoapi.Warehouse,
+	repoURL string,
+) *kargoapi.GitSubscription {
+	if warehouse == nil {
+		return nil
+	}
+	repoURL = git.NormalizeURL(repoURL)
+	for _, sub := range warehouse.Spec.Subscriptions {
+		if sub.Git != nil && git.NormalizeURL(sub.Git.RepoURL) == repoURL {
+			return sub.Git
+		}
+	}
+	return nil
+}
+
+// buildCommitStatus returns the commit status that reflects the state of the
+// provided Promotion and, if it succeeded, of the verification of the Freight
+// it promoted to the provided Stage. It returns nil if there is nothing to
+// report, which is always the case for dry-run Promotions.
+func buildCommitStatus(
+	promo *kargoapi.Promotion,
+	stage *kargoapi.Stage,
+	uiBaseURL string,
+) *gitprovider.CommitStatus {
+	if promo.Spec.DryRun {
+		return nil
+	}
+	status := &gitprovider.CommitStatus{
+		Context: fmt.Sprintf("%s/%s/%s", contextPrefix, promo.Namespace, stage.Name),
+	}
+	if uiBaseURL != "" {
+		status.TargetURL = fmt.Sprintf(
+			"%s/project/%s/stage/%s",
+			strings.TrimSuffix(uiBaseURL, "/"),
+			url.PathEscape(promo.Namespace),
+			url.PathEscape(stage.Name),
+		)
+	}
+
+	switch promo.Status.Phase {
+	case kargoapi.PromotionPhasePending:
+		status.State = gitprovider.CommitStatusStatePending
+		status.Description = "Promotion is pending"
+	case kargoapi.PromotionPhaseRunning:
+		status.State = gitprovider.CommitStatusStatePending
+		status.Description = "Promotion is running"
+	case kargoapi.PromotionPhaseFailed:
+		status.State = gitprovider.CommitStatusStateFailure
+		status.Description = withMessage("Promotion failed", promo.Status.Message)
+	case kargoapi.PromotionPhaseErrored:
+		status.State = gitprovider.CommitStatusStateError
+		status.Description = withMessage("Promotion errored", promo.Status.Message)
+	case kargoapi.PromotionPhaseSucceeded:
+		if stage.Spec.Verification == nil {
+			status.State = gitprovider.CommitStatusStateSuccess
+			status.Description = "Promotion succeeded"
+			break
+		}
+		applyVerificationStatus(status, getVerificationInfo(promo, stage))
+	default:
+		return nil
+	}
+	return status
+}
+
+// getVerificationInfo returns information about the current verification of
+// the Freight collection that the provided Promotion produced, or nil if the
+// Freight collection cannot be found in the Stage's Freight history or has not
+// been verified yet.
+func getVerificationInfo(
+	promo *kargoapi.Promotion,
+	stage *kargoapi.Stage,
+) *kargoapi.VerificationInfo {
+	if promo.Status.FreightCollection == nil {
+		return nil
+	}
+	for _, fc := range stage.Status.FreightHistory {
+		if fc != nil && fc.ID == promo.Status.FreightCollection.ID {
+			return fc.VerificationHistory.Current()
+		}
+	}
+	return nil
+}
+
+// applyVerificationStatus updates the state and description of the provided
+// commit status to reflect the provided verification of a successfully
+// promoted Freight collection.
+func applyVerificationStatus(
+	status *gitprovider.CommitStatus,
+	info *kargoapi.VerificationInfo,
+) {
+	if info == nil {
+		status.State = gitprovider.CommitStatusStatePending
+		status.Description = "Promotion succeeded; awaiting verification"
+		return
+	}
+	switch info.Phase {
+	case kargoapi.VerificationPhaseSuccessful:
+		status.State = gitprovider.CommitStatusStateSuccess
+		status.Description = "Promotion succeeded and was verified"
+	case kargoapi.VerificationPhaseFailed,
+		kargoapi.VerificationPhaseInconclusive,
+		kargoapi.VerificationPhaseAborted:
+		status.State = gitprovider.CommitStatusStateFailure
+		status.Description = withMessage(
+			fmt.Sprintf("Verification %s", strings.ToLower(string(info.Phase))),
+			info.Message,
+		)
+	case kargoapi.VerificationPhaseError:
+		status.State = gitprovider.CommitStatusStateError
+		status.Description = withMessage("Verification errored", info.Message)
+	default:
+		status.State = gitprovider.CommitStatusStatePending
+		status.Description = "Promotion succeeded; verification is in progress"
+	}
+}
+
+// withMessage appends the provided message, if any, to the provided
+// description.
+func withMessage(description, message string) string {
+	if message == "" {
+		return description
+	}
+	return description + ": " + message
+}
@@ -0,0 +1,492 @@
+package commitstatuses
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestNewReconciler(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().Build()
+	r := newReconciler(kubeClient, &credentials.FakeDB{}, ReconcilerConfig{})
+	require.NotNil(t, r.client)
+	require.NotNil(t, r.credentialsDB)
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, r.setCommitStatusFn)
+	require.NotNil(t, r.newGitProviderServiceFn)
+}
+
+func TestReconcile(t *testing.T) {
+	const testNamespace = "fake-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "fake-promotion",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+		Status: kargoapi.PromotionStatus{
+			Phase: kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	testDryRunPromo := testPromo.DeepCopy()
+	testDryRunPromo.Spec.DryRun = true
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "fake-freight",
+		},
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Commits: []kargoapi.GitCommit{
+			{RepoURL: "https://git.example.com/org/repo-a", ID: "fake-commit-a"},
+			{RepoURL: "https://git.example.com/org/repo-b", ID: "fake-commit-b"},
+		},
+	}
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "fake-warehouse",
+		},
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{{
+				Git: &kargoapi.GitSubscription{
+					RepoURL:  "https://git.example.com/org/repo-a.git",
+					Provider: "gitea",
+				},
+			}},
+		},
+	}
+
+	type reportedStatus struct {
+		sub    *kargoapi.GitSubscription
+		commit string
+		status gitprovider.CommitStatus
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		setErr     error
+		assertions func(*testing.T, []reportedStatus, ctrl.Result, error)
+	}{
+		{
+			name: "Promotion not found",
+			assertions: func(t *testing.T, reported []reportedStatus, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reported)
+			},
+		},
+		{
+			name:    "dry-run Promotion",
+			objects: []client.Object{testDryRunPromo, testStage, testFreight, testWarehouse},
+			assertions: func(t *testing.T, reported []reportedStatus, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reported)
+			},
+		},
+		{
+			name:    "Stage not found",
+			objects: []client.Object{testPromo, testFreight},
+			assertions: func(t *testing.T, reported []reportedStatus, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reported)
+			},
+		},
+		{
+			name:    "Freight not found",
+			objects: []client.Object{testPromo, testStage},
+			assertions: func(t *testing.T, reported []reportedStatus, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reported)
+			},
+		},
+		{
+			name:    "error setting commit status",
+			objects: []client.Object{testPromo, testStage, testFreight},
+			setErr:  errors.New("something went wrong"),
+			assertions: func(t *testing.T, reported []reportedStatus, _ ctrl.Result, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				// Every commit is attempted despite the error
+				require.Len(t, reported, 2)
+			},
+		},
+		{
+			name:    "success",
+			objects: []client.Object{testPromo, testStage, testFreight, testWarehouse},
+			assertions: func(t *testing.T, reported []reportedStatus, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Len(t, reported, 2)
+
+				require.Equal(t, "fake-commit-a", reported[0].commit)
+				require.NotNil(t, reported[0].sub)
+				require.Equal(t, "gitea", reported[0].sub.Provider)
+				require.Equal(t, gitprovider.CommitStatusStateSuccess, reported[0].status.State)
+				require.Equal(t, "kargo/fake-project/fake-stage", reported[0].status.Context)
+				require.Equal(
+					t,
+					"https://kargo.example.com/project/fake-project/stage/fake-stage",
+					reported[0].status.TargetURL,
+				)
+
+				require.Equal(t, "fake-commit-b", reported[1].commit)
+				require.Nil(t, reported[1].sub)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var reported []reportedStatus
+			r := &reconciler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+				cfg: ReconcilerConfig{UIBaseURL: "https://kargo.example.com/"},
+				setCommitStatusFn: func(
+					_ context.Context,
+					_ string,
+					sub *kargoapi.GitSubscription,
+					commit kargoapi.GitCommit,
+					status gitprovider.CommitStatus,
+				) error {
+					reported = append(reported, reportedStatus{
+						sub:    sub,
+						commit: commit.ID,
+						status: status,
+					})
+					return testCase.setErr
+				},
+			}
+			res, err := r.Reconcile(
+				context.Background(),
+				ctrl.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: testNamespace,
+						Name:      testPromo.Name,
+					},
+				},
+			)
+			testCase.assertions(t, reported, res, err)
+		})
+	}
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	testCommit := kargoapi.GitCommit{
+		RepoURL: "https://git.example.com/org/repo",
+		ID:      "fake-commit",
+	}
+	testStatus := gitprovider.CommitStatus{
+		State:   gitprovider.CommitStatusStateSuccess,
+		Context: "kargo/fake-project/fake-stage",
+	}
+
+	testCases := []struct {
+		name          string
+		sub           *kargoapi.GitSubscription
+		credentialsDB credentials.Database
+		newSvcErr     error
+		setErr        error
+		assertions    func(*testing.T, *gitprovider.GitProviderOptions, bool, error)
+	}{
+		{
+			name: "error obtaining credentials",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *gitprovider.GitProviderOptions, set bool, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				require.False(t, set)
+			},
+		},
+		{
+			name:          "unknown Git provider",
+			credentialsDB: &credentials.FakeDB{},
+			newSvcErr:     errors.New("no registered providers"),
+			assertions: func(t *testing.T, _ *gitprovider.GitProviderOptions, set bool, err error) {
+				require.NoError(t, err)
+				require.False(t, set)
+			},
+		},
+		{
+			name:          "error setting commit status",
+			credentialsDB: &credentials.FakeDB{},
+			setErr:        errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ *gitprovider.GitProviderOptions, set bool, err error) {
+				require.ErrorContains(t, err, "error setting status of commit")
+				require.ErrorContains(t, err, "something went wrong")
+				require.True(t, set)
+			},
+		},
+		{
+			name: "success",
+			sub: &kargoapi.GitSubscription{
+				Provider:              "gitea",
+				InsecureSkipTLSVerify: true,
+			},
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{Password: "fake-token"}, true, nil
+				},
+			},
+			assertions: func(t *testing.T, opts *gitprovider.GitProviderOptions, set bool, err error) {
+				require.NoError(t, err)
+				require.True(t, set)
+				require.Equal(
+					t,
+					&gitprovider.GitProviderOptions{
+						Name:                  "gitea",
+						Token:                 "fake-token",
+						InsecureSkipTLSVerify: true,
+					},
+					opts,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var opts *gitprovider.GitProviderOptions
+			var set bool
+			r := &reconciler{
+				credentialsDB: testCase.credentialsDB,
+				newGitProviderServiceFn: func(
+					_ string,
+					o *gitprovider.GitProviderOptions,
+				) (gitprovider.GitProviderService, error) {
+					opts = o
+					if testCase.newSvcErr != nil {
+						return nil, testCase.newSvcErr
+					}
+					return &gitprovider.FakeGitProviderService{
+						SetCommitStatusFn: func(
+							_ context.Context,
+							sha string,
+							status gitprovider.CommitStatus,
+						) error {
+							set = true
+							require.Equal(t, testCommit.ID, sha)
+							require.Equal(t, testStatus, status)
+							return testCase.setErr
+						},
+					}, nil
+				},
+			}
+			err := r.setCommitStatus(
+				context.Background(),
+				"fake-project",
+				testCase.sub,
+				testCommit,
+				testStatus,
+			)
+			testCase.assertions(t, opts, set, err)
+		})
+	}
+}
+
+func TestGetGitSubscription(t *testing.T) {
+	warehouse := &kargoapi.Warehouse{
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{RepoURL: "example.com/image"}},
+				{Git: &kargoapi.GitSubscription{RepoURL: "https://github.com/org/repo.git"}},
+			},
+		},
+	}
+	require.Nil(t, getGitSubscription(nil, "https://github.com/org/repo"))
+	require.Nil(t, getGitSubscription(warehouse, "https://github.com/org/other"))
+	require.Equal(
+		t,
+		warehouse.Spec.Subscriptions[1].Git,
+		getGitSubscription(warehouse, "https://github.com/org/repo"),
+	)
+}
+
+func TestBuildCommitStatus(t *testing.T) {
+	newPromo := func(phase kargoapi.PromotionPhase, message string) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project"},
+			Status: kargoapi.PromotionStatus{
+				Phase:             phase,
+				Message:           message,
+				FreightCollection: &kargoapi.FreightCollection{ID: "fake-collection"},
+			},
+		}
+	}
+	newVerifiedStage := func(verificationInfo ...kargoapi.VerificationInfo) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+			Spec: kargoapi.StageSpec{
+				Verification: &kargoapi.Verification{},
+			},
+			Status: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{
+					{ID: "other-collection"},
+					{
+						ID:                  "fake-collection",
+						VerificationHistory: verificationInfo,
+					},
+				},
+			},
+		}
+	}
+	unverifiedStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+	}
+
+	testCases := []struct {
+		name          string
+		promo         *kargoapi.Promotion
+		stage         *kargoapi.Stage
+		expectedState gitprovider.CommitStatusState
+		expectedDesc  string
+	}{
+		{
+			name:  "unknown phase",
+			promo: newPromo("", ""),
+			stage: unverifiedStage,
+		},
+		{
+			name: "dry-run Promotion",
+			promo: func() *kargoapi.Promotion {
+				promo := newPromo(kargoapi.PromotionPhaseSucceeded, "")
+				promo.Spec.DryRun = true
+				return promo
+			}(),
+			stage: unverifiedStage,
+		},
+		{
+			name:          "Promotion pending",
+			promo:         newPromo(kargoapi.PromotionPhasePending, ""),
+			stage:         unverifiedStage,
+			expectedState: gitprovider.CommitStatusStatePending,
+			expectedDesc:  "Promotion is pending",
+		},
+		{
+			name:          "Promotion running",
+			promo:         newPromo(kargoapi.PromotionPhaseRunning, ""),
+			stage:         unverifiedStage,
+			expectedState: gitprovider.CommitStatusStatePending,
+			expectedDesc:  "Promotion is running",
+		},
+		{
+			name:          "Promotion failed",
+			promo:         newPromo(kargoapi.PromotionPhaseFailed, "step failed"),
+			stage:         unverifiedStage,
+			expectedState: gitprovider.CommitStatusStateFailure,
+			expectedDesc:  "Promotion failed: step failed",
+		},
+		{
+			name:          "Promotion errored",
+			promo:         newPromo(kargoapi.PromotionPhaseErrored, ""),
+			stage:         unverifiedStage,
+			expectedState: gitprovider.CommitStatusStateError,
+			expectedDesc:  "Promotion errored",
+		},
+		{
+			name:          "Promotion succeeded without verification",
+			promo:         newPromo(kargoapi.PromotionPhaseSucceeded, ""),
+			stage:         unverifiedStage,
+			expectedState: gitprovider.CommitStatusStateSuccess,
+			expectedDesc:  "Promotion succeeded",
+		},
+		{
+			name:          "Promotion succeeded and verification not started",
+			promo:         newPromo(kargoapi.PromotionPhaseSucceeded, ""),
+			stage:         newVerifiedStage(),
+			expectedState: gitprovider.CommitStatusStatePending,
+			expectedDesc:  "Promotion succeeded; awaiting verification",
+		},
+		{
+			name:  "Promotion succeeded and verification running",
+			promo: newPromo(kargoapi.PromotionPhaseSucceeded, ""),
+			stage: newVerifiedStage(kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+			}),
+			expectedState: gitprovider.CommitStatusStatePending,
+			expectedDesc:  "Promotion succeeded; verification is in progress",
+		},
+		{
+			name:  "Promotion succeeded and verification successful",
+			promo: newPromo(kargoapi.PromotionPhaseSucceeded, ""),
+			stage: newVerifiedStage(
+				kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseSuccessful},
+				kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseFailed},
+			),
+			expectedState: gitprovider.CommitStatusStateSuccess,
+			expectedDesc:  "Promotion succeeded and was verified",
+		},
+		{
+			name:  "Promotion succeeded and verification failed",
+			promo: newPromo(kargoapi.PromotionPhaseSucceeded, ""),
+			stage: newVerifiedStage(kargoapi.VerificationInfo{
+				Phase:   kargoapi.VerificationPhaseFailed,
+				Message: "analysis failed",
+			}),
+			expectedState: gitprovider.CommitStatusStateFailure,
+			expectedDesc:  "Verification failed: analysis failed",
+		},
+		{
+			name:  "Promotion succeeded and verification errored",
+			promo: newPromo(kargoapi.PromotionPhaseSucceeded, ""),
+			stage: newVerifiedStage(kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseError,
+			}),
+			expectedState: gitprovider.CommitStatusStateError,
+			expectedDesc:  "Verification errored",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := buildCommitStatus(testCase.promo, testCase.stage, "")
+			if testCase.expectedState == "" {
+				require.Nil(t, status)
+				return
+			}
+			require.NotNil(t, status)
+			require.Equal(t, testCase.expectedState, status.State)
+			require.Equal(t, testCase.expectedDesc, status.Description)
+			require.Equal(t, "kargo/fake-project/fake-stage", status.Context)
+			require.Empty(t, status.TargetURL)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package commitstatuses
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// verificationChanged is a predicate that filters out Stage Update events that
+// change neither the Stage's current Freight collection nor the phase of that
+// Freight collection's current verification. This is useful for reporting the
+// outcome of a verification only when it changes.
+type verificationChanged[T any] struct{}
+
+func (p verificationChanged[T]) Create(event.TypedCreateEvent[T]) bool {
+	return false
+}
+
+func (p verificationChanged[T]) Update(e event.TypedUpdateEvent[T]) bool {
+	oldStage, ok := any(e.ObjectOld).(*kargoapi.Stage)
+	if !ok || oldStage == nil {
+		return false
+	}
+	newStage, ok := any(e.ObjectNew).(*kargoapi.Stage)
+	if !ok || newStage == nil {
+		return false
+	}
+	oldID, oldPhase := currentVerification(oldStage)
+	newID, newPhase := currentVerification(newStage)
+	return newPhase != "" && (oldID != newID || oldPhase != newPhase)
+}
+
+func (p verificationChanged[T]) Delete(event.TypedDeleteEvent[T]) bool {
+	return false
+}
+
+func (p verificationChanged[T]) Generic(event.TypedGenericEvent[T]) bool {
+	return false
+}
+
+// currentVerification returns the ID of the Stage's current Freight collection
+// and the phase of that Freight collection's current verification, if any.
+func currentVerification(stage *kargoapi.Stage) (string, kargoapi.VerificationPhase) {
+	fc := stage.Status.FreightHistory.Current()
+	if fc == nil {
+		return "", ""
+	}
+	if vi := fc.VerificationHistory.Current(); vi != nil {
+		return fc.ID, vi.Phase
+	}
+	return fc.ID, ""
+}
+
+// lastPromotionForStage returns a request to reconcile the last Promotion of
+// the provided Stage, if any.
+func lastPromotionForStage(_ context.Context, stage *kargoapi.Stage) []reconcile.Request {
+	if stage.Status.LastPromotion == nil {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      stage.Status.LastPromotion.Name,
+		},
+	}}
+}
@@ -0,0 +1,108 @@
+package commitstatuses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestVerificationChanged_Update(t *testing.T) {
+	newStage := func(id string, phases ...kargoapi.VerificationPhase) *kargoapi.Stage {
+		stage := &kargoapi.Stage{}
+		if id == "" {
+			return stage
+		}
+		fc := &kargoapi.FreightCollection{ID: id}
+		for _, phase := range phases {
+			fc.VerificationHistory = append(
+				fc.VerificationHistory,
+				kargoapi.VerificationInfo{Phase: phase},
+			)
+		}
+		stage.Status.FreightHistory = kargoapi.FreightHistory{fc}
+		return stage
+	}
+
+	testCases := []struct {
+		name     string
+		old      *kargoapi.Stage
+		new      *kargoapi.Stage
+		expected bool
+	}{
+		{
+			name:     "no Freight",
+			old:      newStage(""),
+			new:      newStage(""),
+			expected: false,
+		},
+		{
+			name:     "no verification",
+			old:      newStage(""),
+			new:      newStage("fake-collection"),
+			expected: false,
+		},
+		{
+			name:     "verification started",
+			old:      newStage("fake-collection"),
+			new:      newStage("fake-collection", kargoapi.VerificationPhaseRunning),
+			expected: true,
+		},
+		{
+			name:     "verification phase unchanged",
+			old:      newStage("fake-collection", kargoapi.VerificationPhaseRunning),
+			new:      newStage("fake-collection", kargoapi.VerificationPhaseRunning),
+			expected: false,
+		},
+		{
+			name:     "verification completed",
+			old:      newStage("fake-collection", kargoapi.VerificationPhaseRunning),
+			new:      newStage("fake-collection", kargoapi.VerificationPhaseSuccessful),
+			expected: true,
+		},
+		{
+			name:     "Freight collection changed",
+			old:      newStage("fake-collection", kargoapi.VerificationPhaseSuccessful),
+			new:      newStage("other-collection", kargoapi.VerificationPhaseSuccessful),
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				verificationChanged[*kargoapi.Stage]{}.Update(
+					event.TypedUpdateEvent[*kargoapi.Stage]{
+						ObjectOld: testCase.old,
+						ObjectNew: testCase.new,
+					},
+				),
+			)
+		})
+	}
+}
+
+func TestLastPromotionForStage(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+	}
+	require.Empty(t, lastPromotionForStage(context.Background(), stage))
+
+	stage.Status.LastPromotion = &kargoapi.PromotionReference{Name: "fake-promotion"}
+	reqs := lastPromotionForStage(context.Background(), stage)
+	require.Len(t, reqs, 1)
+	require.Equal(
+		t,
+		types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+		reqs[0].NamespacedName,
+	)
+}
@@ -726,3 +726,471 @@ func TestFindChart(t *testing.T) {
 		})
 	}
 }
+
+func TestFindArtifact(t *testing.T) {
+	const testNamespace = "test-namespace"
+	const testRepoURL = "fake-repo-url"
+
+	scheme := runtime.NewScheme()
+	err := kargoapi.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	testOrigin1 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+	testOrigin2 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "some-other-warehouse",
+	}
+
+	testArtifact1 := kargoapi.OCIArtifact{
+		RepoURL: testRepoURL,
+		Tag:     "fake-tag-1",
+	}
+	testArtifact2 := kargoapi.OCIArtifact{
+		RepoURL: testRepoURL,
+		Tag:     "fake-tag-2",
+	}
+
+	testCases := []struct {
+		name          string
+		client        func() client.Client
+		stage         *kargoapi.Stage
+		desiredOrigin *kargoapi.FreightOrigin
+		freight       []kargoapi.FreightReference
+		assertions    func(*testing.T, *kargoapi.OCIArtifact, error)
+	}{
+		{
+			name:          "desired origin specified, but artifact not found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:    testOrigin2, // Wrong origin
+					Artifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Nil(t, artifact)
+			},
+		},
+		{
+			name:          "desired origin specified and artifact is found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:    testOrigin1, // Correct origin
+					Artifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+				{
+					Origin:    testOrigin2,
+					Artifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testArtifact1, artifact)
+			},
+		},
+		{
+			name: "desired origin not specified and warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin not specified and cannot be inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							// This Warehouse has no subscription to the desired repo
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: "not-the-right-repo",
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Nil(t, artifact)
+			},
+		},
+		{
+			name: "desired origin not specified and more than one possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin2.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						// This Stage requests Freight from two Warehouses that both get
+						// artifacts from the same repo
+						{Origin: testOrigin1},
+						{Origin: testOrigin2},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"multiple requested Freight could potentially provide",
+				)
+			},
+		},
+		{
+			name: "desired origin not specified and successfully inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+					},
+				},
+			},
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:    testOrigin1, // Correct origin
+					Artifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+				{
+					Origin:    testOrigin2,
+					Artifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testArtifact1, artifact)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cl client.Client
+			if testCase.client != nil {
+				cl = testCase.client()
+			}
+			artifact, err := FindArtifact(
+				context.Background(),
+				cl,
+				testCase.stage.Namespace,
+				testCase.stage.Spec.RequestedFreight,
+				testCase.desiredOrigin,
+				testCase.freight,
+				testRepoURL,
+			)
+			testCase.assertions(t, artifact, err)
+		})
+	}
+}
+
+func TestFindFile(t *testing.T) {
+	const testNamespace = "test-namespace"
+	const testRepoURL = "fake-repo-url"
+
+	scheme := runtime.NewScheme()
+	err := kargoapi.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	testOrigin1 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+	testOrigin2 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "some-other-warehouse",
+	}
+
+	testFile1 := kargoapi.File{
+		RepoURL: testRepoURL,
+		URL:     "fake-url-1",
+	}
+	testFile2 := kargoapi.File{
+		RepoURL: testRepoURL,
+		URL:     "fake-url-2",
+	}
+
+	testCases := []struct {
+		name          string
+		client        func() client.Client
+		stage         *kargoapi.Stage
+		desiredOrigin *kargoapi.FreightOrigin
+		freight       []kargoapi.FreightReference
+		assertions    func(*testing.T, *kargoapi.File, error)
+	}{
+		{
+			name:          "desired origin specified, but file not found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin2, // Wrong origin
+					Files:  []kargoapi.File{testFile2},
+				},
+			},
+			assertions: func(t *testing.T, file *kargoapi.File, err error) {
+				require.NoError(t, err)
+				require.Nil(t, file)
+			},
+		},
+		{
+			name:          "desired origin specified and file is found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin1, // Correct origin
+					Files:  []kargoapi.File{testFile1},
+				},
+				{
+					Origin: testOrigin2,
+					Files:  []kargoapi.File{testFile2},
+				},
+			},
+			assertions: func(t *testing.T, file *kargoapi.File, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testFile1, file)
+			},
+		},
+		{
+			name: "desired origin not specified and warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.File, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin not specified and cannot be inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							// This Warehouse has no subscription to the desired repo
+							Subscriptions: []kargoapi.RepoSubscription{{
+								File: &kargoapi.FileSubscription{
+									RepoURL: "not-the-right-repo",
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, file *kargoapi.File, err error) {
+				require.NoError(t, err)
+				require.Nil(t, file)
+			},
+		},
+		{
+			name: "desired origin not specified and more than one possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								File: &kargoapi.FileSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin2.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								File: &kargoapi.FileSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						// This Stage requests Freight from two Warehouses that both get
+						// files from the same location
+						{Origin: testOrigin1},
+						{Origin: testOrigin2},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.File, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"multiple requested Freight could potentially provide",
+				)
+			},
+		},
+		{
+			name: "desired origin not specified and successfully inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								File: &kargoapi.FileSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+					},
+				},
+			},
+			freight: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin1, // Correct origin
+					Files:  []kargoapi.File{testFile1},
+				},
+				{
+					Origin: testOrigin2,
+					Files:  []kargoapi.File{testFile1},
+				},
+			},
+			assertions: func(t *testing.T, file *kargoapi.File, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testFile1, file)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cl client.Client
+			if testCase.client != nil {
+				cl = testCase.client()
+			}
+			file, err := FindFile(
+				context.Background(),
+				cl,
+				testCase.stage.Namespace,
+				testCase.stage.Spec.RequestedFreight,
+				testCase.desiredOrigin,
+				testCase.freight,
+				testRepoURL,
+			)
+			testCase.assertions(t, file, err)
+		})
+	}
+}
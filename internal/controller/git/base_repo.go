@@ -67,7 +67,8 @@ type User struct {
 	Name string
 	// Email is the user's email address.
 	Email string
-	// SigningKeyType indicates the type of signing key.
+	// SigningKeyType indicates the type of signing key. If unspecified, the
+	// signing key is not used.
 	SigningKeyType SigningKeyType
 	// SigningKeyPath is an optional path referencing a signing key for
 	// signing git objects.
@@ -75,8 +76,8 @@ type User struct {
 }
 
 // setupAuthor configures the git CLI with a default commit author.
-// Optionally, the author can have an associated signing key. When using GPG
-// signing, the name and email must match the GPG key identity.
+// Optionally, the author can have an associated GPG or SSH signing key. When
+// using GPG signing, the name and email must match the GPG key identity.
 func (b *baseRepo) setupAuthor(author *User) error {
 	if author == nil {
 		author = &User{}
@@ -102,17 +103,21 @@ func (b *baseRepo) setupAuthor(author *User) error {
 		return fmt.Errorf("error configuring git user email: %w", err)
 	}
 
-	if author.SigningKeyPath != "" && author.SigningKeyType == SigningKeyTypeGPG {
-		cmd = b.buildGitCommand("config", "--global", "commit.gpgsign", "true")
+	// The default signing key is needed for as long as the repository is in
+	// use. A GPG key is therefore imported into the default GnuPG home
+	// directory, which git finds without further configuration. It is removed
+	// along with any copy of an SSH key when the home directory is removed as
+	// the repository is closed.
+	signing, err := b.setupSigning(author, filepath.Join(b.homeDir, ".gnupg"))
+	if err != nil {
+		return fmt.Errorf("error configuring commit signing: %w", err)
+	}
+	for _, kv := range signing.config {
+		key, value, _ := strings.Cut(kv, "=")
+		cmd = b.buildGitCommand("config", "--global", key, value)
 		cmd.Dir = b.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
-		if _, err := libExec.Exec(cmd); err != nil {
-			return fmt.Errorf("error configuring commit gpg signing: %w", err)
-		}
-
-		cmd = b.buildCommand("gpg", "--import", author.SigningKeyPath)
-		cmd.Dir = b.homeDir // Override the cmd.Dir that's set by r.buildCommand()
-		if _, err := libExec.Exec(cmd); err != nil {
-			return fmt.Errorf("error importing gpg key %q: %w", author.SigningKeyPath, err)
+		if _, err = libExec.Exec(cmd); err != nil {
+			return fmt.Errorf("error configuring git %s: %w", key, err)
 		}
 	}
 
@@ -1,5 +1,133 @@
 package git
 
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+// SigningKeyType is the type of a key used for signing git objects.
 type SigningKeyType string
 
-const SigningKeyTypeGPG SigningKeyType = "gpg"
+const (
+	// SigningKeyTypeGPG indicates an ASCII-armored GPG private key.
+	SigningKeyTypeGPG SigningKeyType = "gpg"
+	// SigningKeyTypeSSH indicates an SSH private key.
+	SigningKeyTypeSSH SigningKeyType = "ssh"
+)
+
+// commitSigning describes how git must be run to sign commits with a
+// particular signing key.
+type commitSigning struct {
+	// config is the git configuration, formatted as key=value pairs, that
+	// enables signing with the key.
+	config []string
+	// env holds the environment variables, formatted as key=value pairs, that
+	// git must be run with for the key to be found.
+	env []string
+	// cleanup removes any files that were created for signing with the key.
+	cleanup func()
+}
+
+// setupSigning prepares for signing commits with the provided user's signing
+// key. A GPG signing key is imported into the provided GnuPG home directory
+// or, if none is provided, into a temporary one, so that the private key does
+// not outlive the commits it is used for. An SSH signing key is copied to the
+// repository's home directory if it cannot be used as is. Any files created
+// for the purpose of signing are removed by the cleanup function of the
+// returned commitSigning. If the user has no signing key, or its type is
+// unspecified, the returned commitSigning has nil configuration.
+func (b *baseRepo) setupSigning(signer *User, gnupgHome string) (commitSigning, error) {
+	signing := commitSigning{cleanup: func() {}}
+	if signer == nil || signer.SigningKeyPath == "" || signer.SigningKeyType == "" {
+		return signing, nil
+	}
+	switch signer.SigningKeyType {
+	case SigningKeyTypeGPG:
+		if gnupgHome == "" {
+			// os.MkdirTemp creates directories with mode 0700, as GnuPG expects
+			var err error
+			if gnupgHome, err = os.MkdirTemp("", "gnupg-"); err != nil {
+				return signing, fmt.Errorf("error creating GnuPG home directory: %w", err)
+			}
+			signing.cleanup = func() { b.removeGnuPGHome(gnupgHome) }
+		} else if err := os.MkdirAll(gnupgHome, 0o700); err != nil {
+			return signing, fmt.Errorf("error creating GnuPG home directory: %w", err)
+		}
+		signing.env = []string{"GNUPGHOME=" + gnupgHome}
+		cmd := b.buildCommand("gpg", "--batch", "--import", signer.SigningKeyPath)
+		cmd.Dir = b.homeDir // Override the cmd.Dir that's set by b.buildCommand()
+		cmd.Env = append(cmd.Env, signing.env...)
+		if _, err := libExec.Exec(cmd); err != nil {
+			signing.cleanup()
+			return signing, fmt.Errorf("error importing gpg key %q: %w", signer.SigningKeyPath, err)
+		}
+		// GPG selects the key matching the committer's identity
+		signing.config = []string{"gpg.format=openpgp", "commit.gpgsign=true"}
+		return signing, nil
+	case SigningKeyTypeSSH:
+		keyPath, copied, err := b.prepareSSHSigningKey(signer.SigningKeyPath)
+		if err != nil {
+			return signing, err
+		}
+		if copied {
+			signing.cleanup = func() { _ = os.Remove(keyPath) }
+		}
+		signing.config = []string{
+			"gpg.format=ssh",
+			"user.signingkey=" + keyPath,
+			"commit.gpgsign=true",
+		}
+		return signing, nil
+	default:
+		return signing, fmt.Errorf("unsupported signing key type %q", signer.SigningKeyType)
+	}
+}
+
+// removeGnuPGHome stops the gpg-agent GnuPG started for the provided GnuPG
+// home directory, if any, and removes the directory along with the keys that
+// were imported into it.
+func (b *baseRepo) removeGnuPGHome(gnupgHome string) {
+	cmd := b.buildCommand("gpgconf", "--kill", "gpg-agent")
+	cmd.Dir = b.homeDir // Override the cmd.Dir that's set by b.buildCommand()
+	cmd.Env = append(cmd.Env, "GNUPGHOME="+gnupgHome)
+	_, _ = libExec.Exec(cmd)
+	_ = os.RemoveAll(gnupgHome)
+}
+
+// prepareSSHSigningKey returns the path to a file holding the SSH signing key
+// at the provided path in a form ssh-keygen accepts. ssh-keygen refuses to use
+// private keys that are accessible by others, which keys mounted from Secrets
+// often are, and keys lacking a trailing newline. Unless the key at the
+// provided path is already acceptable, it is copied to a file in the
+// repository's home directory that only the current user can access, in which
+// case true is also returned.
+func (b *baseRepo) prepareSSHSigningKey(path string) (string, bool, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", false, fmt.Errorf("error reading signing key %q: %w", path, err)
+	}
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("error reading signing key %q: %w", path, err)
+	}
+	if fi.Mode().Perm()&0o077 == 0 && bytes.HasSuffix(key, []byte("\n")) {
+		return path, false, nil
+	}
+	if !bytes.HasSuffix(key, []byte("\n")) {
+		key = append(key, '\n')
+	}
+	// os.CreateTemp creates files with mode 0600
+	keyFile, err := os.CreateTemp(b.homeDir, "signing-key-")
+	if err != nil {
+		return "", false, fmt.Errorf("error creating signing key file: %w", err)
+	}
+	defer keyFile.Close()
+	if _, err = keyFile.Write(key); err != nil {
+		_ = os.Remove(keyFile.Name())
+		return "", false, fmt.Errorf("error writing signing key to %q: %w", keyFile.Name(), err)
+	}
+	return keyFile.Name(), true, nil
+}
@@ -0,0 +1,252 @@
+package git
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+func TestSetupSigning(t *testing.T) {
+	b := &baseRepo{homeDir: t.TempDir()}
+
+	t.Run("no signing key", func(t *testing.T) {
+		signing, err := b.setupSigning(&User{Name: "Kargo"}, "")
+		require.NoError(t, err)
+		require.Nil(t, signing.config)
+		require.Nil(t, signing.env)
+	})
+
+	t.Run("unspecified signing key type", func(t *testing.T) {
+		signing, err := b.setupSigning(&User{SigningKeyPath: "/fake/path"}, "")
+		require.NoError(t, err)
+		require.Nil(t, signing.config)
+	})
+
+	t.Run("unsupported signing key type", func(t *testing.T) {
+		_, err := b.setupSigning(&User{
+			SigningKeyType: "x509",
+			SigningKeyPath: "/fake/path",
+		}, "")
+		require.ErrorContains(t, err, `unsupported signing key type "x509"`)
+	})
+
+	t.Run("SSH signing key that must be copied", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("fake-key"), 0644)) // nolint: gosec
+		signing, err := b.setupSigning(&User{
+			SigningKeyType: SigningKeyTypeSSH,
+			SigningKeyPath: keyPath,
+		}, "")
+		require.NoError(t, err)
+		cfg := signing.config
+		require.Len(t, cfg, 3)
+		require.Equal(t, "gpg.format=ssh", cfg[0])
+		require.Equal(t, "commit.gpgsign=true", cfg[2])
+
+		// The key must have been copied to a file only the user can access
+		copyPath := strings.TrimPrefix(cfg[1], "user.signingkey=")
+		require.Equal(t, b.homeDir, filepath.Dir(copyPath))
+		fi, err := os.Stat(copyPath)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+		key, err := os.ReadFile(copyPath)
+		require.NoError(t, err)
+		require.Equal(t, "fake-key\n", string(key))
+
+		// The copy is removed by the cleanup function
+		signing.cleanup()
+		_, err = os.Stat(copyPath)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("SSH signing key that can be used as is", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("fake-key\n"), 0600))
+		signing, err := b.setupSigning(&User{
+			SigningKeyType: SigningKeyTypeSSH,
+			SigningKeyPath: keyPath,
+		}, "")
+		require.NoError(t, err)
+		require.Contains(t, signing.config, "user.signingkey="+keyPath)
+
+		// The key is not removed by the cleanup function
+		signing.cleanup()
+		_, err = os.Stat(keyPath)
+		require.NoError(t, err)
+	})
+}
+
+func TestSSHCommitSigning(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+
+	newKey := func(t *testing.T) (string, string) {
+		keyPath := filepath.Join(t.TempDir(), "key")
+		_, err := libExec.Exec(
+			exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath),
+		)
+		require.NoError(t, err)
+		pubKey, err := os.ReadFile(keyPath + ".pub")
+		require.NoError(t, err)
+		return keyPath, strings.TrimSpace(string(pubKey))
+	}
+	defaultKeyPath, defaultPubKey := newKey(t)
+	projectKeyPath, projectPubKey := newKey(t)
+
+	service := gitkit.New(gitkit.Config{
+		Dir:        t.TempDir(),
+		AutoCreate: true,
+	})
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	rep, err := Clone(
+		fmt.Sprintf("%s/test.git", server.URL),
+		&ClientOptions{
+			User: &User{
+				Name:           "Kargo",
+				Email:          "kargo@example.com",
+				SigningKeyType: SigningKeyTypeSSH,
+				SigningKeyPath: defaultKeyPath,
+			},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	defer rep.Close()
+	r, ok := rep.(*repo)
+	require.True(t, ok)
+
+	// verifySigner asserts that the HEAD commit was signed by the provided
+	// email address using the provided public key.
+	verifySigner := func(t *testing.T, email, pubKey string) {
+		allowedSignersPath := filepath.Join(t.TempDir(), "allowed_signers")
+		require.NoError(t, os.WriteFile(
+			allowedSignersPath,
+			[]byte(fmt.Sprintf("%s %s\n", email, pubKey)),
+			0600,
+		))
+		_, err = libExec.Exec(r.buildGitCommand(
+			"-c", "gpg.ssh.allowedSignersFile="+allowedSignersPath,
+			"verify-commit", "HEAD",
+		))
+		require.NoError(t, err)
+	}
+
+	t.Run("signs commits with the default signing key", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(rep.Dir(), "a.txt"), []byte("a"), 0600))
+		require.NoError(t, rep.AddAllAndCommit("default signing key"))
+		verifySigner(t, "kargo@example.com", defaultPubKey)
+	})
+
+	t.Run("signs commits with the author's signing key", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(rep.Dir(), "b.txt"), []byte("b"), 0600))
+		require.NoError(t, rep.AddAll())
+		require.NoError(t, rep.Commit(
+			"project signing key",
+			&CommitOptions{
+				Author: &User{
+					Name:           "Project",
+					Email:          "project@example.com",
+					SigningKeyType: SigningKeyTypeSSH,
+					SigningKeyPath: projectKeyPath,
+				},
+			},
+		))
+		verifySigner(t, "project@example.com", projectPubKey)
+		res, err := libExec.Exec(r.buildGitCommand("log", "-n", "1", "--format=%an <%ae>"))
+		require.NoError(t, err)
+		require.Equal(t, "Project <project@example.com>", strings.TrimSpace(string(res)))
+	})
+}
+
+func TestGPGCommitSigning(t *testing.T) {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		t.Skip("gpg is not available")
+	}
+
+	// The key is generated in a keyring of its own, which is also used to
+	// verify the signatures of commits.
+	keyringDir, err := os.MkdirTemp("", "gnupg-")
+	require.NoError(t, err)
+	keyringEnv := "GNUPGHOME=" + keyringDir
+	defer func() {
+		cmd := exec.Command("gpgconf", "--kill", "gpg-agent")
+		cmd.Env = append(os.Environ(), keyringEnv)
+		_ = cmd.Run()
+		_ = os.RemoveAll(keyringDir)
+	}()
+	cmd := exec.Command(
+		"gpg", "--batch", "--passphrase", "", "--quick-gen-key",
+		"Project <project@example.com>", "ed25519", "sign", "never",
+	)
+	cmd.Env = append(os.Environ(), keyringEnv)
+	_, err = libExec.Exec(cmd)
+	require.NoError(t, err)
+	cmd = exec.Command(
+		"gpg", "--batch", "--armor", "--export-secret-keys", "project@example.com",
+	)
+	cmd.Env = append(os.Environ(), keyringEnv)
+	key, err := libExec.Exec(cmd)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyPath, key, 0600))
+
+	service := gitkit.New(gitkit.Config{
+		Dir:        t.TempDir(),
+		AutoCreate: true,
+	})
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	rep, err := Clone(
+		fmt.Sprintf("%s/test.git", server.URL),
+		&ClientOptions{
+			User: &User{
+				Name:  "Kargo",
+				Email: "kargo@example.com",
+			},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	defer rep.Close()
+	r, ok := rep.(*repo)
+	require.True(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(rep.Dir(), "a.txt"), []byte("a"), 0600))
+	require.NoError(t, rep.AddAll())
+	require.NoError(t, rep.Commit(
+		"project signing key",
+		&CommitOptions{
+			Author: &User{
+				Name:           "Project",
+				Email:          "project@example.com",
+				SigningKeyType: SigningKeyTypeGPG,
+				SigningKeyPath: keyPath,
+			},
+		},
+	))
+
+	cmd = r.buildGitCommand("verify-commit", "HEAD")
+	cmd.Env = append(cmd.Env, keyringEnv)
+	_, err = libExec.Exec(cmd)
+	require.NoError(t, err)
+
+	// The key was not imported into the keyring in the repository's home
+	// directory, which outlives the commit
+	_, err = os.Stat(filepath.Join(r.homeDir, ".gnupg"))
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,288 @@
+package git
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+	libGit "github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/metrics"
+)
+
+// mirrorPruneInterval is the minimum interval between two sweeps for idle
+// mirrors.
+const mirrorPruneInterval = time.Hour
+
+// MirrorCache maintains bare mirrors of remote Git repositories in a
+// persistent directory. Mirrors are keyed by repository URL and credentials
+// and are fetched incrementally. Concurrent updates of the same mirror are
+// deduplicated. Mirrors that have not been used for longer than a configurable
+// period are evicted. MirrorCache is safe for use across multiple goroutines.
+type MirrorCache struct {
+	dir     string
+	maxIdle time.Duration
+	group   singleflight.Group
+
+	locksMu sync.Mutex
+	// locks holds a lock for each mirror, indexed by key. The lock is held
+	// exclusively while a mirror is updated or evicted and shared while it is
+	// cloned.
+	locks map[string]*sync.RWMutex
+
+	// lastPrune holds the time, in Unix nanoseconds, at which the last sweep
+	// for idle mirrors was started.
+	lastPrune atomic.Int64
+
+	nowFn func() time.Time
+}
+
+// NewMirrorCache returns a MirrorCache that maintains mirrors in the provided
+// directory. The directory is created if it does not already exist. Mirrors
+// that have not been used for longer than maxIdle are evicted. If maxIdle is
+// zero, mirrors are never evicted. Credentials are never written to the
+// directory, but because mirrors are keyed by credentials, the directory
+// should not be shared with other processes.
+func NewMirrorCache(dir string, maxIdle time.Duration) (*MirrorCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("error creating Git mirror cache directory %q: %w", dir, err)
+	}
+	return &MirrorCache{
+		dir:     dir,
+		maxIdle: maxIdle,
+		locks:   map[string]*sync.RWMutex{},
+		nowFn:   time.Now,
+	}, nil
+}
+
+// Clone has the same semantics as the package-level Clone function, but first
+// creates or updates a local mirror of the remote repository and then produces
+// the clone from the mirror. The remote "origin" of the returned Repo refers to
+// the mirror, so subsequent fetches (e.g. of tags) are served locally.
+func (m *MirrorCache) Clone(
+	repoURL string,
+	clientOpts *ClientOptions,
+	cloneOpts *CloneOptions,
+) (Repo, error) {
+	if clientOpts == nil {
+		clientOpts = &ClientOptions{}
+	}
+	if cloneOpts == nil {
+		cloneOpts = &CloneOptions{}
+	}
+	key := mirrorKey(repoURL, clientOpts.Credentials)
+	lock := m.lockFor(key)
+
+	res, err, _ := m.group.Do(key, func() (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return m.updateMirror(key, repoURL, clientOpts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Only clones served from a mirror that already existed count as hits.
+	if reused := res.(bool); reused { // nolint: forcetypeassert
+		metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheGit)
+	} else {
+		metrics.RecordDiscoveryCacheMiss(metrics.DiscoveryCacheGit)
+	}
+
+	m.maybePrune()
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	homeDir, err := os.MkdirTemp(cloneOpts.BaseDir, "repo-")
+	if err != nil {
+		return nil,
+			fmt.Errorf("error creating home directory for repo %q: %w", repoURL, err)
+	}
+	if homeDir, err = filepath.EvalSymlinks(homeDir); err != nil {
+		return nil,
+			fmt.Errorf("error resolving symlinks in path %s: %w", homeDir, err)
+	}
+	baseRepo := &baseRepo{
+		creds:   clientOpts.Credentials,
+		dir:     filepath.Join(homeDir, "repo"),
+		homeDir: homeDir,
+		url:     m.mirrorDir(key),
+	}
+	r := &repo{
+		baseRepo: baseRepo,
+		workTree: &workTree{
+			baseRepo: baseRepo,
+		},
+	}
+	if err = r.setupClient(clientOpts); err != nil {
+		return nil, err
+	}
+	if err = r.clone(cloneOpts); err != nil {
+		return nil, err
+	}
+	if err = r.saveDirs(); err != nil {
+		return nil, err
+	}
+	// Report the URL of the remote repository rather than that of the mirror.
+	r.url = repoURL
+	return r, nil
+}
+
+// updateMirror fetches the latest changes from the remote repository into the
+// mirror with the provided key. If the mirror does not exist yet, or if it
+// cannot be fetched into, it is (re-)created. It returns true if an existing
+// mirror was updated and false if the mirror was (re-)created. The caller must
+// hold the lock for the mirror exclusively.
+//
+// Credentials are only ever written to a temporary home directory that is
+// removed once the update is complete, and the URL recorded in the mirror's
+// configuration never includes credentials.
+func (m *MirrorCache) updateMirror(
+	key string,
+	repoURL string,
+	clientOpts *ClientOptions,
+) (bool, error) {
+	homeDir, err := os.MkdirTemp("", "mirror-")
+	if err != nil {
+		return false,
+			fmt.Errorf("error creating home directory for mirror of repo %q: %w", repoURL, err)
+	}
+	defer os.RemoveAll(homeDir)
+
+	mirror := &baseRepo{
+		creds:   clientOpts.Credentials,
+		dir:     m.mirrorDir(key),
+		homeDir: homeDir,
+		url:     repoURL,
+	}
+	if err = mirror.setupClient(clientOpts); err != nil {
+		return false, err
+	}
+	defer m.markUsed(key)
+
+	// Only branches and tags are mirrored, as other refs (e.g. pull requests)
+	// are of no interest. The refspecs and the URL, which may include a
+	// username, are passed explicitly rather than read from the mirror's
+	// configuration.
+	fetchArgs := []string{
+		"fetch", "--prune", mirror.url,
+		"+refs/heads/*:refs/heads/*",
+		"+refs/tags/*:refs/tags/*",
+	}
+
+	if _, err = os.Stat(mirror.dir); err == nil {
+		if _, err = libExec.Exec(mirror.buildGitCommand(fetchArgs...)); err == nil {
+			return true, nil
+		}
+		// The mirror may be corrupted (e.g. if the process was terminated in
+		// the middle of an earlier fetch). Fall through to re-create it.
+	}
+
+	if err = os.RemoveAll(filepath.Join(m.dir, key)); err != nil {
+		return false, fmt.Errorf("error removing mirror of repo %q: %w", repoURL, err)
+	}
+	if err = os.MkdirAll(filepath.Join(m.dir, key), 0700); err != nil {
+		return false, fmt.Errorf("error creating directory for mirror of repo %q: %w", repoURL, err)
+	}
+	cmd := mirror.buildGitCommand("clone", "--bare", mirror.url, mirror.dir)
+	cmd.Dir = mirror.homeDir // Override the cmd.Dir that's set by buildGitCommand()
+	if _, err = libExec.Exec(cmd); err != nil {
+		return false, fmt.Errorf("error mirroring repo %q into %q: %w", repoURL, mirror.dir, err)
+	}
+	// The URL used for cloning may include a username. Replace it with the
+	// original URL so that no part of the credentials is persisted.
+	if _, err = libExec.Exec(
+		mirror.buildGitCommand("config", "remote.origin.url", repoURL),
+	); err != nil {
+		return false, fmt.Errorf("error configuring mirror of repo %q: %w", repoURL, err)
+	}
+	return false, nil
+}
+
+// markUsed records that the mirror with the provided key was just used, so
+// that it is not evicted. The modification time of the mirror's directory is
+// used for this purpose.
+func (m *MirrorCache) markUsed(key string) {
+	now := m.nowFn()
+	_ = os.Chtimes(filepath.Join(m.dir, key), now, now)
+}
+
+// maybePrune starts a sweep for idle mirrors in the background, unless eviction
+// is disabled or a sweep was started within the last mirrorPruneInterval.
+func (m *MirrorCache) maybePrune() {
+	if m.maxIdle <= 0 {
+		return
+	}
+	now := m.nowFn()
+	last := m.lastPrune.Load()
+	if now.Sub(time.Unix(0, last)) < mirrorPruneInterval ||
+		!m.lastPrune.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	go m.prune()
+}
+
+// prune evicts all mirrors that have not been used for longer than the
+// maximum idle period. Mirrors that are currently in use are skipped.
+func (m *MirrorCache) prune() {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		return
+	}
+	cutoff := m.nowFn().Add(-m.maxIdle)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		key := entry.Name()
+		lock := m.lockFor(key)
+		if !lock.TryLock() {
+			continue
+		}
+		// Re-check under the lock, in case the mirror was used in the meantime.
+		if info, err = os.Stat(filepath.Join(m.dir, key)); err == nil &&
+			!info.ModTime().After(cutoff) {
+			_ = os.RemoveAll(filepath.Join(m.dir, key))
+		}
+		lock.Unlock()
+	}
+}
+
+func (m *MirrorCache) mirrorDir(key string) string {
+	return filepath.Join(m.dir, key, "repo")
+}
+
+func (m *MirrorCache) lockFor(key string) *sync.RWMutex {
+	m.locksMu.Lock()
+	defer m.locksMu.Unlock()
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &sync.RWMutex{}
+		m.locks[key] = lock
+	}
+	return lock
+}
+
+// mirrorKey returns a key that uniquely identifies the mirror of the
+// repository with the provided URL, as accessed using the provided
+// credentials. Keying mirrors by credentials ensures that content fetched
+// using one set of credentials is never served to holders of another.
+func mirrorKey(repoURL string, creds *RepoCredentials) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(libGit.NormalizeURL(repoURL)))
+	if creds != nil {
+		_, _ = fmt.Fprintf(h, "\x00%s\x00%s\x00%s", creds.Username, creds.Password, creds.SSHPrivateKey)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
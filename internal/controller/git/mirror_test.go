@@ -0,0 +1,198 @@
+package git
+
+import (
+	"fmt"
+	"io/fs"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorCache(t *testing.T) {
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	// Seed the remote repository with a commit.
+	setupRepo, err := Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRepo.Close()
+	commit := func(msg string) string {
+		err = os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte(msg), 0600)
+		require.NoError(t, err)
+		require.NoError(t, setupRepo.AddAllAndCommit(msg))
+		require.NoError(t, setupRepo.Push(nil))
+		var id string
+		id, err = setupRepo.LastCommitID()
+		require.NoError(t, err)
+		return id
+	}
+	firstCommitID := commit("first commit")
+
+	cacheDir := t.TempDir()
+	cache, err := NewMirrorCache(cacheDir, 0)
+	require.NoError(t, err)
+
+	clonedRepo, err := cache.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer clonedRepo.Close()
+
+	t.Run("clone reports the remote URL", func(t *testing.T) {
+		require.Equal(t, testRepoURL, clonedRepo.URL())
+	})
+
+	t.Run("clone is produced from the mirror", func(t *testing.T) {
+		id, err := clonedRepo.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, firstCommitID, id)
+		_, err = os.Stat(cache.mirrorDir(mirrorKey(testRepoURL, nil)))
+		require.NoError(t, err)
+	})
+
+	// Add another commit and a tag to the remote repository.
+	secondCommitID := commit("second commit")
+	tagCmd := setupRepo.(*repo).buildGitCommand("tag", "v1.0.0") // nolint: forcetypeassert
+	_, err = tagCmd.Output()
+	require.NoError(t, err)
+	pushCmd := setupRepo.(*repo).buildGitCommand("push", "origin", "v1.0.0") // nolint: forcetypeassert
+	_, err = pushCmd.Output()
+	require.NoError(t, err)
+
+	updatedRepo, err := cache.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer updatedRepo.Close()
+
+	t.Run("mirror is updated incrementally", func(t *testing.T) {
+		id, err := updatedRepo.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, secondCommitID, id)
+	})
+
+	t.Run("tags are fetched from the mirror", func(t *testing.T) {
+		tags, err := updatedRepo.ListTags()
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, "v1.0.0", tags[0].Tag)
+		require.Equal(t, secondCommitID, tags[0].CommitID)
+	})
+
+	t.Run("existing mirror is reused", func(t *testing.T) {
+		key := mirrorKey(testRepoURL, nil)
+		reused, err := cache.updateMirror(key, testRepoURL, &ClientOptions{})
+		require.NoError(t, err)
+		require.True(t, reused)
+	})
+
+	t.Run("credentials are not persisted", func(t *testing.T) {
+		for _, creds := range []*RepoCredentials{
+			{
+				Username: "fake-username",
+				Password: "fake-password",
+			},
+			{
+				SSHPrivateKey: "fake-ssh-key",
+			},
+		} {
+			key := mirrorKey(testRepoURL, creds)
+			reused, err := cache.updateMirror(key, testRepoURL, &ClientOptions{Credentials: creds})
+			require.NoError(t, err)
+			require.False(t, reused)
+			// Fetch into the mirror as well
+			reused, err = cache.updateMirror(key, testRepoURL, &ClientOptions{Credentials: creds})
+			require.NoError(t, err)
+			require.True(t, reused)
+			err = filepath.WalkDir(
+				filepath.Join(cacheDir, key),
+				func(path string, d fs.DirEntry, err error) error {
+					require.NoError(t, err)
+					require.NotEqual(t, ".ssh", d.Name(), path)
+					if d.IsDir() {
+						return nil
+					}
+					data, err := os.ReadFile(path)
+					require.NoError(t, err)
+					for _, secret := range []string{
+						"fake-username",
+						"fake-password",
+						"fake-ssh-key",
+					} {
+						require.False(t, strings.Contains(string(data), secret), path)
+					}
+					return nil
+				},
+			)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("corrupted mirror is re-created", func(t *testing.T) {
+		mirrorDir := cache.mirrorDir(mirrorKey(testRepoURL, nil))
+		require.NoError(t, os.RemoveAll(filepath.Join(mirrorDir, "objects")))
+		r, err := cache.Clone(testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer r.Close()
+		id, err := r.LastCommitID()
+		require.NoError(t, err)
+		require.Equal(t, secondCommitID, id)
+	})
+}
+
+func TestMirrorCachePrune(t *testing.T) {
+	testNow := time.Now()
+	cacheDir := t.TempDir()
+	cache, err := NewMirrorCache(cacheDir, time.Hour)
+	require.NoError(t, err)
+	cache.nowFn = func() time.Time { return testNow }
+
+	for key, lastUsed := range map[string]time.Time{
+		"idle":   testNow.Add(-2 * time.Hour),
+		"recent": testNow.Add(-30 * time.Minute),
+		"in-use": testNow.Add(-2 * time.Hour),
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, key, "repo"), 0700))
+		require.NoError(t, os.Chtimes(filepath.Join(cacheDir, key), lastUsed, lastUsed))
+	}
+	lock := cache.lockFor("in-use")
+	lock.RLock()
+	defer lock.RUnlock()
+
+	cache.prune()
+
+	_, err = os.Stat(filepath.Join(cacheDir, "idle"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(cacheDir, "recent"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(cacheDir, "in-use"))
+	require.NoError(t, err)
+}
+
+func TestMirrorKey(t *testing.T) {
+	const testRepoURL = "https://github.com/example/repo"
+	// Equivalent URLs map to the same mirror
+	require.Equal(t, mirrorKey(testRepoURL, nil), mirrorKey(testRepoURL+".git", nil))
+	// Different credentials map to different mirrors
+	require.NotEqual(
+		t,
+		mirrorKey(testRepoURL, nil),
+		mirrorKey(testRepoURL, &RepoCredentials{Username: "fake-user", Password: "fake-pass"}),
+	)
+	require.NotEqual(
+		t,
+		mirrorKey(testRepoURL, &RepoCredentials{Username: "fake-user", Password: "fake-pass"}),
+		mirrorKey(testRepoURL, &RepoCredentials{Username: "fake-user", Password: "other-pass"}),
+	)
+}
@@ -103,6 +103,14 @@ func TestRepo(t *testing.T) {
 		require.True(t, hasDiffs)
 	})
 
+	t.Run("can diff", func(t *testing.T) {
+		var diff string
+		diff, err = rep.Diff()
+		require.NoError(t, err)
+		require.Contains(t, diff, "+++ b/test.txt")
+		require.Contains(t, diff, "+foo")
+	})
+
 	testCommitMessage := fmt.Sprintf("test commit %s", uuid.NewString())
 	err = rep.AddAllAndCommit(testCommitMessage)
 	require.NoError(t, err)
@@ -126,6 +134,16 @@ func TestRepo(t *testing.T) {
 		require.Equal(t, testCommitMessage, msg)
 	})
 
+	t.Run("can list commits", func(t *testing.T) {
+		var commits []CommitMetadata
+		commits, err = rep.ListCommits(0, 0)
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+		require.Equal(t, lastCommitID, commits[0].ID)
+		require.Equal(t, testCommitMessage, commits[0].Subject)
+		require.Empty(t, commits[0].Body)
+	})
+
 	t.Run("can get diff paths", func(t *testing.T) {
 		var paths []string
 		paths, err = rep.GetDiffPathsForCommitID(lastCommitID)
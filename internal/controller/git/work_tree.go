@@ -42,6 +42,9 @@ type WorkTree interface {
 	CurrentBranch() (string, error)
 	// DeleteBranch deletes the specified branch
 	DeleteBranch(branch string) error
+	// Diff stages pending changes and returns a unified diff of those changes
+	// against the head of the current branch.
+	Diff() (string, error)
 	// Dir returns an absolute path to the working tree.
 	Dir() string
 	// HasDiffs returns a bool indicating whether the working tree currently
@@ -190,7 +193,10 @@ type CommitOptions struct {
 	// AllowEmpty indicates whether an empty commit should be allowed.
 	AllowEmpty bool
 	// Author is the author of the commit. If nil, the default author already
-	// configured in the git repository will be used.
+	// configured in the git repository will be used. Any of the author's
+	// fields that are unspecified also default to the configured values,
+	// except for the signing key, which, if specified, is used to sign the
+	// commit in place of any signing key that is already configured.
 	Author *User
 }
 
@@ -198,12 +204,37 @@ func (w *workTree) Commit(message string, opts *CommitOptions) error {
 	if opts == nil {
 		opts = &CommitOptions{}
 	}
-	cmdTokens := []string{"commit", "-m", message}
+	var cmdTokens, env []string
+	if opts.Author != nil {
+		// The author's identity and signing key apply to this commit only, so
+		// they are specified as configuration overrides rather than written to
+		// the repository's configuration. Any key imported or copied for
+		// signing the commit is removed once it has been made.
+		signing, err := w.setupSigning(opts.Author, "")
+		if err != nil {
+			return fmt.Errorf("error configuring commit signing: %w", err)
+		}
+		defer signing.cleanup()
+		authorConfig := signing.config
+		env = signing.env
+		if opts.Author.Email != "" {
+			authorConfig = append([]string{"user.email=" + opts.Author.Email}, authorConfig...)
+		}
+		if opts.Author.Name != "" {
+			authorConfig = append([]string{"user.name=" + opts.Author.Name}, authorConfig...)
+		}
+		for _, kv := range authorConfig {
+			cmdTokens = append(cmdTokens, "-c", kv)
+		}
+	}
+	cmdTokens = append(cmdTokens, "commit", "-m", message)
 	if opts.AllowEmpty {
 		cmdTokens = append(cmdTokens, "--allow-empty")
 	}
 
-	if _, err := libExec.Exec(w.buildGitCommand(cmdTokens...)); err != nil {
+	cmd := w.buildGitCommand(cmdTokens...)
+	cmd.Env = append(cmd.Env, env...)
+	if _, err := libExec.Exec(cmd); err != nil {
 		return fmt.Errorf("error committing changes: %w", err)
 	}
 	return nil
@@ -283,6 +314,19 @@ func (w *workTree) GetDiffPathsForCommitID(commitID string) ([]string, error) {
 	return paths, nil
 }
 
+func (w *workTree) Diff() (string, error) {
+	if err := w.AddAll(); err != nil {
+		return "", err
+	}
+	resBytes, err := libExec.Exec(
+		w.buildGitCommand("diff", "--cached", "--no-color", "--no-ext-diff"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("error diffing staged changes: %w", err)
+	}
+	return string(resBytes), nil
+}
+
 func (w *workTree) HasDiffs() (bool, error) {
 	resBytes, err := libExec.Exec(w.buildGitCommand("status", "-s"))
 	if err != nil {
@@ -325,11 +369,16 @@ type CommitMetadata struct {
 	Committer string
 	// Subject is the subject (first line) of the commit message.
 	Subject string
+	// Body is the remainder of the commit message, following the subject.
+	Body string
 }
 
 func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 	args := []string{
 		"log",
+		// Separate commits with NULs, as commit message bodies may span
+		// multiple lines.
+		"-z",
 		// This format is designed to output the following fields, separated by
 		// tabs (%x09):
 		//
@@ -337,8 +386,8 @@ func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 		// - commit date
 		// - author name and email
 		// - committer name and email
-		// - subject
-		"--pretty=format:%H%x09%ci%x09%an <%ae>%x09%cn <%ce>%x09%s",
+		// - subject and body, separated by a unit separator (%x1f)
+		"--pretty=format:%H%x09%ci%x09%an <%ae>%x09%cn <%ce>%x09%s%x1f%b",
 	}
 	if limit > 0 {
 		args = append(args, fmt.Sprintf("--max-count=%d", limit))
@@ -353,13 +402,15 @@ func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 	}
 
 	var commits []CommitMetadata
-	scanner := bufio.NewScanner(bytes.NewReader(commitsBytes))
-	for scanner.Scan() {
-		line := scanner.Bytes()
-		parts := bytes.SplitN(scanner.Bytes(), []byte("\t"), 5)
+	for _, record := range bytes.Split(commitsBytes, []byte{0}) {
+		if len(record) == 0 {
+			continue
+		}
+		parts := bytes.SplitN(record, []byte("\t"), 5)
 		if len(parts) != 5 {
-			return nil, fmt.Errorf("unexpected number of fields: %q", line)
+			return nil, fmt.Errorf("unexpected number of fields: %q", record)
 		}
+		subject, body, _ := bytes.Cut(parts[4], []byte{0x1f})
 
 		commitDate, err := time.Parse("2006-01-02 15:04:05 -0700", string(parts[1]))
 		if err != nil {
@@ -371,7 +422,8 @@ func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 			CommitDate: commitDate,
 			Author:     string(parts[2]),
 			Committer:  string(parts[3]),
-			Subject:    string(parts[4]),
+			Subject:    string(subject),
+			Body:       string(bytes.TrimSpace(body)),
 		})
 	}
 
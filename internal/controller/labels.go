@@ -66,12 +66,14 @@ func GetShardRequirement(shard string) (*labels.Requirement, error) {
 
 // GetCredentialsRequirement returns a label requirement that matches only
 // resources that have a credential type label set to one of the supported
-// credential types.
+// credential types, or that are labeled as Git signing keys.
 func GetCredentialsRequirement() (*labels.Requirement, error) {
 	req, err := labels.NewRequirement(kargoapi.CredentialTypeLabelKey, selection.In, []string{
+		credentials.TypeFile.String(),
 		credentials.TypeGit.String(),
 		credentials.TypeHelm.String(),
 		credentials.TypeImage.String(),
+		kargoapi.CredentialTypeLabelValueGitSigningKey,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error creating credentials label selector: %w", err)
@@ -89,6 +89,13 @@ func TestGetCredentialsRequirement(t *testing.T) {
 			},
 			matches: true,
 		},
+		{
+			name: "credential type label set to git-signing-key",
+			labels: labels.Set{
+				kargoapi.CredentialTypeLabelKey: kargoapi.CredentialTypeLabelValueGitSigningKey,
+			},
+			matches: true,
+		},
 		{
 			name: "credential type label set to unknown type",
 			labels: labels.Set{
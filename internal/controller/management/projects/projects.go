@@ -460,7 +460,7 @@ func (r *reconciler) ensureDefaultProjectRoles(
 				},
 				{ // Full access to all mutable Kargo resource types
 					APIGroups: []string{kargoapi.GroupVersion.Group},
-					Resources: []string{"freights", "stages", "warehouses"},
+					Resources: []string{"freights", "promotiontemplates", "stages", "warehouses"},
 					Verbs:     []string{"*"},
 				},
 				{ // Promote permission on all stages
@@ -512,8 +512,14 @@ func (r *reconciler) ensureDefaultProjectRoles(
 				},
 				{
 					APIGroups: []string{kargoapi.GroupVersion.Group},
-					Resources: []string{"freights", "promotions", "stages", "warehouses"},
-					Verbs:     []string{"get", "list", "watch"},
+					Resources: []string{
+						"freights",
+						"promotions",
+						"promotiontemplates",
+						"stages",
+						"warehouses",
+					},
+					Verbs: []string{"get", "list", "watch"},
 				},
 				{
 					APIGroups: []string{rolloutsapi.GroupVersion.Group},
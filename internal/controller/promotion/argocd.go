@@ -2,11 +2,13 @@ package promotion
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/gobwas/glob"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -194,6 +196,32 @@ func (a *argoCDMechanism) Promote(
 			continue
 		}
 
+		if promo.Spec.DryRun {
+			// Record the update instead of performing it. As there is nothing
+			// to wait for, the update is considered to have succeeded.
+			var patch string
+			if patch, err = buildApplicationSpecPatch(
+				app,
+				desiredSource,
+				desiredSources,
+			); err != nil {
+				return err
+			}
+			if promo.Status.Plan == nil {
+				promo.Status.Plan = &kargoapi.PromotionPlan{}
+			}
+			promo.Status.Plan.ArgoCDAppChanges = append(
+				promo.Status.Plan.ArgoCDAppChanges,
+				kargoapi.ArgoCDAppChange{
+					AppNamespace: app.Namespace,
+					AppName:      app.Name,
+					Patch:        patch,
+				},
+			)
+			updateResults = append(updateResults, argocd.OperationSucceeded)
+			continue
+		}
+
 		// Perform the update.
 		if err = a.syncApplicationFn(
 			ctx,
@@ -221,6 +249,41 @@ func (a *argoCDMechanism) Promote(
 	return nil
 }
 
+// buildApplicationSpecPatch returns a JSON merge patch of the spec of the
+// provided Argo CD Application that would update its source(s) to the desired
+// source(s).
+func buildApplicationSpecPatch(
+	app *argocd.Application,
+	desiredSource *argocd.ApplicationSource,
+	desiredSources argocd.ApplicationSources,
+) (string, error) {
+	desiredSpec := app.Spec.DeepCopy()
+	desiredSpec.Source = desiredSource.DeepCopy()
+	desiredSpec.Sources = desiredSources.DeepCopy()
+	origJSON, err := json.Marshal(app.Spec)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error marshaling spec of Argo CD Application %q in namespace %q: %w",
+			app.Name, app.Namespace, err,
+		)
+	}
+	desiredJSON, err := json.Marshal(desiredSpec)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error marshaling desired spec of Argo CD Application %q in namespace %q: %w",
+			app.Name, app.Namespace, err,
+		)
+	}
+	patch, err := jsonpatch.CreateMergePatch(origJSON, desiredJSON)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error creating patch for Argo CD Application %q in namespace %q: %w",
+			app.Name, app.Namespace, err,
+		)
+	}
+	return string(patch), nil
+}
+
 // buildDesiredSources returns the desired source(s) for an Argo CD Application,
 // by updating the current source(s) with the given source updates.
 func (a *argoCDMechanism) buildDesiredSources(
@@ -45,6 +45,7 @@ func TestArgoCDGetName(t *testing.T) {
 func TestArgoCDPromote(t *testing.T) {
 	testCases := []struct {
 		name       string
+		dryRun     bool
 		promoMech  *argoCDMechanism
 		stage      *kargoapi.Stage
 		newFreight []kargoapi.FreightReference
@@ -687,10 +688,106 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, origFreight, promo.Status.FreightCollection)
 			},
 		},
+		{
+			name:   "dry run",
+			dryRun: true,
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewFakeClient(),
+				getAuthorizedApplicationFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-app",
+						},
+						Spec: argocd.ApplicationSpec{
+							Source: &argocd.ApplicationSource{
+								RepoURL:        "https://github.com/example/repo",
+								TargetRevision: "fake-revision",
+							},
+						},
+					}, nil
+				},
+				buildDesiredSourcesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+				) (*argocd.ApplicationSource, argocd.ApplicationSources, error) {
+					return &argocd.ApplicationSource{
+						RepoURL:        "https://github.com/example/repo",
+						TargetRevision: "new-fake-revision",
+					}, nil, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					*kargoapi.FreightCollection,
+					*argocd.ApplicationSource,
+					argocd.ApplicationSources,
+				) (argocd.OperationPhase, bool, error) {
+					return "", true, nil
+				},
+				syncApplicationFn: func(
+					context.Context,
+					*argocd.Application,
+					*argocd.ApplicationSource,
+					argocd.ApplicationSources,
+					string,
+				) error {
+					return errors.New("syncApplicationFn should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+									{},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				origFreight *kargoapi.FreightCollection,
+				promo *kargoapi.Promotion,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Equal(
+					t,
+					&kargoapi.PromotionPlan{
+						ArgoCDAppChanges: []kargoapi.ArgoCDAppChange{{
+							AppNamespace: "fake-namespace",
+							AppName:      "fake-app",
+							Patch:        `{"source":{"targetRevision":"new-fake-revision"}}`,
+						}},
+					},
+					promo.Status.Plan,
+				)
+				// The freight collection should be unaltered
+				require.Equal(t, origFreight, promo.Status.FreightCollection)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			promo := &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					DryRun: testCase.dryRun,
+				},
 				Status: kargoapi.PromotionStatus{
 					FreightCollection: &kargoapi.FreightCollection{},
 				},
@@ -88,6 +88,8 @@ func (c *compositeMechanism) Promote(
 //  2. The FreightCollection is unconditionally updated to that of src.
 //  3. Metadata from src is merged into Metadata from olderStatus, with Metadata
 //     from src taking precedence in case of key conflicts.
+//  4. The Plan is unconditionally updated to that of src, which already
+//     includes any changes planned prior to src.
 //
 // Both arguments must be non-nil.
 func mergePromoStatus(
@@ -111,6 +113,7 @@ func mergePromoStatus(
 		mergedStatus.Message = firstNonEmpty(mergedStatus.Message, newerStatus.Message)
 	}
 	mergedStatus.FreightCollection = newerStatus.FreightCollection
+	mergedStatus.Plan = newerStatus.Plan
 	// Merge the two metadata maps
 	if len(newerStatus.Metadata) > 0 {
 		if mergedStatus.Metadata == nil {
@@ -214,6 +214,22 @@ func TestMergePromoStatus(t *testing.T) {
 		require.Same(t, newerStatus.FreightCollection, mergedStatus.FreightCollection)
 	})
 
+	t.Run("plan replacement", func(t *testing.T) {
+		olderStatus := &kargoapi.PromotionStatus{
+			Plan: &kargoapi.PromotionPlan{
+				GitRepoChanges: []kargoapi.GitRepoChange{{
+					RepoURL: "https://github.com/example/repo",
+				}},
+			},
+		}
+		newerStatus := olderStatus.DeepCopy()
+		newerStatus.Plan.ArgoCDAppChanges = []kargoapi.ArgoCDAppChange{{
+			AppName: "fake-app",
+		}}
+		mergedStatus := mergePromoStatus(newerStatus, olderStatus)
+		require.Same(t, newerStatus.Plan, mergedStatus.Plan)
+	})
+
 	t.Run("metadata merging", func(t *testing.T) {
 		olderStatus := &kargoapi.PromotionStatus{
 			Metadata: map[string]string{
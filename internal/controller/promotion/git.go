@@ -70,6 +70,16 @@ type gitMechanism struct {
 		repo git.Repo,
 		repoCreds git.RepoCredentials,
 	) (string, error)
+	gitDiffFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		update *kargoapi.GitRepoUpdate,
+		newFreight []kargoapi.FreightReference,
+		readRef string,
+		writeBranch string,
+		repo git.Repo,
+		repoCreds git.RepoCredentials,
+	) (string, error)
 	applyConfigManagementFn func(
 		ctx context.Context,
 		stage *kargoapi.Stage,
@@ -113,6 +123,7 @@ func newGitMechanism(
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
 	g.getAuthorFn = g.getAuthor
 	g.gitCommitFn = g.gitCommit
+	g.gitDiffFn = g.gitDiff
 	g.applyConfigManagementFn = applyConfigManagementFn
 	return g
 }
@@ -208,6 +219,37 @@ func (g *gitMechanism) doSingleUpdate(
 	}
 	defer repo.Close()
 
+	if promo.Spec.DryRun {
+		// A dry run neither commits nor opens a PR. Instead, the changes are
+		// diffed against the branch they would ultimately have been written to.
+		var diff string
+		if diff, err = g.gitDiffFn(
+			ctx,
+			stage,
+			update,
+			promo.Status.FreightCollection.References(),
+			readRef,
+			update.WriteBranch,
+			repo,
+			*creds,
+		); err != nil {
+			return err
+		}
+		if promo.Status.Plan == nil {
+			promo.Status.Plan = &kargoapi.PromotionPlan{}
+		}
+		promo.Status.Plan.GitRepoChanges = append(
+			promo.Status.Plan.GitRepoChanges,
+			kargoapi.GitRepoChange{
+				RepoURL: update.RepoURL,
+				Branch:  update.WriteBranch,
+				Diff:    diff,
+			},
+		)
+		promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+		return nil
+	}
+
 	commitBranch := update.WriteBranch
 	if update.PullRequest != nil {
 		// When doing a PR promotion, instead of committing to writeBranch directly,
@@ -341,6 +383,8 @@ func (g *gitMechanism) getAuthor() (*git.User, error) {
 	switch strings.ToLower(g.cfg.SigningKeyType) {
 	case "gpg", "":
 		author.SigningKeyType = git.SigningKeyTypeGPG
+	case "ssh":
+		author.SigningKeyType = git.SigningKeyTypeSSH
 	default:
 		return nil, fmt.Errorf(
 			"unsupported signing key type: %q",
@@ -376,6 +420,89 @@ func (g *gitMechanism) gitCommit(
 	writeBranch string,
 	repo git.Repo,
 	repoCreds git.RepoCredentials,
+) (string, error) {
+	commitMsg, err := g.prepareChanges(
+		ctx,
+		stage,
+		update,
+		newFreight,
+		readRef,
+		writeBranch,
+		repo,
+		repoCreds,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	hasDiffs, err := repo.HasDiffs()
+	if err != nil {
+		return "", fmt.Errorf("error checking for diffs in git repo %q: %w", update.RepoURL, err)
+	}
+
+	if hasDiffs {
+		if err = repo.AddAllAndCommit(commitMsg); err != nil {
+			return "", fmt.Errorf("error committing updates to git repo %q: %w", update.RepoURL, err)
+		}
+		if err = repo.Push(nil); err != nil {
+			return "", fmt.Errorf("error pushing updates to git repo %q: %w", update.RepoURL, err)
+		}
+	}
+
+	commitID, err := repo.LastCommitID()
+	if err != nil {
+		return "", fmt.Errorf("error getting last commit ID from git repo %q: %w", update.RepoURL, err)
+	}
+
+	return commitID, nil
+}
+
+// gitDiff prepares the changes to the cloned repository exactly as gitCommit
+// does, but instead of committing and pushing them to the specified
+// writeBranch, it returns a unified diff of the changes against that branch.
+func (g *gitMechanism) gitDiff(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.GitRepoUpdate,
+	newFreight []kargoapi.FreightReference,
+	readRef string,
+	writeBranch string,
+	repo git.Repo,
+	repoCreds git.RepoCredentials,
+) (string, error) {
+	if _, err := g.prepareChanges(
+		ctx,
+		stage,
+		update,
+		newFreight,
+		readRef,
+		writeBranch,
+		repo,
+		repoCreds,
+	); err != nil {
+		return "", err
+	}
+	diff, err := repo.Diff()
+	if err != nil {
+		return "", fmt.Errorf("error diffing updates to git repo %q: %w", update.RepoURL, err)
+	}
+	return diff, nil
+}
+
+// prepareChanges checks out the specified readRef (if non-empty), applies the
+// provided update function to the cloned repository and, if necessary, moves
+// the resulting changes on top of the specified writeBranch. The changes are
+// left uncommitted in the working tree. The function returns a commit message
+// summarizing the changes.
+func (g *gitMechanism) prepareChanges(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.GitRepoUpdate,
+	newFreight []kargoapi.FreightReference,
+	readRef string,
+	writeBranch string,
+	repo git.Repo,
+	repoCreds git.RepoCredentials,
 ) (string, error) {
 	var err error
 	// If readRef is non-empty, check out the specified commit or branch,
@@ -462,26 +589,7 @@ func (g *gitMechanism) gitCommit(
 		}
 	}
 
-	hasDiffs, err := repo.HasDiffs()
-	if err != nil {
-		return "", fmt.Errorf("error checking for diffs in git repo %q: %w", update.RepoURL, err)
-	}
-
-	if hasDiffs {
-		if err = repo.AddAllAndCommit(commitMsg); err != nil {
-			return "", fmt.Errorf("error committing updates to git repo %q: %w", update.RepoURL, err)
-		}
-		if err = repo.Push(nil); err != nil {
-			return "", fmt.Errorf("error pushing updates to git repo %q: %w", update.RepoURL, err)
-		}
-	}
-
-	commitID, err := repo.LastCommitID()
-	if err != nil {
-		return "", fmt.Errorf("error getting last commit ID from git repo %q: %w", update.RepoURL, err)
-	}
-
-	return commitID, nil
+	return commitMsg, nil
 }
 
 // moveRepoContents transplants the entire contents of the source directory
@@ -49,6 +49,7 @@ func TestNewGitMechanism(t *testing.T) {
 	require.NotNil(t, gpm.getAuthorFn)
 	require.NotNil(t, gpm.getCredentialsFn)
 	require.NotNil(t, gpm.gitCommitFn)
+	require.NotNil(t, gpm.gitDiffFn)
 	require.NotNil(t, gpm.applyConfigManagementFn)
 }
 
@@ -61,6 +62,7 @@ func TestGitGetName(t *testing.T) {
 func TestGitPromote(t *testing.T) {
 	testCases := []struct {
 		name       string
+		dryRun     bool
 		promoMech  *gitMechanism
 		assertions func(
 			t *testing.T,
@@ -168,6 +170,7 @@ func TestGitDoSingleUpdate(t *testing.T) {
 	const testRef = "fake-ref"
 	testCases := []struct {
 		name       string
+		dryRun     bool
 		promoMech  *gitMechanism
 		assertions func(
 			t *testing.T,
@@ -375,6 +378,76 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				require.Equal(t, origRefs, updatedRefs)
 			},
 		},
+		{
+			name:   "dry run",
+			dryRun: true,
+			promoMech: &gitMechanism{
+				getReadRefFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					*kargoapi.GitRepoUpdate,
+					[]kargoapi.FreightReference,
+				) (string, *kargoapi.GitCommit, error) {
+					return testRef, nil, nil
+				},
+				getAuthorFn: func() (*git.User, error) {
+					return nil, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				gitCommitFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.GitRepoUpdate,
+					[]kargoapi.FreightReference,
+					string,
+					string,
+					git.Repo,
+					git.RepoCredentials,
+				) (string, error) {
+					return "", errors.New("gitCommitFn should not be called")
+				},
+				gitDiffFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.GitRepoUpdate,
+					[]kargoapi.FreightReference,
+					string,
+					string,
+					git.Repo,
+					git.RepoCredentials,
+				) (string, error) {
+					return "fake-diff", nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				origFreight *kargoapi.FreightCollection,
+				promo *kargoapi.Promotion,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Equal(
+					t,
+					&kargoapi.PromotionPlan{
+						GitRepoChanges: []kargoapi.GitRepoChange{{
+							RepoURL: "https://github.com/akuity/kargo",
+							Diff:    "fake-diff",
+						}},
+					},
+					promo.Status.Plan,
+				)
+				// The freight collection should be unaltered
+				require.Equal(t, origFreight, promo.Status.FreightCollection)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -386,6 +459,9 @@ func TestGitDoSingleUpdate(t *testing.T) {
 			)
 			promo := &kargoapi.Promotion{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace"},
+				Spec: kargoapi.PromotionSpec{
+					DryRun: testCase.dryRun,
+				},
 				Status: kargoapi.PromotionStatus{
 					FreightCollection: freight,
 				},
@@ -8,6 +8,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/gitprovider"
+	"github.com/akuity/kargo/internal/gitprovider/bitbucket"
 	"github.com/akuity/kargo/internal/gitprovider/github"
 	"github.com/akuity/kargo/internal/gitprovider/gitlab"
 )
@@ -106,6 +107,8 @@ func newGitProvider(
 		gpOpts.Name = github.GitProviderServiceName
 	case update.PullRequest.GitLab != nil:
 		gpOpts.Name = gitlab.GitProviderServiceName
+	case update.PullRequest.Bitbucket != nil:
+		gpOpts.Name = bitbucket.GitProviderServiceName
 	}
 	return gitprovider.NewGitProviderService(update.RepoURL, gpOpts)
 }
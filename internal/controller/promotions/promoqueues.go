@@ -48,7 +48,8 @@ func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.Pr
 	logger := logging.LoggerFromContext(ctx)
 	for _, promo := range promos.Items {
 		promo := promo // This is to sidestep implicit memory aliasing in this for loop
-		if promo.Status.Phase.IsTerminal() || len(promo.Spec.Stage) == 0 {
+		// Dry runs are not subject to the queues.
+		if promo.Status.Phase.IsTerminal() || len(promo.Spec.Stage) == 0 || promo.Spec.DryRun {
 			continue
 		}
 		stage := types.NamespacedName{
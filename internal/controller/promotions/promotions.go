@@ -2,7 +2,10 @@ package promotions
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"sync"
@@ -10,6 +13,7 @@ import (
 
 	"github.com/kelseyhightower/envconfig"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,15 +30,30 @@ import (
 	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/controller/runtime"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/directives"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	libEvent "github.com/akuity/kargo/internal/kubernetes/event"
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// defaultStepRetryBackoff is the duration to wait before the first retry of a
+// failed attempt at executing a Promotion step, if the step does not specify
+// otherwise.
+const defaultStepRetryBackoff = 10 * time.Second
+
 // ReconcilerConfig represents configuration for the promotion reconciler.
 type ReconcilerConfig struct {
 	ShardName string `envconfig:"SHARD_NAME"`
+	// DirectivePluginsConfigPath is the path to a YAML file describing the
+	// out-of-process directive plugins to make available to Promotion steps.
+	DirectivePluginsConfigPath string `envconfig:"DIRECTIVE_PLUGINS_CONFIG_PATH"`
+	// WorkDir is the path to a directory under which the working directories
+	// of running Promotions are kept. For a Promotion waiting on one of its
+	// steps to be resumed after a restart of the controller, this directory
+	// must survive such a restart. If empty, the system's temporary directory
+	// is used.
+	WorkDir string `envconfig:"PROMOTION_WORK_DIR"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -53,8 +72,9 @@ func ReconcilerConfigFromEnv() ReconcilerConfig {
 
 // reconciler reconciles Promotion resources.
 type reconciler struct {
-	kargoClient     client.Client
-	promoMechanisms promotion.Mechanism
+	kargoClient      client.Client
+	promoMechanisms  promotion.Mechanism
+	directivesEngine *directives.Engine
 
 	cfg ReconcilerConfig
 
@@ -108,11 +128,25 @@ func SetupReconcilerWithManager(
 		argocdClient = argocdMgr.GetClient()
 	}
 
+	directivesRegistry := directives.BuiltinsRegistry()
+	if cfg.DirectivePluginsConfigPath != "" {
+		pluginsCfg, err := directives.LoadPluginsConfig(cfg.DirectivePluginsConfigPath)
+		if err != nil {
+			return fmt.Errorf("error loading directive plugins configuration: %w", err)
+		}
+		for _, pluginCfg := range pluginsCfg.Plugins {
+			if err = directivesRegistry.RegisterPlugin(ctx, pluginCfg); err != nil {
+				return fmt.Errorf("error registering directive plugin: %w", err)
+			}
+		}
+	}
+
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
 		argocdClient,
 		libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
 		credentialsDB,
+		directivesRegistry,
 		cfg,
 	)
 
@@ -178,6 +212,7 @@ func newReconciler(
 	argocdClient client.Client,
 	recorder record.EventRecorder,
 	credentialsDB credentials.Database,
+	directivesRegistry directives.DirectiveRegistry,
 	cfg ReconcilerConfig,
 ) *reconciler {
 	pqs := promoQueues{
@@ -194,6 +229,12 @@ func newReconciler(
 			argocdClient,
 			credentialsDB,
 		),
+		directivesEngine: directives.NewEngine(
+			directivesRegistry,
+			credentialsDB,
+			kargoClient,
+			argocdClient,
+		),
 	}
 	r.getStageFn = kargoapi.GetStage
 	r.promoteFn = r.promote
@@ -237,9 +278,17 @@ func (r *reconciler) Reconcile(
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	if promo == nil || promo.Status.Phase.IsTerminal() {
-		// Ignore if not found or already finished. Promo might be nil if the
-		// Promotion was deleted after the current reconciliation request was issued.
+	if promo == nil {
+		// Ignore if not found. Promo might be nil if the Promotion was deleted
+		// after the current reconciliation request was issued.
+		return ctrl.Result{}, nil
+	}
+	if !promo.DeletionTimestamp.IsZero() {
+		logger.Debug("Promotion is being deleted")
+		return ctrl.Result{}, r.cleanupPromotion(ctx, promo)
+	}
+	if promo.Status.Phase.IsTerminal() {
+		// Ignore if already finished.
 		return ctrl.Result{}, nil
 	}
 	// Find the Freight
@@ -266,6 +315,10 @@ func (r *reconciler) Reconcile(
 	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
 		// anything we've already marked Running, we allow it to continue to reconcile
 		logger.Debug("continuing Promotion")
+	} else if promo.Spec.DryRun {
+		// A dry run does not make any changes, so it neither waits for, nor holds
+		// up, other Promotions to the same Stage.
+		logger.Info("began dry-run promotion")
 	} else {
 		// promo is Pending. Try to begin it.
 		if !r.pqs.tryBegin(ctx, promo) {
@@ -291,6 +344,15 @@ func (r *reconciler) Reconcile(
 		}
 	}
 
+	// The working directory of the Promotion's steps is retained for as long
+	// as the Promotion is running. A finalizer ensures it is removed, should the
+	// Promotion be deleted before it finishes.
+	if len(promo.Spec.Steps) > 0 || promo.Spec.PromotionTemplate != nil {
+		if _, err = kargoapi.EnsureFinalizer(ctx, r.kargoClient, promo); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error ensuring finalizer on Promotion: %w", err)
+		}
+	}
+
 	// Retrieve the Stage associated with the Promotion.
 	stage, err := r.getStageFn(
 		ctx,
@@ -328,7 +390,10 @@ func (r *reconciler) Reconcile(
 	// Freight self-aware of the Stages it has been promoted to, or even
 	// more radically, by making the Promotion self-aware of the Freight
 	// collection it is promoting.
-	if stage.Status.CurrentPromotion == nil || stage.Status.CurrentPromotion.Name != promo.Name {
+	//
+	// A dry run is exempt from this, as the Stage never awaits it.
+	if !promo.Spec.DryRun &&
+		(stage.Status.CurrentPromotion == nil || stage.Status.CurrentPromotion.Name != promo.Name) {
 		logger.Debug("Stage is not awaiting Promotion", "stage", stage.Name, "promotion", promo.Name)
 		return ctrl.Result{Requeue: true}, nil
 	}
@@ -384,8 +449,16 @@ func (r *reconciler) Reconcile(
 		logger.Error(err, "error updating Promotion status")
 	}
 
-	// Record event after patching status if new phase is terminal
-	if newStatus.Phase.IsTerminal() {
+	// The working directory of the Promotion's steps is retained for as long
+	// as the Promotion is running, so that execution can be resumed from where
+	// it was left. It is no longer needed once the Promotion has finished.
+	if err == nil && newStatus.Phase.IsTerminal() {
+		err = r.cleanupPromotion(ctx, promo)
+	}
+
+	// Record event after patching status if new phase is terminal. A dry run
+	// did not actually promote anything, so no event is recorded for it.
+	if newStatus.Phase.IsTerminal() && !promo.Spec.DryRun {
 		stage, getStageErr := r.getStageFn(
 			ctx,
 			r.kargoClient,
@@ -439,14 +512,29 @@ func (r *reconciler) Reconcile(
 
 	// If the promotion is still running, we'll need to periodically check on
 	// it.
-	//
-	// TODO: Make this configurable
 	if newStatus.Phase == kargoapi.PromotionPhaseRunning {
-		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+		return ctrl.Result{RequeueAfter: getRequeueInterval(newStatus)}, nil
 	}
 	return ctrl.Result{}, nil
 }
 
+// getRequeueInterval returns the amount of time to wait before checking on a
+// running Promotion with the provided status again. If one of its steps failed
+// and is to be retried, this is the time until the next attempt is due.
+//
+// TODO: Make the default interval configurable
+func getRequeueInterval(status *kargoapi.PromotionStatus) time.Duration {
+	interval := 5 * time.Minute
+	for _, step := range status.Steps {
+		if step.NextAttempt != nil {
+			// A zero RequeueAfter would disable requeueing altogether, so an
+			// attempt that is already due is checked on shortly instead.
+			interval = min(interval, max(time.Until(step.NextAttempt.Time), time.Second))
+		}
+	}
+	return interval
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -480,11 +568,13 @@ func (r *reconciler) promote(
 	logger = logger.WithValues("targetFreight", targetFreight.Name)
 
 	targetFreightRef := kargoapi.FreightReference{
-		Name:    targetFreight.Name,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
-		Origin:  targetFreight.Origin,
+		Name:      targetFreight.Name,
+		Commits:   targetFreight.Commits,
+		Images:    targetFreight.Images,
+		Charts:    targetFreight.Charts,
+		Artifacts: targetFreight.Artifacts,
+		Files:     targetFreight.Files,
+		Origin:    targetFreight.Origin,
 	}
 
 	// Make a deep copy of the Promotion to pass to the promotion mechanisms,
@@ -497,13 +587,17 @@ func (r *reconciler) promote(
 		stage,
 	)
 
-	if err := r.promoMechanisms.Promote(ctx, stage, workingPromo); err != nil {
+	if len(workingPromo.Spec.Steps) > 0 || workingPromo.Spec.PromotionTemplate != nil {
+		if err := r.executeSteps(ctx, stage, workingPromo); err != nil {
+			return nil, err
+		}
+	} else if err := r.promoMechanisms.Promote(ctx, stage, workingPromo); err != nil {
 		return nil, err
 	}
 
 	logger.Debug("promotion", "phase", workingPromo.Status.Phase)
 
-	if workingPromo.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+	if workingPromo.Status.Phase == kargoapi.PromotionPhaseSucceeded && !promo.Spec.DryRun {
 		// Trigger re-verification of the Stage if the promotion succeeded and
 		// this is a re-promotion of the same Freight.
 		current := stage.Status.FreightHistory.Current()
@@ -531,6 +625,321 @@ func (r *reconciler) promote(
 	return &workingPromo.Status, nil
 }
 
+// executeSteps executes the Steps of the provided Promotion using the
+// directives Engine and records the outcome of each executed Step in the
+// Promotion's status. The Promotion's phase is updated to reflect the overall
+// outcome of the execution. An error is only returned if the Steps could not
+// be executed at all.
+func (r *reconciler) executeSteps(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	promoSteps, vars := promo.Spec.Steps, promo.Spec.Vars
+	if promo.Spec.PromotionTemplate != nil {
+		// The PromotionTemplate is expanded only once, when the Promotion
+		// starts. Subsequent reconciliations resume from that snapshot, so that
+		// the step CurrentStep refers to does not change underneath them.
+		if promo.Status.ExpandedTemplate == nil {
+			templateSteps, templateVars, err := r.expandPromotionTemplate(ctx, promo)
+			if err != nil {
+				return err
+			}
+			promo.Status.ExpandedTemplate = &kargoapi.ExpandedPromotionTemplate{
+				Steps: templateSteps,
+				Vars:  templateVars,
+			}
+		}
+		promoSteps = promo.Status.ExpandedTemplate.Steps
+		vars = promo.Status.ExpandedTemplate.Vars
+	}
+
+	steps := make([]directives.Step, len(promoSteps))
+	for i, step := range promoSteps {
+		var err error
+		if steps[i], err = buildDirectivesStep(step); err != nil {
+			return err
+		}
+	}
+
+	// If a previous reconciliation left the Promotion waiting on one of its
+	// steps, execution is resumed from that step, using the working directory
+	// and shared state left behind by the steps that were already executed.
+	promoCtx := directives.PromotionContext{
+		Project:         promo.Namespace,
+		Stage:           promo.Spec.Stage,
+		FreightRequests: stage.Spec.RequestedFreight,
+		Vars:            vars,
+		WorkDir:         r.getPromotionWorkDir(promo),
+		StartFromStep:   promo.Status.CurrentStep,
+		DryRun:          promo.Spec.DryRun,
+	}
+	if promo.Status.FreightCollection != nil {
+		promoCtx.Freight = *promo.Status.FreightCollection.DeepCopy()
+	}
+	if promo.Status.State != nil {
+		if err := json.Unmarshal(promo.Status.State.Raw, &promoCtx.State); err != nil {
+			return fmt.Errorf("error unmarshaling state of Promotion steps: %w", err)
+		}
+	}
+	if err := os.MkdirAll(promoCtx.WorkDir, 0o700); err != nil {
+		return fmt.Errorf("error creating working directory for Promotion steps: %w", err)
+	}
+
+	res, execErr := r.directivesEngine.Execute(ctx, promoCtx, steps)
+
+	// Retain the status of the steps that were executed by previous
+	// reconciliations, and replace the status of all others.
+	retained := countStepStatuses(
+		steps[:min(promoCtx.StartFromStep, int64(len(steps)))],
+	)
+	if len(promo.Status.Steps) > retained {
+		promo.Status.Steps = promo.Status.Steps[:retained]
+	}
+	for _, stepRes := range res.StepResults {
+		stepStatus, err := buildPromotionStepStatus(stepRes)
+		if err != nil {
+			return err
+		}
+		promo.Status.Steps = append(promo.Status.Steps, stepStatus)
+		// The changes planned by the steps of a dry run accumulate over
+		// subsequent reconciliations.
+		if plan := stepRes.Result.Plan; plan != nil {
+			if promo.Status.Plan == nil {
+				promo.Status.Plan = &kargoapi.PromotionPlan{}
+			}
+			promo.Status.Plan.GitRepoChanges = append(
+				promo.Status.Plan.GitRepoChanges,
+				plan.GitRepoChanges...,
+			)
+			promo.Status.Plan.ArgoCDAppChanges = append(
+				promo.Status.Plan.ArgoCDAppChanges,
+				plan.ArgoCDAppChanges...,
+			)
+		}
+	}
+
+	promo.Status.CurrentStep = res.CurrentStep
+	promo.Status.State = nil
+	if len(res.State) > 0 {
+		state, err := json.Marshal(res.State)
+		if err != nil {
+			return fmt.Errorf("error marshaling state of Promotion steps: %w", err)
+		}
+		promo.Status.State = &apiextensionsv1.JSON{Raw: state}
+	}
+
+	switch res.Status {
+	case directives.StatusSuccess:
+		promo.Status.Phase = kargoapi.PromotionPhaseSucceeded
+	case directives.StatusPending:
+		promo.Status.Phase = kargoapi.PromotionPhaseRunning
+	default:
+		promo.Status.Phase = kargoapi.PromotionPhaseFailed
+		if execErr != nil {
+			promo.Status.Message = execErr.Error()
+		}
+		logger.Error(execErr, "error executing Promotion steps")
+	}
+	return nil
+}
+
+// expandPromotionTemplate returns the steps of the PromotionTemplate referenced
+// by the provided Promotion, along with the variables those steps are to be
+// executed with. The variables consist of the Promotion's own variables,
+// followed by the PromotionTemplate's parameters. Parameters for which the
+// Promotion does not specify a value assume their default value.
+func (r *reconciler) expandPromotionTemplate(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) ([]kargoapi.PromotionStep, []kargoapi.PromotionVariable, error) {
+	ref := promo.Spec.PromotionTemplate
+	template, err := kargoapi.GetPromotionTemplate(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      ref.Name,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if template == nil {
+		return nil, nil, fmt.Errorf(
+			"PromotionTemplate %q not found in namespace %q",
+			ref.Name,
+			promo.Namespace,
+		)
+	}
+
+	values := make(map[string]string, len(ref.Parameters))
+	for _, param := range ref.Parameters {
+		values[param.Name] = param.Value
+	}
+	vars := make(
+		[]kargoapi.PromotionVariable,
+		0,
+		len(promo.Spec.Vars)+len(template.Spec.Parameters),
+	)
+	vars = append(vars, promo.Spec.Vars...)
+	for _, param := range template.Spec.Parameters {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Required {
+				return nil, nil, fmt.Errorf(
+					"no value specified for required parameter %q of PromotionTemplate %q",
+					param.Name,
+					template.Name,
+				)
+			}
+			value = param.Default
+		}
+		delete(values, param.Name)
+		vars = append(vars, kargoapi.PromotionVariable{
+			Name:  param.Name,
+			Value: value,
+		})
+	}
+	for _, param := range ref.Parameters {
+		if _, ok := values[param.Name]; ok {
+			return nil, nil, fmt.Errorf(
+				"PromotionTemplate %q has no parameter %q",
+				template.Name,
+				param.Name,
+			)
+		}
+	}
+
+	return template.Spec.Steps, vars, nil
+}
+
+// buildDirectivesStep converts the provided PromotionStep into a
+// directives.Step.
+func buildDirectivesStep(step kargoapi.PromotionStep) (directives.Step, error) {
+	if step.Parallel != nil {
+		group := directives.Step{
+			ContinueOnError: step.ContinueOnError,
+			MaxConcurrency:  step.Parallel.MaxConcurrency,
+			Steps:           make([]directives.Step, len(step.Parallel.Steps)),
+		}
+		for i, child := range step.Parallel.Steps {
+			var err error
+			if group.Steps[i], err = buildDirectivesStep(kargoapi.PromotionStep{
+				Uses:            child.Uses,
+				As:              child.As,
+				Config:          child.Config,
+				Timeout:         child.Timeout,
+				Retry:           child.Retry,
+				ContinueOnError: child.ContinueOnError,
+			}); err != nil {
+				return directives.Step{}, err
+			}
+		}
+		return group, nil
+	}
+
+	cfg, err := step.GetConfig()
+	if err != nil {
+		return directives.Step{}, err
+	}
+	dirStep := directives.Step{
+		Directive:       step.Uses,
+		Alias:           step.As,
+		Config:          cfg,
+		ContinueOnError: step.ContinueOnError,
+	}
+	if step.Timeout != nil {
+		dirStep.Timeout = step.Timeout.Duration
+	}
+	if step.Retry != nil {
+		dirStep.Retries = step.Retry.Limit
+		dirStep.RetryBackoff = defaultStepRetryBackoff
+		if step.Retry.Backoff != nil {
+			dirStep.RetryBackoff = step.Retry.Backoff.Duration
+		}
+	}
+	return dirStep, nil
+}
+
+// countStepStatuses returns the number of PromotionStepStatuses recorded for
+// the provided steps once they have been executed. A step group records the
+// status of each of its child steps instead of a status of its own.
+func countStepStatuses(steps []directives.Step) int {
+	var count int
+	for _, step := range steps {
+		if len(step.Steps) > 0 {
+			count += len(step.Steps)
+		} else {
+			count++
+		}
+	}
+	return count
+}
+
+// getPromotionWorkDir returns the path of the working directory in which the
+// steps of the provided Promotion are executed.
+func (r *reconciler) getPromotionWorkDir(promo *kargoapi.Promotion) string {
+	workDir := r.cfg.WorkDir
+	if workDir == "" {
+		workDir = os.TempDir()
+	}
+	return filepath.Join(workDir, "promotion-"+string(promo.UID))
+}
+
+// cleanupPromotion removes the working directory of the provided Promotion's
+// steps and, subsequently, the finalizer that ensures this happens.
+func (r *reconciler) cleanupPromotion(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	if err := os.RemoveAll(r.getPromotionWorkDir(promo)); err != nil {
+		return fmt.Errorf("error removing working directory of Promotion: %w", err)
+	}
+	if err := kargoapi.RemoveFinalizer(ctx, r.kargoClient, promo); err != nil {
+		return fmt.Errorf("error removing finalizer from Promotion: %w", err)
+	}
+	return nil
+}
+
+// buildPromotionStepStatus converts the provided directives.StepResult into a
+// PromotionStepStatus.
+func buildPromotionStepStatus(
+	stepRes directives.StepResult,
+) (kargoapi.PromotionStepStatus, error) {
+	status := kargoapi.PromotionStepStatus{
+		Uses:     stepRes.Directive,
+		As:       stepRes.Alias,
+		Attempts: stepRes.Attempts,
+	}
+	switch stepRes.Result.Status {
+	case directives.StatusSuccess:
+		status.Result = kargoapi.PromotionStepResultSuccess
+	case directives.StatusPending:
+		status.Result = kargoapi.PromotionStepResultPending
+	default:
+		status.Result = kargoapi.PromotionStepResultFailure
+	}
+	if stepRes.Err != nil {
+		status.Message = stepRes.Err.Error()
+	}
+	if !stepRes.NextAttempt.IsZero() {
+		status.NextAttempt = &metav1.Time{Time: stepRes.NextAttempt}
+	}
+	if len(stepRes.Result.Output) > 0 {
+		output, err := json.Marshal(stepRes.Result.Output)
+		if err != nil {
+			return status, fmt.Errorf(
+				"error marshaling output of step %q: %w", stepRes.Directive, err,
+			)
+		}
+		status.Output = &apiextensionsv1.JSON{Raw: output}
+	}
+	return status, nil
+}
+
 // buildTargetFreightCollection constructs a FreightCollection that contains all
 // FreightReferences from the previous Promotion (excepting those that are no
 // longer requested), plus a FreightReference for the provided targetFreight.
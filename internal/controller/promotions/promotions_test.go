@@ -3,9 +3,15 @@ package promotions
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -16,6 +22,7 @@ import (
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/directives"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 )
 
@@ -26,6 +33,7 @@ func TestNewPromotionReconciler(t *testing.T) {
 		kubeClient,
 		&fakeevent.EventRecorder{},
 		&credentials.FakeDB{},
+		directives.BuiltinsRegistry(),
 		ReconcilerConfig{},
 	)
 	require.NotNil(t, r.kargoClient)
@@ -49,6 +57,7 @@ func newFakeReconciler(
 		kubeClient,
 		recorder,
 		&credentials.FakeDB{},
+		directives.BuiltinsRegistry(),
 		ReconcilerConfig{},
 	)
 }
@@ -228,6 +237,32 @@ func TestReconcile(t *testing.T) {
 				return nil, errors.New("expected error")
 			},
 		},
+		{
+			name:                  "dry run",
+			expectPromoteFnCalled: true,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo2"},
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: false,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo1",
+						},
+					},
+				},
+				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhaseRunning, before),
+				func() *kargoapi.Promotion {
+					promo := newPromo("fake-namespace", "fake-promo2", "fake-stage", "", now)
+					promo.Spec.DryRun = true
+					return promo
+				}(),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -271,6 +306,8 @@ func TestReconcile(t *testing.T) {
 					require.Len(t, recorder.Events, 1)
 					event := <-recorder.Events
 					require.Equal(t, tc.expectedEventReason, event.Reason)
+				} else {
+					require.Empty(t, recorder.Events)
 				}
 			}
 		})
@@ -297,3 +334,753 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestReconcileDeletedPromotion(t *testing.T) {
+	ctx := context.TODO()
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+	promo.UID = types.UID(uuid.NewString())
+	promo.Finalizers = []string{kargoapi.FinalizerName}
+	promo.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	r := newFakeReconciler(t, &fakeevent.EventRecorder{}, promo)
+	r.cfg.WorkDir = t.TempDir()
+	r.promoteFn = func(
+		context.Context,
+		v1alpha1.Promotion,
+		*v1alpha1.Stage,
+		*v1alpha1.Freight,
+	) (*kargoapi.PromotionStatus, error) {
+		require.Fail(t, "a Promotion that is being deleted must not be promoted")
+		return nil, nil
+	}
+
+	workDir := r.getPromotionWorkDir(promo)
+	require.NoError(t, os.MkdirAll(workDir, 0o700))
+
+	_, err := r.Reconcile(ctx, ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Name,
+		},
+	})
+	require.NoError(t, err)
+	require.NoDirExists(t, workDir)
+
+	// With its finalizer removed, the Promotion should be gone
+	err = r.kargoClient.Get(ctx, client.ObjectKeyFromObject(promo), &kargoapi.Promotion{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+type fakeDirective struct {
+	name   string
+	result directives.Result
+	err    error
+}
+
+func (d *fakeDirective) Name() string {
+	return d.name
+}
+
+func (d *fakeDirective) Run(context.Context, *directives.StepContext) (directives.Result, error) {
+	return d.result, d.err
+}
+
+func TestExecuteSteps(t *testing.T) {
+	workDir := t.TempDir()
+	registry := directives.DirectiveRegistry{}
+	registry.RegisterDirective(
+		&fakeDirective{
+			name: "fake-success",
+			result: directives.Result{
+				Status: directives.StatusSuccess,
+				Output: directives.State{"fake-key": "fake-value"},
+			},
+		},
+		nil,
+	)
+	registry.RegisterDirective(
+		&fakeDirective{
+			name:   "fake-pending",
+			result: directives.Result{Status: directives.StatusPending},
+		},
+		nil,
+	)
+	registry.RegisterDirective(
+		&fakeDirective{
+			name: "fake-plan",
+			result: directives.Result{
+				Status: directives.StatusSuccess,
+				Plan: &kargoapi.PromotionPlan{
+					GitRepoChanges: []kargoapi.GitRepoChange{{
+						RepoURL: "https://github.com/example/repo",
+						Diff:    "fake-diff",
+					}},
+				},
+			},
+		},
+		nil,
+	)
+	registry.RegisterDirective(
+		&fakeDirective{
+			name:   "fake-failure",
+			result: directives.Result{Status: directives.StatusFailure},
+			err:    errors.New("something went wrong"),
+		},
+		nil,
+	)
+
+	testCases := []struct {
+		name       string
+		steps      []kargoapi.PromotionStep
+		status     kargoapi.PromotionStatus
+		assertions func(*testing.T, *kargoapi.Promotion, error)
+	}{
+		{
+			name: "invalid step config",
+			steps: []kargoapi.PromotionStep{{
+				Uses:   "fake-success",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`invalid`)},
+			}},
+			assertions: func(t *testing.T, _ *kargoapi.Promotion, err error) {
+				require.ErrorContains(t, err, "error unmarshaling config of step")
+			},
+		},
+		{
+			name: "all steps succeed",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success", As: "step1"},
+				{Uses: "fake-success"},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.PromotionStepStatus{
+						{
+							Uses:   "fake-success",
+							As:     "step1",
+							Result: kargoapi.PromotionStepResultSuccess,
+							Output: &apiextensionsv1.JSON{
+								Raw: []byte(`{"fake-key":"fake-value"}`),
+							},
+							Attempts: 1,
+						},
+						{
+							Uses:   "fake-success",
+							Result: kargoapi.PromotionStepResultSuccess,
+							Output: &apiextensionsv1.JSON{
+								Raw: []byte(`{"fake-key":"fake-value"}`),
+							},
+							Attempts: 1,
+						},
+					},
+					promo.Status.Steps,
+				)
+			},
+		},
+		{
+			name: "step is pending",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success", As: "step1"},
+				{Uses: "fake-pending"},
+				{Uses: "fake-success"},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+				require.Len(t, promo.Status.Steps, 2)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepResultPending,
+					promo.Status.Steps[1].Result,
+				)
+				require.Equal(t, int64(1), promo.Status.CurrentStep)
+				require.NotNil(t, promo.Status.State)
+				require.JSONEq(
+					t,
+					`{"step1":{"fake-key":"fake-value"}}`,
+					string(promo.Status.State.Raw),
+				)
+				require.DirExists(t, filepath.Join(workDir, "promotion-"+string(promo.UID)))
+			},
+		},
+		{
+			name: "resumes from pending step",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-failure", As: "step1"},
+				{Uses: "fake-success", As: "step2"},
+			},
+			status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+				Steps: []kargoapi.PromotionStepStatus{
+					{
+						Uses:   "fake-failure",
+						As:     "step1",
+						Result: kargoapi.PromotionStepResultSuccess,
+					},
+					{
+						Uses:   "fake-success",
+						As:     "step2",
+						Result: kargoapi.PromotionStepResultPending,
+					},
+				},
+				CurrentStep: 1,
+				State: &apiextensionsv1.JSON{
+					Raw: []byte(`{"step1":{"fake-key":"fake-value"}}`),
+				},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Len(t, promo.Status.Steps, 2)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepResultSuccess,
+					promo.Status.Steps[0].Result,
+				)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepResultSuccess,
+					promo.Status.Steps[1].Result,
+				)
+				require.Equal(t, int64(1), promo.Status.CurrentStep)
+				require.JSONEq(
+					t,
+					`{"step1":{"fake-key":"fake-value"},"step2":{"fake-key":"fake-value"}}`,
+					string(promo.Status.State.Raw),
+				)
+			},
+		},
+		{
+			name: "invalid persisted state",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success"},
+			},
+			status: kargoapi.PromotionStatus{
+				State: &apiextensionsv1.JSON{Raw: []byte(`invalid`)},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.Promotion, err error) {
+				require.ErrorContains(t, err, "error unmarshaling state of Promotion steps")
+			},
+		},
+		{
+			name: "step fails",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success"},
+				{Uses: "fake-failure"},
+				{Uses: "fake-success"},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, promo.Status.Phase)
+				require.Contains(t, promo.Status.Message, "something went wrong")
+				require.Len(t, promo.Status.Steps, 2)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepResultFailure,
+					promo.Status.Steps[1].Result,
+				)
+				require.Equal(t, "something went wrong", promo.Status.Steps[1].Message)
+			},
+		},
+		{
+			name: "failed step is to be retried",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success"},
+				{
+					Uses: "fake-failure",
+					Retry: &kargoapi.PromotionStepRetry{
+						Limit:   1,
+						Backoff: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+				{Uses: "fake-success"},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+				require.Equal(t, int64(1), promo.Status.CurrentStep)
+				require.Len(t, promo.Status.Steps, 2)
+				stepStatus := promo.Status.Steps[1]
+				require.Equal(t, kargoapi.PromotionStepResultPending, stepStatus.Result)
+				require.Equal(t, "something went wrong", stepStatus.Message)
+				require.Equal(t, int64(1), stepStatus.Attempts)
+				require.NotNil(t, stepStatus.NextAttempt)
+				require.WithinDuration(
+					t,
+					time.Now().Add(time.Minute),
+					stepStatus.NextAttempt.Time,
+					5*time.Second,
+				)
+			},
+		},
+		{
+			name: "parallel steps",
+			steps: []kargoapi.PromotionStep{
+				{
+					Parallel: &kargoapi.PromotionStepGroup{
+						MaxConcurrency: 1,
+						Steps: []kargoapi.PromotionParallelStep{
+							{Uses: "fake-success", As: "step1"},
+							{Uses: "fake-success", As: "step2"},
+						},
+					},
+				},
+				{Uses: "fake-success"},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Len(t, promo.Status.Steps, 3)
+				require.Equal(t, "step1", promo.Status.Steps[0].As)
+				require.Equal(t, "step2", promo.Status.Steps[1].As)
+				require.JSONEq(
+					t,
+					`{"step1":{"fake-key":"fake-value"},"step2":{"fake-key":"fake-value"}}`,
+					string(promo.Status.State.Raw),
+				)
+			},
+		},
+		{
+			name: "resumes after parallel steps",
+			steps: []kargoapi.PromotionStep{
+				{
+					Parallel: &kargoapi.PromotionStepGroup{
+						Steps: []kargoapi.PromotionParallelStep{
+							{Uses: "fake-success", As: "step1"},
+							{Uses: "fake-success", As: "step2"},
+						},
+					},
+				},
+				{Uses: "fake-success", As: "step3"},
+			},
+			status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+				Steps: []kargoapi.PromotionStepStatus{
+					{
+						Uses:   "fake-success",
+						As:     "step1",
+						Result: kargoapi.PromotionStepResultSuccess,
+					},
+					{
+						Uses:   "fake-success",
+						As:     "step2",
+						Result: kargoapi.PromotionStepResultSuccess,
+					},
+					{
+						Uses:   "fake-success",
+						As:     "step3",
+						Result: kargoapi.PromotionStepResultPending,
+					},
+				},
+				CurrentStep: 1,
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Len(t, promo.Status.Steps, 3)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepResultSuccess,
+					promo.Status.Steps[2].Result,
+				)
+			},
+		},
+		{
+			name: "plan is accumulated",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success"},
+				{Uses: "fake-plan"},
+			},
+			status: kargoapi.PromotionStatus{
+				CurrentStep: 1,
+				Steps: []kargoapi.PromotionStepStatus{{
+					Uses:   "fake-success",
+					Result: kargoapi.PromotionStepResultSuccess,
+				}},
+				Plan: &kargoapi.PromotionPlan{
+					ArgoCDAppChanges: []kargoapi.ArgoCDAppChange{{
+						AppName: "fake-app",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+				require.Equal(
+					t,
+					&kargoapi.PromotionPlan{
+						GitRepoChanges: []kargoapi.GitRepoChange{{
+							RepoURL: "https://github.com/example/repo",
+							Diff:    "fake-diff",
+						}},
+						ArgoCDAppChanges: []kargoapi.ArgoCDAppChange{{
+							AppName: "fake-app",
+						}},
+					},
+					promo.Status.Plan,
+				)
+			},
+		},
+		{
+			name: "unknown directive",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-unknown"},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, promo.Status.Phase)
+				require.Contains(t, promo.Status.Message, "not found")
+				require.Len(t, promo.Status.Steps, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				directivesEngine: directives.NewEngine(registry, nil, nil, nil),
+				cfg:              ReconcilerConfig{WorkDir: workDir},
+			}
+			promo := &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+					UID:       types.UID(uuid.NewString()),
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage: "fake-stage",
+					Steps: testCase.steps,
+				},
+				Status: testCase.status,
+			}
+			err := r.executeSteps(context.Background(), &kargoapi.Stage{}, promo)
+			testCase.assertions(t, promo, err)
+		})
+	}
+}
+
+func TestExecuteStepsRetry(t *testing.T) {
+	registry := directives.DirectiveRegistry{}
+	registry.RegisterDirective(
+		&fakeDirective{
+			name:   "fake-success",
+			result: directives.Result{Status: directives.StatusSuccess},
+		},
+		nil,
+	)
+	registry.RegisterDirective(
+		&fakeDirective{
+			name:   "fake-failure",
+			result: directives.Result{Status: directives.StatusFailure},
+			err:    errors.New("something went wrong"),
+		},
+		nil,
+	)
+	r := &reconciler{
+		directivesEngine: directives.NewEngine(registry, nil, nil, nil),
+		cfg:              ReconcilerConfig{WorkDir: t.TempDir()},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+			UID:       types.UID(uuid.NewString()),
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage: "fake-stage",
+			Steps: []kargoapi.PromotionStep{
+				{
+					Uses: "fake-failure",
+					Retry: &kargoapi.PromotionStepRetry{
+						Limit:   1,
+						Backoff: &metav1.Duration{Duration: time.Millisecond},
+					},
+					ContinueOnError: true,
+				},
+				{Uses: "fake-success"},
+			},
+		},
+	}
+
+	// The first attempt fails, and the step is reported as pending until the
+	// retry is due.
+	require.NoError(t, r.executeSteps(context.Background(), &kargoapi.Stage{}, promo))
+	require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+	require.Len(t, promo.Status.Steps, 1)
+	require.NotNil(t, promo.Status.Steps[0].NextAttempt)
+	require.LessOrEqual(t, getRequeueInterval(&promo.Status), time.Second)
+
+	// The retry fails as well, after which execution continues with the next
+	// step.
+	time.Sleep(time.Until(promo.Status.Steps[0].NextAttempt.Time))
+	require.NoError(t, r.executeSteps(context.Background(), &kargoapi.Stage{}, promo))
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.Phase)
+	require.Len(t, promo.Status.Steps, 2)
+	require.Equal(
+		t,
+		kargoapi.PromotionStepStatus{
+			Uses:     "fake-failure",
+			Result:   kargoapi.PromotionStepResultFailure,
+			Message:  "something went wrong",
+			Attempts: 2,
+		},
+		promo.Status.Steps[0],
+	)
+	require.Equal(
+		t,
+		kargoapi.PromotionStepResultSuccess,
+		promo.Status.Steps[1].Result,
+	)
+	require.Nil(t, promo.Status.State)
+}
+
+func TestGetRequeueInterval(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     kargoapi.PromotionStatus
+		assertions func(*testing.T, time.Duration)
+	}{
+		{
+			name: "no step to be retried",
+			status: kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepStatus{{Uses: "fake-step"}},
+			},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Equal(t, 5*time.Minute, interval)
+			},
+		},
+		{
+			name: "step to be retried",
+			status: kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepStatus{{
+					Uses:        "fake-step",
+					NextAttempt: &metav1.Time{Time: time.Now().Add(time.Minute)},
+				}},
+			},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Greater(t, interval, 55*time.Second)
+				require.LessOrEqual(t, interval, time.Minute)
+			},
+		},
+		{
+			name: "retry is overdue",
+			status: kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepStatus{{
+					Uses:        "fake-step",
+					NextAttempt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+				}},
+			},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Equal(t, time.Second, interval)
+			},
+		},
+		{
+			name: "retry is not due before default interval",
+			status: kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepStatus{{
+					Uses:        "fake-step",
+					NextAttempt: &metav1.Time{Time: time.Now().Add(time.Hour)},
+				}},
+			},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Equal(t, 5*time.Minute, interval)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, getRequeueInterval(&testCase.status))
+		})
+	}
+}
+
+func TestExpandPromotionTemplate(t *testing.T) {
+	template := &kargoapi.PromotionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-template",
+		},
+		Spec: kargoapi.PromotionTemplateSpec{
+			Parameters: []kargoapi.PromotionTemplateParameter{
+				{Name: "path", Required: true},
+				{Name: "branch", Default: "main"},
+			},
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "git-clone"},
+				{Uses: "git-push"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		ref        *kargoapi.PromotionTemplateReference
+		assertions func(*testing.T, []kargoapi.PromotionStep, []kargoapi.PromotionVariable, error)
+	}{
+		{
+			name: "template not found",
+			ref:  &kargoapi.PromotionTemplateReference{Name: "fake-unknown"},
+			assertions: func(
+				t *testing.T,
+				_ []kargoapi.PromotionStep,
+				_ []kargoapi.PromotionVariable,
+				err error,
+			) {
+				require.ErrorContains(t, err, `PromotionTemplate "fake-unknown" not found`)
+			},
+		},
+		{
+			name: "missing required parameter",
+			ref:  &kargoapi.PromotionTemplateReference{Name: "fake-template"},
+			assertions: func(
+				t *testing.T,
+				_ []kargoapi.PromotionStep,
+				_ []kargoapi.PromotionVariable,
+				err error,
+			) {
+				require.ErrorContains(
+					t,
+					err,
+					`no value specified for required parameter "path"`,
+				)
+			},
+		},
+		{
+			name: "unknown parameter",
+			ref: &kargoapi.PromotionTemplateReference{
+				Name: "fake-template",
+				Parameters: []kargoapi.PromotionVariable{
+					{Name: "path", Value: "env/test"},
+					{Name: "unknown", Value: "fake-value"},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ []kargoapi.PromotionStep,
+				_ []kargoapi.PromotionVariable,
+				err error,
+			) {
+				require.ErrorContains(
+					t,
+					err,
+					`PromotionTemplate "fake-template" has no parameter "unknown"`,
+				)
+			},
+		},
+		{
+			name: "success",
+			ref: &kargoapi.PromotionTemplateReference{
+				Name: "fake-template",
+				Parameters: []kargoapi.PromotionVariable{
+					{Name: "path", Value: "env/${{ .vars.env }}"},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				steps []kargoapi.PromotionStep,
+				vars []kargoapi.PromotionVariable,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, template.Spec.Steps, steps)
+				require.Equal(
+					t,
+					[]kargoapi.PromotionVariable{
+						{Name: "env", Value: "test"},
+						{Name: "path", Value: "env/${{ .vars.env }}"},
+						{Name: "branch", Value: "main"},
+					},
+					vars,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, &fakeevent.EventRecorder{}, template)
+			promo := &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage: "fake-stage",
+					Vars: []kargoapi.PromotionVariable{
+						{Name: "env", Value: "test"},
+					},
+					PromotionTemplate: testCase.ref,
+				},
+			}
+			steps, vars, err := r.expandPromotionTemplate(context.Background(), promo)
+			testCase.assertions(t, steps, vars, err)
+		})
+	}
+}
+
+func TestExecuteStepsFromExpandedTemplate(t *testing.T) {
+	registry := directives.DirectiveRegistry{}
+	registry.RegisterDirective(
+		&fakeDirective{
+			name:   "fake-pending",
+			result: directives.Result{Status: directives.StatusPending},
+		},
+		nil,
+	)
+	registry.RegisterDirective(
+		&fakeDirective{
+			name:   "fake-success",
+			result: directives.Result{Status: directives.StatusSuccess},
+		},
+		nil,
+	)
+
+	template := &kargoapi.PromotionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-template",
+		},
+		Spec: kargoapi.PromotionTemplateSpec{
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "fake-success"},
+				{Uses: "fake-pending"},
+			},
+		},
+	}
+	r := newFakeReconciler(t, &fakeevent.EventRecorder{}, template)
+	r.cfg.WorkDir = t.TempDir()
+	r.directivesEngine = directives.NewEngine(registry, nil, r.kargoClient, nil)
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+			UID:       types.UID(uuid.NewString()),
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage: "fake-stage",
+			PromotionTemplate: &kargoapi.PromotionTemplateReference{
+				Name: "fake-template",
+			},
+		},
+	}
+
+	// The first execution expands the PromotionTemplate and waits on its
+	// second step
+	err := r.executeSteps(context.Background(), &kargoapi.Stage{}, promo)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+	require.Equal(t, int64(1), promo.Status.CurrentStep)
+	require.NotNil(t, promo.Status.ExpandedTemplate)
+	require.Equal(t, template.Spec.Steps, promo.Status.ExpandedTemplate.Steps)
+
+	// Changes made to the PromotionTemplate in the meantime must not affect
+	// the resumed execution
+	template.Spec.Steps = []kargoapi.PromotionStep{{Uses: "fake-success"}}
+	require.NoError(t, r.kargoClient.Update(context.Background(), template))
+
+	err = r.executeSteps(context.Background(), &kargoapi.Stage{}, promo)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+	require.Equal(t, int64(1), promo.Status.CurrentStep)
+	require.Len(t, promo.Status.ExpandedTemplate.Steps, 2)
+}
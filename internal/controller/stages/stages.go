@@ -171,6 +171,18 @@ type reconciler struct {
 		newStatus kargoapi.FreightStatus,
 	) error
 
+	rollbackFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freightHistory kargoapi.FreightHistory,
+	) error
+
+	listPromotionsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
 	// Auto-promotion:
 
 	isAutoPromotionPermittedFn func(
@@ -466,6 +478,8 @@ func newReconciler(
 	r.getFreightFn = kargoapi.GetFreight
 	r.verifyFreightInStageFn = r.verifyFreightInStage
 	r.patchFreightStatusFn = r.patchFreightStatus
+	r.rollbackFn = r.rollback
+	r.listPromotionsFn = r.kargoClient.List
 	// Auto-promotion:
 	r.isAutoPromotionPermittedFn = r.isAutoPromotionPermitted
 	r.getProjectFn = kargoapi.GetProject
@@ -523,7 +537,7 @@ func (r *reconciler) Reconcile(
 		if _, err = kargoapi.EnsureFinalizer(ctx, r.kargoClient, stage); err != nil {
 			newStatus = stage.Status
 		} else {
-			if stage.Spec.PromotionMechanisms == nil {
+			if stage.IsControlFlow() {
 				newStatus, err = r.syncControlFlowStage(ctx, stage)
 			} else {
 				newStatus, err = r.syncNormalStage(ctx, stage)
@@ -852,6 +866,38 @@ func (r *reconciler) syncNormalStage(
 				}
 			}
 		}
+
+		// If verification just failed and the Stage has opted into being rolled
+		// back, record that the Stage is to be rolled back. As the Stage's status
+		// is updated even if the rollback fails, this ensures the rollback is
+		// retried by subsequent reconciliations until it succeeds.
+		rollbackEnabled := stage.Spec.RollbackPolicy != nil && stage.Spec.RollbackPolicy.Enabled
+		if verificationJustCompleted &&
+			currentVI.Phase == kargoapi.VerificationPhaseFailed && rollbackEnabled {
+			status.PendingRollback = currentFC.ID
+		}
+		// A pending rollback is abandoned if the Stage has moved on from the
+		// Freight that failed verification in the meantime, or if the Stage has
+		// opted out of being rolled back.
+		if status.PendingRollback != currentFC.ID || !rollbackEnabled {
+			status.PendingRollback = ""
+		}
+
+		// Roll back to the last Freight that was verified successfully and
+		// refrain from looking for new Freight to promote.
+		if status.PendingRollback != "" {
+			logger.Debug("verification failed; rolling back Stage")
+			if err := r.rollbackFn(ctx, stage, status.FreightHistory); err != nil {
+				return status, fmt.Errorf(
+					"error rolling back Stage %q in namespace %q: %w",
+					stage.Name,
+					stage.Namespace,
+					err,
+				)
+			}
+			status.PendingRollback = ""
+			return status, nil
+		}
 	}
 
 	// Stop here if we have no chance of finding any Freight to promote.
@@ -910,6 +956,18 @@ func (r *reconciler) syncNormalStage(
 		// Prepare the logger for this origin and Freight.
 		freightLogger := logger.WithValues("origin", origin, "freight", latestFreight.Name)
 
+		// Freight that was rolled back from this Stage is not eligible for
+		// auto-promotion unless it has since been explicitly re-approved.
+		if _, rolledBack := latestFreight.Status.RolledBackFrom[stage.Name]; rolledBack {
+			if _, approved := latestFreight.Status.ApprovedFor[stage.Name]; !approved {
+				freightLogger.Debug(
+					"latest available Freight for origin was rolled back from Stage " +
+						"and has not been re-approved",
+				)
+				continue
+			}
+		}
+
 		// Only proceed if latest Freight isn't the one we already have
 		if currentFreight != nil && len(currentFreight.Freight) > 0 {
 			if freightRef, ok := currentFreight.Freight[origin]; ok &&
@@ -1305,6 +1363,171 @@ func (r *reconciler) patchFreightStatus(
 	return nil
 }
 
+// rollback rolls the provided Stage back to the most recent FreightCollection
+// in the provided Freight history that was successfully verified. For each
+// origin for which the current Freight differs from that of the
+// FreightCollection, the current Freight is marked as having been rolled back
+// from the Stage and a Promotion of the previously verified Freight is created,
+// unless a previous attempt at rolling back already created that Promotion.
+func (r *reconciler) rollback(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freightHistory kargoapi.FreightHistory,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	current := freightHistory.Current()
+	if current == nil {
+		return nil
+	}
+	var target *kargoapi.FreightCollection
+	for _, fc := range freightHistory[1:] {
+		if slices.ContainsFunc(fc.VerificationHistory, func(vi kargoapi.VerificationInfo) bool {
+			return vi.Phase == kargoapi.VerificationPhaseSuccessful
+		}) {
+			target = fc
+			break
+		}
+	}
+	if target == nil {
+		logger.Info("no previously verified Freight found to roll back to")
+		return nil
+	}
+
+	// Find the Freight for which a rollback Promotion has already been created
+	// since the current Freight failed verification.
+	promos := &kargoapi.PromotionList{}
+	if err := r.listPromotionsFn(
+		ctx,
+		promos,
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabels{kargoapi.RollbackOfLabelKey: current.ID},
+	); err != nil {
+		return fmt.Errorf(
+			"error listing rollback Promotions in namespace %q: %w",
+			stage.Namespace,
+			err,
+		)
+	}
+	var failedAt time.Time
+	if vi := current.VerificationHistory.Current(); vi != nil && vi.FinishTime != nil {
+		failedAt = vi.FinishTime.Truncate(time.Second)
+	}
+	rolledBackTo := map[string]struct{}{}
+	for _, promo := range promos.Items {
+		if promo.Spec.Stage == stage.Name && !promo.CreationTimestamp.Time.Before(failedAt) {
+			rolledBackTo[promo.Spec.Freight] = struct{}{}
+		}
+	}
+
+	for _, ref := range target.References() {
+		origin := ref.Origin.String()
+		failedRef, ok := current.Freight[origin]
+		if ok && failedRef.Name == ref.Name {
+			continue
+		}
+
+		if ok {
+			failed, err := r.getFreightFn(
+				ctx,
+				r.kargoClient,
+				types.NamespacedName{
+					Namespace: stage.Namespace,
+					Name:      failedRef.Name,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("get freight: %w", err)
+			}
+			if failed != nil {
+				// Explicit approval is required before the failed Freight is
+				// eligible for auto-promotion to this Stage again, so any existing
+				// approval is revoked.
+				newStatus := *failed.Status.DeepCopy()
+				if newStatus.RolledBackFrom == nil {
+					newStatus.RolledBackFrom = map[string]kargoapi.RolledBackStage{}
+				}
+				newStatus.RolledBackFrom[stage.Name] = kargoapi.RolledBackStage{}
+				delete(newStatus.ApprovedFor, stage.Name)
+				if err = r.patchFreightStatusFn(ctx, failed, newStatus); err != nil {
+					return err
+				}
+			}
+		}
+
+		freight, err := r.getFreightFn(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: stage.Namespace,
+				Name:      ref.Name,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("get freight: %w", err)
+		}
+
+		if _, ok := rolledBackTo[ref.Name]; ok {
+			logger.Debug(
+				"rollback Promotion already exists",
+				"freight", ref.Name,
+				"origin", origin,
+			)
+			continue
+		}
+
+		promo := kargo.NewPromotion(ctx, *stage, ref.Name)
+		if promo.Labels == nil {
+			promo.Labels = map[string]string{}
+		}
+		promo.Labels[kargoapi.RollbackOfLabelKey] = current.ID
+		if err = r.createPromotionFn(ctx, &promo); err != nil {
+			return fmt.Errorf(
+				"error creating Promotion of Stage %q in namespace %q to Freight %q: %w",
+				stage.Name,
+				stage.Namespace,
+				ref.Name,
+				err,
+			)
+		}
+
+		msg := fmt.Sprintf(
+			"Rolling back Stage %q to Freight %q after failed verification",
+			stage.Name,
+			ref.Name,
+		)
+		if ok {
+			msg = fmt.Sprintf(
+				"Rolling back Stage %q from Freight %q to Freight %q after failed verification",
+				stage.Name,
+				failedRef.Name,
+				ref.Name,
+			)
+		}
+		r.recorder.AnnotatedEventf(
+			&promo,
+			kargoapi.NewPromotionEventAnnotations(
+				ctx,
+				kargoapi.FormatEventControllerActor(r.cfg.Name()),
+				&promo,
+				freight,
+			),
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonRollbackTriggered,
+			"%s",
+			msg,
+		)
+
+		logger.Debug(
+			"created rollback Promotion resource",
+			"promotion", promo.Name,
+			"origin", origin,
+		)
+	}
+
+	return nil
+}
+
 func (r *reconciler) isAutoPromotionPermitted(
 	ctx context.Context,
 	namespace string,
@@ -1318,20 +1541,28 @@ func (r *reconciler) isAutoPromotionPermitted(
 	if project == nil {
 		return false, fmt.Errorf("Project %q not found", namespace)
 	}
-	if project.Spec == nil || len(project.Spec.PromotionPolicies) == 0 {
+	policy := project.GetPromotionPolicy(stageName)
+	if policy == nil {
 		logger.Debug("found no PromotionPolicy associated with the Stage")
 		return false, nil
 	}
-	for _, policy := range project.Spec.PromotionPolicies {
-		if policy.Stage == stageName {
-			logger.Debug(
-				"found PromotionPolicy associated with the Stage",
-				"autoPromotionEnabled", policy.AutoPromotionEnabled,
-			)
-			return policy.AutoPromotionEnabled, nil
-		}
+	logger.Debug(
+		"found PromotionPolicy associated with the Stage",
+		"autoPromotionEnabled", policy.AutoPromotionEnabled,
+	)
+	if !policy.AutoPromotionEnabled {
+		return false, nil
+	}
+	permitted, reason, err := policy.IsPromotionPermitted(r.nowFn())
+	if err != nil {
+		return false, fmt.Errorf(
+			"error evaluating PromotionPolicy for Stage %q: %w", stageName, err,
+		)
+	}
+	if !permitted {
+		logger.Debug("auto-promotion is restricted", "reason", reason)
 	}
-	return false, nil
+	return permitted, nil
 }
 
 func (r *reconciler) getPromotionsForStage(
@@ -1358,7 +1589,10 @@ func (r *reconciler) getPromotionsForStage(
 			err,
 		)
 	}
-	return promos.Items, nil
+	// Dry-run Promotions never affect the state of the Stage.
+	return slices.DeleteFunc(promos.Items, func(promo kargoapi.Promotion) bool {
+		return promo.Spec.DryRun
+	}), nil
 }
 
 func (r *reconciler) getAvailableFreight(
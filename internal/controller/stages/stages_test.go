@@ -67,6 +67,7 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, r.getFreightFn)
 	require.NotNil(t, r.verifyFreightInStageFn)
 	require.NotNil(t, r.patchFreightStatusFn)
+	require.NotNil(t, r.rollbackFn)
 	// Auto-promotion:
 	require.NotNil(t, r.isAutoPromotionPermittedFn)
 	require.NotNil(t, r.getProjectFn)
@@ -1427,6 +1428,450 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "verification failed with rollback enabled",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification:        &kargoapi.Verification{},
+					RollbackPolicy:      &kargoapi.RollbackPolicy{Enabled: true},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "fake-collection-id",
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									ID:    "fake-id",
+									Phase: kargoapi.VerificationPhasePending,
+									AnalysisRun: &kargoapi.AnalysisRunReference{
+										Name: "fake-analysis-run",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				getAnalysisRunFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*rollouts.AnalysisRun, error) {
+					return &rollouts.AnalysisRun{}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getVerificationInfoFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					return &kargoapi.VerificationInfo{
+						ID:    "fake-id",
+						Phase: kargoapi.VerificationPhaseFailed,
+						AnalysisRun: &kargoapi.AnalysisRunReference{
+							Name: "fake-analysis-run",
+						},
+					}, nil
+				},
+				rollbackFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					history kargoapi.FreightHistory,
+				) error {
+					if history.Current().VerificationHistory.Current().Phase !=
+						kargoapi.VerificationPhaseFailed {
+						return errors.New("rolled back before verification failed")
+					}
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, errors.New("auto-promotion should not be attempted")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+				require.Empty(t, newStatus.PendingRollback)
+
+				// The failed verification should have been recorded as an event
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonFreightVerificationFailed, event.Reason)
+			},
+		},
+
+		{
+			name: "error rolling back",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification:        &kargoapi.Verification{},
+					RollbackPolicy:      &kargoapi.RollbackPolicy{Enabled: true},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "fake-collection-id",
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									ID:    "fake-id",
+									Phase: kargoapi.VerificationPhasePending,
+									AnalysisRun: &kargoapi.AnalysisRunReference{
+										Name: "fake-analysis-run",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				getAnalysisRunFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*rollouts.AnalysisRun, error) {
+					return &rollouts.AnalysisRun{}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getVerificationInfoFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					return &kargoapi.VerificationInfo{
+						ID:    "fake-id",
+						Phase: kargoapi.VerificationPhaseFailed,
+						AnalysisRun: &kargoapi.AnalysisRunReference{
+							Name: "fake-analysis-run",
+						},
+					}, nil
+				},
+				rollbackFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightHistory,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error rolling back Stage")
+				require.ErrorContains(t, err, "something went wrong")
+				// The rollback should be retried
+				require.Equal(t, "fake-collection-id", newStatus.PendingRollback)
+			},
+		},
+
+		{
+			name: "pending rollback is retried",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification:        &kargoapi.Verification{},
+					RollbackPolicy:      &kargoapi.RollbackPolicy{Enabled: true},
+				},
+				Status: kargoapi.StageStatus{
+					Phase:           kargoapi.StagePhaseSteady,
+					PendingRollback: "fake-collection-id",
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "fake-collection-id",
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									ID:    "fake-id",
+									Phase: kargoapi.VerificationPhaseFailed,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				rollbackFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightHistory,
+				) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, errors.New("auto-promotion should not be attempted")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, newStatus.PendingRollback)
+			},
+		},
+
+		{
+			name: "stale pending rollback is abandoned",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					RollbackPolicy:      &kargoapi.RollbackPolicy{Enabled: true},
+				},
+				Status: kargoapi.StageStatus{
+					Phase:           kargoapi.StagePhaseSteady,
+					PendingRollback: "fake-old-collection-id",
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "fake-collection-id",
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				nowFn:     time.Now,
+				verifyFreightInStageFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				rollbackFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightHistory,
+				) error {
+					return errors.New("rollback should not be attempted")
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, newStatus.PendingRollback)
+			},
+		},
+
+		{
+			name: "latest Freight was rolled back from Stage",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: testOrigin,
+						},
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-failed-freight-id",
+								},
+								Status: kargoapi.FreightStatus{
+									RolledBackFrom: map[string]kargoapi.RolledBackStage{
+										"fake-stage": {},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("Promotions should not be listed")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
 		{
 			name: "Promotion already exists",
 			stage: &kargoapi.Stage{
@@ -2680,88 +3125,268 @@ func TestClearApprovals(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.reconciler.clearApprovals(
+					context.Background(),
+					&kargoapi.Stage{},
+				),
+			)
+		})
+	}
+}
+
+func TestVerifyFreightInStage(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "error getting Freight",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, updated bool, err error) {
+				require.False(t, updated)
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error finding Freight")
+			},
+		},
+		{
+			name: "Freight not found",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, updated bool, err error) {
+				require.False(t, updated)
+				require.ErrorContains(t, err, "found no Freight")
+			},
+		},
+		{
+			name: "Freight already verified in Stage",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, updated bool, err error) {
+				require.NoError(t, err)
+				require.False(t, updated)
+			},
+		},
+		{
+			name: "error Patching Freight status",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, updated bool, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.False(t, updated)
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, updated bool, err error) {
 				require.NoError(t, err)
+				require.True(t, updated)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.reconciler.clearApprovals(
-					context.Background(),
-					&kargoapi.Stage{},
-				),
+			updated, err := testCase.reconciler.verifyFreightInStage(
+				context.Background(),
+				"fake-namespace",
+				"fake-freight",
+				"fake-stage",
 			)
+			testCase.assertions(t, updated, err)
 		})
 	}
 }
 
-func TestVerifyFreightInStage(t *testing.T) {
+func TestRollback(t *testing.T) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testHistory := kargoapi.FreightHistory{
+		{
+			ID: "fake-failed-collection",
+			Freight: map[string]kargoapi.FreightReference{
+				testOrigin.String(): {
+					Name:   "fake-failed-freight",
+					Origin: testOrigin,
+				},
+			},
+			VerificationHistory: []kargoapi.VerificationInfo{{
+				Phase: kargoapi.VerificationPhaseFailed,
+			}},
+		},
+		{
+			Freight: map[string]kargoapi.FreightReference{
+				testOrigin.String(): {
+					Name:   "fake-unverified-freight",
+					Origin: testOrigin,
+				},
+			},
+			VerificationHistory: []kargoapi.VerificationInfo{{
+				Phase: kargoapi.VerificationPhaseError,
+			}},
+		},
+		{
+			Freight: map[string]kargoapi.FreightReference{
+				testOrigin.String(): {
+					Name:   "fake-verified-freight",
+					Origin: testOrigin,
+				},
+			},
+			VerificationHistory: []kargoapi.VerificationInfo{
+				{Phase: kargoapi.VerificationPhaseFailed},
+				{Phase: kargoapi.VerificationPhaseSuccessful},
+			},
+		},
+	}
 	testCases := []struct {
 		name       string
+		history    kargoapi.FreightHistory
 		reconciler *reconciler
-		assertions func(*testing.T, bool, error)
+		assertions func(*testing.T, *fakeevent.EventRecorder, error)
 	}{
 		{
-			name: "error getting Freight",
-			reconciler: &reconciler{
-				getFreightFn: func(
-					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return nil, errors.New("something went wrong")
-				},
-			},
-			assertions: func(t *testing.T, updated bool, err error) {
-				require.False(t, updated)
-				require.ErrorContains(t, err, "something went wrong")
-				require.ErrorContains(t, err, "error finding Freight")
+			name:       "no previously verified Freight",
+			history:    testHistory[:2],
+			reconciler: &reconciler{},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.Events)
 			},
 		},
 		{
-			name: "Freight not found",
+			name:    "error listing Promotions",
+			history: testHistory,
 			reconciler: &reconciler{
-				getFreightFn: func(
+				listPromotionsFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return nil, nil
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, updated bool, err error) {
-				require.False(t, updated)
-				require.ErrorContains(t, err, "found no Freight")
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, err error) {
+				require.ErrorContains(t, err, "error listing rollback Promotions")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, recorder.Events)
 			},
 		},
 		{
-			name: "Freight already verified in Stage",
+			name:    "error patching Freight status",
+			history: testHistory,
 			reconciler: &reconciler{
+				listPromotionsFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
 				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						Status: kargoapi.FreightStatus{
-							VerifiedIn: map[string]kargoapi.VerifiedStage{
-								"fake-stage": {},
-							},
-						},
-					}, nil
+					return &kargoapi.Freight{}, nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, updated bool, err error) {
-				require.NoError(t, err)
-				require.False(t, updated)
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, recorder.Events)
 			},
 		},
 		{
-			name: "error Patching Freight status",
+			name:    "error creating Promotion",
+			history: testHistory,
 			reconciler: &reconciler{
+				listPromotionsFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -2773,18 +3398,44 @@ func TestVerifyFreightInStage(t *testing.T) {
 					context.Context,
 					*kargoapi.Freight,
 					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
 				) error {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, updated bool, err error) {
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, err error) {
+				require.ErrorContains(t, err, "error creating Promotion")
 				require.ErrorContains(t, err, "something went wrong")
-				require.False(t, updated)
+				require.Empty(t, recorder.Events)
 			},
 		},
 		{
-			name: "success",
+			name:    "rollback Promotion already exists",
+			history: testHistory,
 			reconciler: &reconciler{
+				listPromotionsFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					if !ok {
+						return errors.New("unexpected list type")
+					}
+					promos.Items = []kargoapi.Promotion{{
+						Spec: kargoapi.PromotionSpec{
+							Stage:   "fake-stage",
+							Freight: "fake-verified-freight",
+						},
+					}}
+					return nil
+				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -2799,22 +3450,101 @@ func TestVerifyFreightInStage(t *testing.T) {
 				) error {
 					return nil
 				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Promotion should not be created")
+				},
 			},
-			assertions: func(t *testing.T, updated bool, err error) {
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, err error) {
 				require.NoError(t, err)
-				require.True(t, updated)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:    "success",
+			history: testHistory,
+			reconciler: &reconciler{
+				listPromotionsFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				getFreightFn: func(
+					_ context.Context,
+					_ client.Client,
+					key types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: key.Namespace,
+							Name:      key.Name,
+						},
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					freight *kargoapi.Freight,
+					newStatus kargoapi.FreightStatus,
+				) error {
+					if freight.Name != "fake-failed-freight" {
+						return fmt.Errorf("unexpected patch of Freight %q", freight.Name)
+					}
+					if _, ok := newStatus.RolledBackFrom["fake-stage"]; !ok {
+						return errors.New("Freight not marked as rolled back")
+					}
+					if _, ok := newStatus.ApprovedFor["fake-stage"]; ok {
+						return errors.New("approval not revoked")
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					if !ok || promo.Spec.Freight != "fake-verified-freight" {
+						return errors.New("unexpected Promotion")
+					}
+					if promo.Labels[kargoapi.RollbackOfLabelKey] != "fake-failed-collection" {
+						return errors.New("Promotion not labeled as a rollback")
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, err error) {
+				require.NoError(t, err)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonRollbackTriggered, event.Reason)
+				require.Equal(
+					t,
+					"fake-verified-freight",
+					event.Annotations[kargoapi.AnnotationKeyEventFreightName],
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			updated, err := testCase.reconciler.verifyFreightInStage(
+			recorder := fakeevent.NewEventRecorder(10)
+			testCase.reconciler.recorder = recorder
+			err := testCase.reconciler.rollback(
 				context.Background(),
-				"fake-namespace",
-				"fake-freight",
-				"fake-stage",
+				testStage,
+				testCase.history,
 			)
-			testCase.assertions(t, updated, err)
+			testCase.assertions(t, recorder, err)
 		})
 	}
 }
@@ -2913,9 +3643,86 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 				require.True(t, result)
 			},
 		},
+		{
+			name: "frozen",
+			reconciler: &reconciler{
+				getProjectFn: func(_ context.Context, _ client.Client, _ string) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{
+									Stage:                "fake-stage",
+									AutoPromotionEnabled: true,
+									Freezes: []kargoapi.PromotionFreeze{{
+										Start: metav1.NewTime(fakeTime.Add(-time.Hour)),
+										End:   metav1.NewTime(fakeTime.Add(time.Hour)),
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "outside of allowed window",
+			reconciler: &reconciler{
+				getProjectFn: func(_ context.Context, _ client.Client, _ string) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{
+									Stage:                "fake-stage",
+									AutoPromotionEnabled: true,
+									Windows: []kargoapi.PromotionWindow{{
+										Kind:     kargoapi.PromotionWindowKindAllow,
+										Schedule: "0 8 * * *",
+										Duration: metav1.Duration{Duration: 8 * time.Hour},
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "invalid window",
+			reconciler: &reconciler{
+				getProjectFn: func(_ context.Context, _ client.Client, _ string) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{
+									Stage:                "fake-stage",
+									AutoPromotionEnabled: true,
+									Windows: []kargoapi.PromotionWindow{{
+										Kind:     kargoapi.PromotionWindowKindDeny,
+										Schedule: "not a schedule",
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, result bool, err error) {
+				require.ErrorContains(t, err, "invalid schedule")
+				require.False(t, result)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			testCase.reconciler.nowFn = fakeNow
 			res, err := testCase.reconciler.isAutoPromotionPermitted(
 				context.Background(),
 				"fake-namespace",
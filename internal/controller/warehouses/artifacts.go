@@ -0,0 +1,159 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// discoverOCIArtifacts discovers the latest suitable artifacts for the given
+// OCI artifact subscriptions. It returns a list of artifact discovery results,
+// one for each subscription.
+func (r *reconciler) discoverOCIArtifacts(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+	results := make([]kargoapi.OCIArtifactDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.OCIArtifact == nil {
+			continue
+		}
+		sub := *s.OCIArtifact
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// Obtain credentials for the artifact repository. Artifacts live in the
+		// same registries as images, so image credentials are used.
+		creds, ok, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for artifact repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		var regCreds *image.Credentials
+		if ok {
+			regCreds = &image.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+			logger.Debug("obtained credentials for artifact repo")
+		} else {
+			logger.Debug("found no credentials for artifact repo")
+		}
+
+		// Enrich the logger with additional fields for this subscription.
+		logger = logger.WithValues(ociArtifactDiscoveryLogFields(sub))
+
+		// Discover the latest suitable artifacts.
+		artifacts, err := r.discoverOCIArtifactRefsFn(ctx, sub, regCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering latest artifacts %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		if len(artifacts) == 0 {
+			results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+				RepoURL: sub.RepoURL,
+			})
+			logger.Debug("discovered no artifacts")
+			continue
+		}
+
+		discoveredArtifacts := make([]kargoapi.DiscoveredOCIArtifactReference, 0, len(artifacts))
+		for _, artifact := range artifacts {
+			discoveredArtifacts = append(discoveredArtifacts, kargoapi.DiscoveredOCIArtifactReference{
+				Tag:          artifact.Tag,
+				Digest:       artifact.Digest,
+				ArtifactType: artifact.ArtifactType,
+			})
+		}
+
+		results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+			RepoURL:    sub.RepoURL,
+			References: discoveredArtifacts,
+		})
+		logger.Debug(
+			"discovered artifacts",
+			"count", len(artifacts),
+		)
+	}
+
+	return results, nil
+}
+
+func (r *reconciler) discoverOCIArtifactRefs(
+	ctx context.Context,
+	sub kargoapi.OCIArtifactSubscription,
+	creds *image.Credentials,
+) ([]image.Artifact, error) {
+	artifactSelector, err := ociArtifactSelectorForSubscription(sub, creds)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error creating artifact selector for artifact %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+
+	artifacts, err := artifactSelector.Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error discovering newest applicable artifacts %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	return artifacts, nil
+}
+
+func ociArtifactDiscoveryLogFields(sub kargoapi.OCIArtifactSubscription) []any {
+	f := []any{
+		"selectionStrategy", sub.SelectionStrategy,
+		"typeConstrained", sub.ArtifactType != "",
+		"annotationsConstrained", len(sub.Annotations) > 0,
+	}
+	switch sub.SelectionStrategy {
+	case kargoapi.OCIArtifactSelectionStrategySemVer, kargoapi.OCIArtifactSelectionStrategyDigest:
+		f = append(
+			f,
+			"semverConstraint", sub.SemverConstraint,
+		)
+	case kargoapi.OCIArtifactSelectionStrategyLexical:
+		f = append(
+			f,
+			"tagConstrained", sub.AllowTags != "" || len(sub.IgnoreTags) > 0,
+		)
+	}
+	return f
+}
+
+func ociArtifactSelectorForSubscription(
+	sub kargoapi.OCIArtifactSubscription,
+	creds *image.Credentials,
+) (image.ArtifactSelector, error) {
+	return image.NewArtifactSelector(
+		sub.RepoURL,
+		image.SelectionStrategy(sub.SelectionStrategy),
+		&image.ArtifactSelectorOptions{
+			StrictSemvers:         sub.StrictSemvers,
+			Constraint:            sub.SemverConstraint,
+			AllowRegex:            sub.AllowTags,
+			Ignore:                sub.IgnoreTags,
+			ArtifactType:          sub.ArtifactType,
+			Annotations:           sub.Annotations,
+			Creds:                 creds,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			DiscoveryLimit:        int(sub.DiscoveryLimit),
+		},
+	)
+}
@@ -0,0 +1,157 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+)
+
+func TestDiscoverOCIArtifacts(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		subs       []kargoapi.RepoSubscription
+		assertions func(*testing.T, []kargoapi.OCIArtifactDiscoveryResult, error)
+	}{
+		{
+			name:       "no OCI artifact subscription",
+			reconciler: &reconciler{},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, fmt.Errorf("something went wrong")
+					},
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for artifact repo")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "discovers artifact references",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						_ context.Context,
+						_ string,
+						credType credentials.Type,
+						_ string,
+					) (credentials.Credentials, bool, error) {
+						require.Equal(t, credentials.TypeImage, credType)
+						return credentials.Credentials{Username: "fake-user"}, true, nil
+					},
+				},
+				discoverOCIArtifactRefsFn: func(
+					_ context.Context,
+					_ kargoapi.OCIArtifactSubscription,
+					creds *image.Credentials,
+				) ([]image.Artifact, error) {
+					require.Equal(t, "fake-user", creds.Username)
+					return []image.Artifact{
+						{Tag: "v1.1.0", Digest: "fake-digest", ArtifactType: "fake-type"},
+						{Tag: "v1.0.0", Digest: "other-fake-digest", ArtifactType: "fake-type"},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL: "fake-repo",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "v1.1.0", Digest: "fake-digest", ArtifactType: "fake-type"},
+							{Tag: "v1.0.0", Digest: "other-fake-digest", ArtifactType: "fake-type"},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering artifact references",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Artifact, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "no suitable artifacts discovered",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Artifact, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL: "fake-repo",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+					},
+				}, results)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.discoverOCIArtifacts(
+				context.TODO(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			testCase.assertions(t, results, err)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/file"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// discoverFiles discovers the latest suitable files for the given file
+// subscriptions. It returns a list of file discovery results, one for each
+// subscription.
+func (r *reconciler) discoverFiles(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.FileDiscoveryResult, error) {
+	results := make([]kargoapi.FileDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.File == nil {
+			continue
+		}
+		sub := *s.File
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// Obtain credentials for the location of the files.
+		creds, ok, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeFile, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for file repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		var fileCreds *file.Credentials
+		if ok {
+			fileCreds = &file.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+			logger.Debug("obtained credentials for file repo")
+		} else {
+			logger.Debug("found no credentials for file repo")
+		}
+
+		// Enrich the logger with additional fields for this subscription.
+		logger = logger.WithValues(fileDiscoveryLogFields(sub))
+
+		// Discover the latest suitable files.
+		files, err := r.selectFilesFn(ctx, sub, fileCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering latest files %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		if len(files) == 0 {
+			results = append(results, kargoapi.FileDiscoveryResult{
+				RepoURL: sub.RepoURL,
+			})
+			logger.Debug("discovered no files")
+			continue
+		}
+
+		discoveredFiles := make([]kargoapi.DiscoveredFile, 0, len(files))
+		for _, f := range files {
+			discovered := kargoapi.DiscoveredFile{
+				URL:      f.URL,
+				Version:  f.Version,
+				ETag:     f.ETag,
+				Checksum: f.Checksum,
+			}
+			if f.LastModified != nil {
+				discovered.LastModified = &metav1.Time{Time: *f.LastModified}
+			}
+			discoveredFiles = append(discoveredFiles, discovered)
+		}
+
+		results = append(results, kargoapi.FileDiscoveryResult{
+			RepoURL: sub.RepoURL,
+			Files:   discoveredFiles,
+		})
+		logger.Debug(
+			"discovered files",
+			"count", len(files),
+		)
+	}
+
+	return results, nil
+}
+
+func (r *reconciler) selectFiles(
+	ctx context.Context,
+	sub kargoapi.FileSubscription,
+	creds *file.Credentials,
+) ([]file.File, error) {
+	fileSelector, err := fileSelectorForSubscription(sub, creds)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error creating file selector for %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+
+	files, err := fileSelector.Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error discovering newest applicable files %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	return files, nil
+}
+
+func fileDiscoveryLogFields(sub kargoapi.FileSubscription) []any {
+	f := []any{
+		"selectionStrategy", sub.SelectionStrategy,
+		"fileConstrained", sub.AllowFiles != "" || len(sub.IgnoreFiles) > 0,
+	}
+	if sub.SelectionStrategy == kargoapi.FileSelectionStrategySemVer {
+		f = append(
+			f,
+			"semverConstraint", sub.SemverConstraint,
+		)
+	}
+	return f
+}
+
+func fileSelectorForSubscription(
+	sub kargoapi.FileSubscription,
+	creds *file.Credentials,
+) (file.Selector, error) {
+	return file.NewSelector(
+		sub.RepoURL,
+		file.SelectionStrategy(sub.SelectionStrategy),
+		&file.SelectorOptions{
+			StrictSemvers:         sub.StrictSemvers,
+			Constraint:            sub.SemverConstraint,
+			VersionRegex:          sub.VersionRegex,
+			AllowRegex:            sub.AllowFiles,
+			Ignore:                sub.IgnoreFiles,
+			Endpoint:              sub.Endpoint,
+			Region:                sub.Region,
+			Creds:                 creds,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			DiscoveryLimit:        int(sub.DiscoveryLimit),
+		},
+	)
+}
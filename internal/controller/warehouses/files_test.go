@@ -0,0 +1,181 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/file"
+)
+
+func TestDiscoverFiles(t *testing.T) {
+	lastModified := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		subs       []kargoapi.RepoSubscription
+		assertions func(*testing.T, []kargoapi.FileDiscoveryResult, error)
+	}{
+		{
+			name:       "no file subscription",
+			reconciler: &reconciler{},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.FileDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, fmt.Errorf("something went wrong")
+					},
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{File: &kargoapi.FileSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.FileDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for file repo")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "discovers files",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						_ context.Context,
+						_ string,
+						credType credentials.Type,
+						_ string,
+					) (credentials.Credentials, bool, error) {
+						require.Equal(t, credentials.TypeFile, credType)
+						return credentials.Credentials{Username: "fake-access-key"}, true, nil
+					},
+				},
+				selectFilesFn: func(
+					_ context.Context,
+					_ kargoapi.FileSubscription,
+					creds *file.Credentials,
+				) ([]file.File, error) {
+					require.Equal(t, "fake-access-key", creds.Username)
+					return []file.File{
+						{
+							Name:         "app-1.1.0.tgz",
+							URL:          "s3://fake-bucket/app-1.1.0.tgz",
+							Version:      "1.1.0",
+							ETag:         "fake-etag",
+							Checksum:     "sha256:fake-checksum",
+							LastModified: &lastModified,
+						},
+						{
+							Name:    "app-1.0.0.tgz",
+							URL:     "s3://fake-bucket/app-1.0.0.tgz",
+							Version: "1.0.0",
+						},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{File: &kargoapi.FileSubscription{
+					RepoURL: "s3://fake-bucket/",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.FileDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.FileDiscoveryResult{
+					{
+						RepoURL: "s3://fake-bucket/",
+						Files: []kargoapi.DiscoveredFile{
+							{
+								URL:          "s3://fake-bucket/app-1.1.0.tgz",
+								Version:      "1.1.0",
+								ETag:         "fake-etag",
+								LastModified: &metav1.Time{Time: lastModified},
+								Checksum:     "sha256:fake-checksum",
+							},
+							{
+								URL:     "s3://fake-bucket/app-1.0.0.tgz",
+								Version: "1.0.0",
+							},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering files",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				selectFilesFn: func(
+					context.Context,
+					kargoapi.FileSubscription,
+					*file.Credentials,
+				) ([]file.File, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{File: &kargoapi.FileSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.FileDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "no suitable files discovered",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				selectFilesFn: func(
+					context.Context,
+					kargoapi.FileSubscription,
+					*file.Credentials,
+				) ([]file.File, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{File: &kargoapi.FileSubscription{
+					RepoURL: "s3://fake-bucket/",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.FileDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.FileDiscoveryResult{
+					{
+						RepoURL: "s3://fake-bucket/",
+					},
+				}, results)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.discoverFiles(
+				context.TODO(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			testCase.assertions(t, results, err)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/akuity/kargo/internal/controller/git"
 	libSemver "github.com/akuity/kargo/internal/controller/semver"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/logging"
 )
 
@@ -67,6 +68,32 @@ func (r *reconciler) discoverCommits(
 			logger.Debug("found no credentials for git repo")
 		}
 
+		// Pull requests are discovered using the Git provider's API, so there is
+		// no need to clone the repository.
+		if sub.CommitSelectionStrategy == kargoapi.CommitSelectionStrategyPullRequest {
+			discovered, err := r.discoverPullRequestsFn(
+				logging.ContextWithLogger(ctx, logger.WithValues(gitDiscoveryLogFields(sub)...)),
+				sub,
+				repoCreds,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error discovering pull requests for git repo %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+			results = append(results, kargoapi.GitDiscoveryResult{
+				RepoURL: sub.RepoURL,
+				Commits: discovered,
+			})
+			logger.Debug(
+				"discovered pull requests",
+				"count", len(discovered),
+			)
+			continue
+		}
+
 		// Clone the Git repository.
 		cloneOpts := &git.CloneOptions{
 			Branch:       sub.Branch,
@@ -120,7 +147,7 @@ func (r *reconciler) discoverCommits(
 				)
 			}
 		default:
-			commits, err := r.discoverBranchHistoryFn(repo, sub)
+			commits, err := r.discoverBranchHistoryFn(logging.ContextWithLogger(ctx, logger), repo, sub)
 			if err != nil {
 				return nil, fmt.Errorf("error listing commits from git repo %q: %w", sub.RepoURL, err)
 			}
@@ -172,7 +199,30 @@ func (r *reconciler) discoverCommits(
 // list of commits that match the criteria, sorted in descending order. If the
 // list contains more than 20 commits, it is clipped to the 20 most recent
 // commits.
-func (r *reconciler) discoverBranchHistory(repo git.Repo, sub kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+func (r *reconciler) discoverBranchHistory(
+	ctx context.Context,
+	repo git.Repo,
+	sub kargoapi.GitSubscription,
+) ([]git.CommitMetadata, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	// Compile commit filters.
+	filters, err := getCommitFilters(sub)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compile include and exclude path selectors.
+	includeSelectors, err := getPathSelectors(sub.IncludePaths)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing include selector: %w", err)
+	}
+	excludeSelectors, err := getPathSelectors(sub.ExcludePaths)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing exclude selector: %w", err)
+	}
+	pathFiltered := sub.IncludePaths != nil || sub.ExcludePaths != nil
+
 	limit := int(sub.DiscoveryLimit)
 	var filteredCommits = make([]git.CommitMetadata, 0, limit)
 	for skip := uint(0); ; skip += uint(limit) {
@@ -181,50 +231,50 @@ func (r *reconciler) discoverBranchHistory(repo git.Repo, sub kargoapi.GitSubscr
 			return nil, fmt.Errorf("error listing commits from git repo %q: %w", sub.RepoURL, err)
 		}
 
-		// If no include or exclude paths are specified, return the first commits
-		// up to the limit.
-		if sub.IncludePaths == nil && sub.ExcludePaths == nil {
+		// If no filters are specified, return the first commits up to the
+		// limit.
+		if filters == nil && !pathFiltered {
 			return commits, nil
 		}
 
-		if filteredCommits == nil {
-			filteredCommits = make([]git.CommitMetadata, 0, limit)
-		}
-
-		// Compile include and exclude path selectors.
-		includeSelectors, err := getPathSelectors(sub.IncludePaths)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing include selector: %w", err)
-		}
-		excludeSelectors, err := getPathSelectors(sub.ExcludePaths)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing exclude selector: %w", err)
-		}
-
-		// Filter commits based on include and exclude paths.
+		// Filter commits based on their metadata and on include and exclude
+		// paths.
 		for _, meta := range commits {
-			diffPaths, err := r.getDiffPathsForCommitIDFn(repo, meta.ID)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"error getting diff paths for commit %q in git repo %q: %w",
-					meta.ID,
-					sub.RepoURL,
-					err,
-				)
+			if reason := filters.ignoreReason(meta); reason != "" {
+				logger.Debug("ignoring commit", "commit", meta.ID, "reason", reason)
+				continue
 			}
-			match, err := matchesPathsFilters(includeSelectors, excludeSelectors, diffPaths)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"error checking includePaths/excludePaths match for commit %q for git repo %q: %w",
-					meta.ID,
-					sub.RepoURL,
-					err,
-				)
-			}
-			if match {
-				filteredCommits = append(filteredCommits, meta)
+
+			if pathFiltered {
+				diffPaths, err := r.getDiffPathsForCommitIDFn(repo, meta.ID)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"error getting diff paths for commit %q in git repo %q: %w",
+						meta.ID,
+						sub.RepoURL,
+						err,
+					)
+				}
+				match, err := matchesPathsFilters(includeSelectors, excludeSelectors, diffPaths)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"error checking includePaths/excludePaths match for commit %q for git repo %q: %w",
+						meta.ID,
+						sub.RepoURL,
+						err,
+					)
+				}
+				if !match {
+					logger.Debug(
+						"ignoring commit",
+						"commit", meta.ID,
+						"reason", "no changes in paths selected by includePaths/excludePaths",
+					)
+					continue
+				}
 			}
 
+			filteredCommits = append(filteredCommits, meta)
 			if len(filteredCommits) >= limit {
 				return trimSlice(filteredCommits, limit), nil
 			}
@@ -239,6 +289,89 @@ func (r *reconciler) discoverBranchHistory(repo git.Repo, sub kargoapi.GitSubscr
 	return trimSlice(filteredCommits, limit), nil
 }
 
+// discoverPullRequests returns a list of commits, one for the head of each
+// open pull request in the given subscription's repository that matches the
+// subscription's target branch and labels. Pull requests from forks are
+// ignored, as their head commits are not available in the repository itself.
+// The list is sorted by the date pull requests were last updated, in
+// descending order, and clipped to the subscription's discovery limit.
+func (r *reconciler) discoverPullRequests(
+	ctx context.Context,
+	sub kargoapi.GitSubscription,
+	creds *git.RepoCredentials,
+) ([]kargoapi.DiscoveredCommit, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	gpOpts := &gitprovider.GitProviderOptions{
+		Name:                  sub.Provider,
+		InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+	}
+	if creds != nil {
+		gpOpts.Token = creds.Password
+	}
+	gpSvc, err := r.newGitProviderServiceFn(sub.RepoURL, gpOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Git provider service: %w", err)
+	}
+
+	prs, err := gpSvc.ListPullRequests(ctx, gitprovider.ListPullRequestOpts{
+		State:  gitprovider.PullRequestStateOpen,
+		Base:   sub.Branch,
+		Labels: sub.PullRequestLabels,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests: %w", err)
+	}
+
+	commits := make([]kargoapi.DiscoveredCommit, 0, len(prs))
+	for _, pr := range prs {
+		if pr.FromFork {
+			logger.Debug("ignoring pull request from fork", "pullRequest", pr.Number)
+			continue
+		}
+		if pr.HeadSHA == "" {
+			continue
+		}
+		commit := kargoapi.DiscoveredCommit{
+			ID:     pr.HeadSHA,
+			Branch: pr.HeadRef,
+			// A decent subject length for a commit message is 50 characters
+			// (based on the 50/72 rule). Pull request titles follow similar
+			// conventions, so we apply the same limit as for commit subjects.
+			Subject: shortenString(pr.Title, 80),
+			PullRequest: &kargoapi.PullRequestReference{
+				Number: pr.Number,
+				URL:    pr.URL,
+			},
+		}
+		if pr.UpdatedAt != nil {
+			commit.CreatorDate = &metav1.Time{Time: *pr.UpdatedAt}
+		}
+		commits = append(commits, commit)
+		logger.Trace(
+			"discovered commit from pull request",
+			"pullRequest", pr.Number,
+			"commit", pr.HeadSHA,
+		)
+	}
+
+	slices.SortStableFunc(commits, func(a, b kargoapi.DiscoveredCommit) int {
+		// Sort in descending order of last update, with pull requests lacking a
+		// date of last update sorted last.
+		switch {
+		case a.CreatorDate == nil && b.CreatorDate == nil:
+			return 0
+		case a.CreatorDate == nil:
+			return 1
+		case b.CreatorDate == nil:
+			return -1
+		}
+		return b.CreatorDate.Compare(a.CreatorDate.Time)
+	})
+
+	return trimSlice(commits, int(sub.DiscoveryLimit)), nil
+}
+
 // discoverTags returns a list of tags from the given Git repository that match
 // the given subscription's tag selection criteria. It returns the list of tags
 // that match the criteria, sorted in descending order. If the list contains
@@ -354,6 +487,89 @@ func ignores(tagName string, ignore []string) bool {
 	return false
 }
 
+// commitFilters holds the compiled author, committer, and message patterns of
+// a Git subscription.
+type commitFilters struct {
+	allowAuthors     []*regexp.Regexp
+	ignoreAuthors    []*regexp.Regexp
+	allowCommitters  []*regexp.Regexp
+	ignoreCommitters []*regexp.Regexp
+	ignoreMessages   []*regexp.Regexp
+}
+
+// getCommitFilters compiles the author, committer, and message patterns of the
+// given Git subscription. It returns nil if the subscription specifies none.
+func getCommitFilters(sub kargoapi.GitSubscription) (*commitFilters, error) {
+	if len(sub.AllowAuthors) == 0 && len(sub.IgnoreAuthors) == 0 &&
+		len(sub.AllowCommitters) == 0 && len(sub.IgnoreCommitters) == 0 &&
+		len(sub.IgnoreMessages) == 0 {
+		return nil, nil
+	}
+	f := &commitFilters{}
+	for _, p := range []struct {
+		field    string
+		patterns []string
+		regexes  *[]*regexp.Regexp
+	}{
+		{"allowAuthors", sub.AllowAuthors, &f.allowAuthors},
+		{"ignoreAuthors", sub.IgnoreAuthors, &f.ignoreAuthors},
+		{"allowCommitters", sub.AllowCommitters, &f.allowCommitters},
+		{"ignoreCommitters", sub.IgnoreCommitters, &f.ignoreCommitters},
+		{"ignoreMessages", sub.IgnoreMessages, &f.ignoreMessages},
+	} {
+		for _, pattern := range p.patterns {
+			regex, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s pattern %q: %w", p.field, pattern, err)
+			}
+			*p.regexes = append(*p.regexes, regex)
+		}
+	}
+	return f, nil
+}
+
+// ignoreReason returns a human-readable explanation of why the given commit
+// must be ignored, or an empty string if it must not. A nil commitFilters
+// ignores no commits.
+func (f *commitFilters) ignoreReason(meta git.CommitMetadata) string {
+	if f == nil {
+		return ""
+	}
+	if regex := firstMatch(f.ignoreAuthors, meta.Author); regex != nil {
+		return fmt.Sprintf("author matches ignoreAuthors pattern %q", regex)
+	}
+	if len(f.allowAuthors) > 0 && firstMatch(f.allowAuthors, meta.Author) == nil {
+		return "author matches no allowAuthors pattern"
+	}
+	if regex := firstMatch(f.ignoreCommitters, meta.Committer); regex != nil {
+		return fmt.Sprintf("committer matches ignoreCommitters pattern %q", regex)
+	}
+	if len(f.allowCommitters) > 0 && firstMatch(f.allowCommitters, meta.Committer) == nil {
+		return "committer matches no allowCommitters pattern"
+	}
+	if len(f.ignoreMessages) > 0 {
+		msg := meta.Subject
+		if meta.Body != "" {
+			msg += "\n\n" + meta.Body
+		}
+		if regex := firstMatch(f.ignoreMessages, msg); regex != nil {
+			return fmt.Sprintf("message matches ignoreMessages pattern %q", regex)
+		}
+	}
+	return ""
+}
+
+// firstMatch returns the first of the given regular expressions that matches
+// the given string, or nil if none does.
+func firstMatch(regexes []*regexp.Regexp, str string) *regexp.Regexp {
+	for _, regex := range regexes {
+		if regex.MatchString(str) {
+			return regex
+		}
+	}
+	return nil
+}
+
 func getPathSelectors(selectorStrs []string) ([]pathSelector, error) {
 	selectors := make([]pathSelector, len(selectorStrs))
 	for i, selectorStr := range selectorStrs {
@@ -496,6 +712,11 @@ func gitDiscoveryLogFields(sub kargoapi.GitSubscription) []any {
 		"selectionStrategy", sub.CommitSelectionStrategy,
 		"pathConstrained", sub.IncludePaths != nil || sub.ExcludePaths != nil,
 	}
+	if len(sub.AllowAuthors) > 0 || len(sub.IgnoreAuthors) > 0 ||
+		len(sub.AllowCommitters) > 0 || len(sub.IgnoreCommitters) > 0 ||
+		len(sub.IgnoreMessages) > 0 {
+		f = append(f, "commitConstrained", true)
+	}
 	if sub.Branch != "" {
 		f = append(f, "branch", sub.Branch)
 	}
@@ -508,6 +729,8 @@ func gitDiscoveryLogFields(sub kargoapi.GitSubscription) []any {
 		)
 	case kargoapi.CommitSelectionStrategyLexical, kargoapi.CommitSelectionStrategyNewestTag:
 		f = append(f, "tagConstrained", sub.AllowTags != "" || len(sub.IgnoreTags) > 0)
+	case kargoapi.CommitSelectionStrategyPullRequest:
+		f = append(f, "labelConstrained", len(sub.PullRequestLabels) > 0)
 	}
 	return f
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 )
 
 func TestDiscoverCommits(t *testing.T) {
@@ -59,6 +62,62 @@ func TestDiscoverCommits(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "discovers pull requests without cloning",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverPullRequestsFn: func(
+					context.Context,
+					kargoapi.GitSubscription,
+					*git.RepoCredentials,
+				) ([]kargoapi.DiscoveredCommit, error) {
+					return []kargoapi.DiscoveredCommit{{
+						ID:          "abc",
+						PullRequest: &kargoapi.PullRequestReference{Number: 1},
+					}}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:                 "fake-repo",
+					CommitSelectionStrategy: kargoapi.CommitSelectionStrategyPullRequest,
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.GitDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						Commits: []kargoapi.DiscoveredCommit{{
+							ID:          "abc",
+							PullRequest: &kargoapi.PullRequestReference{Number: 1},
+						}},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering pull requests",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverPullRequestsFn: func(
+					context.Context,
+					kargoapi.GitSubscription,
+					*git.RepoCredentials,
+				) ([]kargoapi.DiscoveredCommit, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					CommitSelectionStrategy: kargoapi.CommitSelectionStrategyPullRequest,
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error discovering pull requests for git repo")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "discovers tags",
 			reconciler: &reconciler{
@@ -120,7 +179,11 @@ func TestDiscoverCommits(t *testing.T) {
 				gitCloneFn: func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error) {
 					return nil, nil
 				},
-				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+				discoverBranchHistoryFn: func(
+					context.Context,
+					git.Repo,
+					kargoapi.GitSubscription,
+				) ([]git.CommitMetadata, error) {
 					return []git.CommitMetadata{
 						{ID: "abc"},
 						{ID: "xyz"},
@@ -153,7 +216,11 @@ func TestDiscoverCommits(t *testing.T) {
 				gitCloneFn: func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error) {
 					return nil, nil
 				},
-				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+				discoverBranchHistoryFn: func(
+					context.Context,
+					git.Repo,
+					kargoapi.GitSubscription,
+				) ([]git.CommitMetadata, error) {
 					return nil, errors.New("something went wrong")
 				},
 			},
@@ -180,7 +247,11 @@ func TestDiscoverCommits(t *testing.T) {
 						{Tag: "v1.0.0"},
 					}, nil
 				},
-				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+				discoverBranchHistoryFn: func(
+					context.Context,
+					git.Repo,
+					kargoapi.GitSubscription,
+				) ([]git.CommitMetadata, error) {
 					return []git.CommitMetadata{
 						{ID: "abc"},
 						{ID: "xyz"},
@@ -315,16 +386,151 @@ func TestDiscoverBranchHistory(t *testing.T) {
 				}, commits)
 			},
 		},
+		{
+			name: "invalid commit filter",
+			sub: kargoapi.GitSubscription{
+				IgnoreAuthors: []string{"("},
+			},
+			reconciler: &reconciler{},
+			assertions: func(t *testing.T, _ []git.CommitMetadata, err error) {
+				require.ErrorContains(t, err, "error parsing ignoreAuthors pattern")
+			},
+		},
+		{
+			name: "with commit and path filters",
+			sub: kargoapi.GitSubscription{
+				DiscoveryLimit: 2,
+				IgnoreAuthors:  []string{`^renovate\[bot\]`},
+				IgnoreMessages: []string{`\[skip kargo\]`},
+				IncludePaths:   []string{"charts"},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(_ git.Repo, _ uint, skip uint) ([]git.CommitMetadata, error) {
+					if skip > 0 {
+						return nil, nil
+					}
+					return []git.CommitMetadata{
+						{ID: "bot", Author: "renovate[bot] <bot@renovateapp.com>"},
+						{ID: "skipped", Subject: "Update values", Body: "[skip kargo]"},
+						{ID: "unrelated", Subject: "Update docs"},
+						{ID: "abc", Subject: "Update chart"},
+					}, nil
+				},
+				getDiffPathsForCommitIDFn: func(_ git.Repo, id string) ([]string, error) {
+					if id == "unrelated" {
+						return []string{"docs/README.md"}, nil
+					}
+					return []string{"charts/foo/values.yaml"}, nil
+				},
+			},
+			assertions: func(t *testing.T, commits []git.CommitMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []git.CommitMetadata{
+					{ID: "abc", Subject: "Update chart"},
+				}, commits)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			tags, err := testCase.reconciler.discoverBranchHistory(nil, testCase.sub)
+			tags, err := testCase.reconciler.discoverBranchHistory(context.Background(), nil, testCase.sub)
 			testCase.assertions(t, tags, err)
 		})
 	}
 }
 
+func TestDiscoverPullRequests(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		creds      *git.RepoCredentials
+		provider   *gitprovider.FakeGitProviderService
+		assertions func(*testing.T, []kargoapi.DiscoveredCommit, error)
+	}{
+		{
+			name: "error listing pull requests",
+			provider: &gitprovider.FakeGitProviderService{
+				ListPullRequestsFn: func(
+					context.Context,
+					gitprovider.ListPullRequestOpts,
+				) ([]*gitprovider.PullRequest, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.DiscoveredCommit, err error) {
+				require.ErrorContains(t, err, "error listing pull requests")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.GitSubscription{
+				Branch:            "main",
+				PullRequestLabels: []string{"preview"},
+				DiscoveryLimit:    2,
+			},
+			creds: &git.RepoCredentials{Password: "fake-token"},
+			provider: &gitprovider.FakeGitProviderService{
+				ListPullRequestsFn: func(
+					_ context.Context,
+					opts gitprovider.ListPullRequestOpts,
+				) ([]*gitprovider.PullRequest, error) {
+					if opts.State != gitprovider.PullRequestStateOpen ||
+						opts.Base != "main" ||
+						!slices.Equal(opts.Labels, []string{"preview"}) {
+						return nil, errors.New("unexpected options")
+					}
+					return []*gitprovider.PullRequest{
+						{Number: 1, HeadSHA: "abc", HeadRef: "older", UpdatedAt: &older},
+						{Number: 2, HeadSHA: "def", HeadRef: "fork", FromFork: true, UpdatedAt: &newer},
+						{Number: 3, HeadSHA: "ghi", HeadRef: "undated"},
+						{Number: 4, HeadSHA: "jkl", HeadRef: "newer", Title: "Add feature", UpdatedAt: &newer},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, commits []kargoapi.DiscoveredCommit, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.DiscoveredCommit{
+					{
+						ID:          "jkl",
+						Branch:      "newer",
+						Subject:     "Add feature",
+						CreatorDate: &metav1.Time{Time: newer},
+						PullRequest: &kargoapi.PullRequestReference{Number: 4},
+					},
+					{
+						ID:          "abc",
+						Branch:      "older",
+						CreatorDate: &metav1.Time{Time: older},
+						PullRequest: &kargoapi.PullRequestReference{Number: 1},
+					},
+				}, commits)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				newGitProviderServiceFn: func(
+					_ string,
+					opts *gitprovider.GitProviderOptions,
+				) (gitprovider.GitProviderService, error) {
+					if testCase.creds != nil {
+						require.Equal(t, testCase.creds.Password, opts.Token)
+					}
+					return testCase.provider, nil
+				},
+			}
+			commits, err := r.discoverPullRequests(context.Background(), testCase.sub, testCase.creds)
+			testCase.assertions(t, commits, err)
+		})
+	}
+}
+
 func TestDiscoverTags(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -687,6 +893,92 @@ func TestIgnores(t *testing.T) {
 	}
 }
 
+func TestCommitFiltersIgnoreReason(t *testing.T) {
+	const (
+		testAuthor    = "Kargo <no-reply@kargo.io>"
+		testCommitter = "GitHub <noreply@github.com>"
+	)
+	testCases := []struct {
+		name     string
+		sub      kargoapi.GitSubscription
+		meta     git.CommitMetadata
+		expected string
+	}{
+		{
+			name: "no filters",
+			meta: git.CommitMetadata{Author: testAuthor},
+		},
+		{
+			name:     "author ignored",
+			sub:      kargoapi.GitSubscription{IgnoreAuthors: []string{"no-reply@kargo"}},
+			meta:     git.CommitMetadata{Author: testAuthor},
+			expected: `author matches ignoreAuthors pattern "no-reply@kargo"`,
+		},
+		{
+			name: "author ignored despite being allowed",
+			sub: kargoapi.GitSubscription{
+				AllowAuthors:  []string{"Kargo"},
+				IgnoreAuthors: []string{"no-reply@kargo"},
+			},
+			meta:     git.CommitMetadata{Author: testAuthor},
+			expected: `author matches ignoreAuthors pattern "no-reply@kargo"`,
+		},
+		{
+			name:     "author not allowed",
+			sub:      kargoapi.GitSubscription{AllowAuthors: []string{"@example.com>$"}},
+			meta:     git.CommitMetadata{Author: testAuthor},
+			expected: "author matches no allowAuthors pattern",
+		},
+		{
+			name:     "committer ignored",
+			sub:      kargoapi.GitSubscription{IgnoreCommitters: []string{"^GitHub"}},
+			meta:     git.CommitMetadata{Committer: testCommitter},
+			expected: `committer matches ignoreCommitters pattern "^GitHub"`,
+		},
+		{
+			name:     "committer not allowed",
+			sub:      kargoapi.GitSubscription{AllowCommitters: []string{"@example.com>$"}},
+			meta:     git.CommitMetadata{Committer: testCommitter},
+			expected: "committer matches no allowCommitters pattern",
+		},
+		{
+			name:     "message ignored by subject",
+			sub:      kargoapi.GitSubscription{IgnoreMessages: []string{`^chore:`}},
+			meta:     git.CommitMetadata{Subject: "chore: update deps"},
+			expected: `message matches ignoreMessages pattern "^chore:"`,
+		},
+		{
+			name: "message ignored by body",
+			sub:  kargoapi.GitSubscription{IgnoreMessages: []string{`(?m)^\[skip kargo\]$`}},
+			meta: git.CommitMetadata{
+				Subject: "Update values",
+				Body:    "Some details\n[skip kargo]",
+			},
+			expected: `message matches ignoreMessages pattern "(?m)^\\[skip kargo\\]$"`,
+		},
+		{
+			name: "not ignored",
+			sub: kargoapi.GitSubscription{
+				AllowAuthors:     []string{"Kargo"},
+				IgnoreCommitters: []string{"renovate"},
+				IgnoreMessages:   []string{`\[skip kargo\]`},
+			},
+			meta: git.CommitMetadata{
+				Author:    testAuthor,
+				Committer: testCommitter,
+				Subject:   "Update values",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filters, err := getCommitFilters(testCase.sub)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, filters.ignoreReason(testCase.meta))
+		})
+	}
+}
+
 func TestSelectSemVerTags(t *testing.T) {
 	testCases := []struct {
 		name       string
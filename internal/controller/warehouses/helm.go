@@ -3,6 +3,10 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -53,8 +57,23 @@ func (r *reconciler) discoverCharts(
 			logger = logger.WithValues("semverConstraint", sub.SemverConstraint)
 		}
 
+		opts := &helm.DiscoveryOptions{
+			SemverConstraint: sub.SemverConstraint,
+			DiscoveryLimit:   int(sub.DiscoveryLimit),
+		}
+		if sub.Verification != nil {
+			logger = logger.WithValues("keyringSecret", sub.Verification.KeyringSecret)
+			if opts.Keyrings, err = r.getChartKeyrings(ctx, namespace, *sub.Verification); err != nil {
+				return nil, fmt.Errorf(
+					"error obtaining keyrings for chart repository %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+		}
+
 		// Discover versions of the chart based on the semver constraint.
-		versions, err := r.discoverChartVersionsFn(ctx, sub.RepoURL, sub.Name, sub.SemverConstraint, helmCreds)
+		versions, err := r.discoverChartVersionsFn(ctx, sub.RepoURL, sub.Name, helmCreds, opts)
 		if err != nil {
 			if sub.Name == "" {
 				return nil, fmt.Errorf(
@@ -81,12 +100,27 @@ func (r *reconciler) discoverCharts(
 			continue
 		}
 
-		results = append(results, kargoapi.ChartDiscoveryResult{
+		versions = trimSlice(versions, int(sub.DiscoveryLimit))
+		result := kargoapi.ChartDiscoveryResult{
 			RepoURL:          sub.RepoURL,
 			Name:             sub.Name,
 			SemverConstraint: sub.SemverConstraint,
-			Versions:         trimSlice(versions, int(sub.DiscoveryLimit)),
-		})
+			Versions:         make([]string, 0, len(versions)),
+			References:       make([]kargoapi.DiscoveredChartReference, 0, len(versions)),
+		}
+		for _, v := range versions {
+			ref := kargoapi.DiscoveredChartReference{
+				Version:    v.Version,
+				AppVersion: v.AppVersion,
+				Digest:     v.Digest,
+			}
+			if v.CreatedAt != nil {
+				ref.CreatedAt = &metav1.Time{Time: *v.CreatedAt}
+			}
+			result.Versions = append(result.Versions, v.Version)
+			result.References = append(result.References, ref)
+		}
+		results = append(results, result)
 		logger.Debug(
 			"discovered chart versions",
 			"count", len(versions),
@@ -96,6 +130,28 @@ func (r *reconciler) discoverCharts(
 	return results, nil
 }
 
+// getChartKeyrings returns the OpenPGP keyrings held by the Secret referenced
+// by the provided kargoapi.ChartVerification in the given namespace.
+func (r *reconciler) getChartKeyrings(
+	ctx context.Context,
+	namespace string,
+	verification kargoapi.ChartVerification,
+) ([][]byte, error) {
+	secret, err := r.getSecret(ctx, namespace, verification.KeyringSecret)
+	if err != nil {
+		return nil, err
+	}
+	keys := slices.Sorted(maps.Keys(secret.Data))
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("Secret %q holds no keyrings", secret.Name)
+	}
+	keyrings := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyrings = append(keyrings, secret.Data[key])
+	}
+	return keyrings, nil
+}
+
 // trimSlice returns a slice of any type with a maximum length of limit.
 // If the input slice is shorter than limit or limit is less than or equal to
 // zero, the input slice is returned unmodified.
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -13,6 +17,8 @@ import (
 )
 
 func TestDiscoverCharts(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
@@ -60,10 +66,18 @@ func TestDiscoverCharts(t *testing.T) {
 					context.Context,
 					string,
 					string,
-					string,
 					*helm.Credentials,
-				) ([]string, error) {
-					return []string{"1.1.0", "1.0.0"}, nil
+					*helm.DiscoveryOptions,
+				) ([]helm.ChartVersion, error) {
+					return []helm.ChartVersion{
+						{
+							Version:    "1.1.0",
+							AppVersion: "v1.1.0",
+							Digest:     "sha256:fake-digest",
+							CreatedAt:  &createdAt,
+						},
+						{Version: "1.0.0"},
+					}, nil
 				},
 			},
 			subs: []kargoapi.RepoSubscription{
@@ -79,10 +93,84 @@ func TestDiscoverCharts(t *testing.T) {
 						RepoURL:  "https://example.com",
 						Name:     "fake-chart",
 						Versions: []string{"1.1.0", "1.0.0"},
+						References: []kargoapi.DiscoveredChartReference{
+							{
+								Version:    "1.1.0",
+								AppVersion: "v1.1.0",
+								Digest:     "sha256:fake-digest",
+								CreatedAt:  &metav1.Time{Time: createdAt},
+							},
+							{Version: "1.0.0"},
+						},
 					},
 				}, results)
 			},
 		},
+		{
+			name: "discovers verified chart versions",
+			reconciler: &reconciler{
+				client: fake.NewClientBuilder().WithObjects(
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-keyrings",
+						},
+						Data: map[string][]byte{
+							"b": []byte("fake-keyring-b"),
+							"a": []byte("fake-keyring-a"),
+						},
+					},
+				).Build(),
+				credentialsDB: &credentials.FakeDB{},
+				discoverChartVersionsFn: func(
+					_ context.Context,
+					_ string,
+					_ string,
+					_ *helm.Credentials,
+					opts *helm.DiscoveryOptions,
+				) ([]helm.ChartVersion, error) {
+					if len(opts.Keyrings) != 2 ||
+						string(opts.Keyrings[0]) != "fake-keyring-a" ||
+						string(opts.Keyrings[1]) != "fake-keyring-b" {
+						return nil, fmt.Errorf("unexpected keyrings")
+					}
+					return []helm.ChartVersion{{Version: "1.0.0"}}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL: "https://example.com",
+					Name:    "fake-chart",
+					Verification: &kargoapi.ChartVerification{
+						KeyringSecret: "fake-keyrings",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, results, 1)
+				require.Equal(t, []string{"1.0.0"}, results[0].Versions)
+			},
+		},
+		{
+			name: "error obtaining keyrings",
+			reconciler: &reconciler{
+				client:        fake.NewClientBuilder().Build(),
+				credentialsDB: &credentials.FakeDB{},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL: "https://example.com",
+					Verification: &kargoapi.ChartVerification{
+						KeyringSecret: "fake-keyrings",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining keyrings for chart repository")
+				require.Empty(t, results)
+			},
+		},
 		{
 			name: "no chart versions discovered",
 			reconciler: &reconciler{
@@ -91,9 +179,9 @@ func TestDiscoverCharts(t *testing.T) {
 					context.Context,
 					string,
 					string,
-					string,
 					*helm.Credentials,
-				) ([]string, error) {
+					*helm.DiscoveryOptions,
+				) ([]helm.ChartVersion, error) {
 					return nil, nil
 				},
 			},
@@ -121,9 +209,9 @@ func TestDiscoverCharts(t *testing.T) {
 					context.Context,
 					string,
 					string,
-					string,
 					*helm.Credentials,
-				) ([]string, error) {
+					*helm.DiscoveryOptions,
+				) ([]helm.ChartVersion, error) {
 					return nil, fmt.Errorf("something went wrong")
 				},
 			},
@@ -144,9 +232,9 @@ func TestDiscoverCharts(t *testing.T) {
 					context.Context,
 					string,
 					string,
-					string,
 					*helm.Credentials,
-				) ([]string, error) {
+					*helm.DiscoveryOptions,
+				) ([]helm.ChartVersion, error) {
 					return nil, fmt.Errorf("something went wrong")
 				},
 			},
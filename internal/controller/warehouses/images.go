@@ -3,9 +3,13 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -52,11 +56,27 @@ func (r *reconciler) discoverImages(
 			logger.Debug("found no credentials for image repo")
 		}
 
+		// Obtain the material required to verify image signatures, if any.
+		var verification *image.VerificationOptions
+		if sub.Verification != nil {
+			if verification, err = r.getImageVerificationOptions(
+				ctx,
+				namespace,
+				*sub.Verification,
+			); err != nil {
+				return nil, fmt.Errorf(
+					"error obtaining verification options for image repo %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+		}
+
 		// Enrich the logger with additional fields for this subscription.
 		logger = logger.WithValues(imageDiscoveryLogFields(sub))
 
 		// Discover the latest suitable images.
-		images, err := r.discoverImageRefsFn(ctx, sub, regCreds)
+		images, err := r.discoverImageRefsFn(ctx, sub, regCreds, verification)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error discovering latest images %q: %w",
@@ -76,9 +96,12 @@ func (r *reconciler) discoverImages(
 		discoveredImages := make([]kargoapi.DiscoveredImageReference, 0, len(images))
 		for _, img := range images {
 			discovery := kargoapi.DiscoveredImageReference{
-				Tag:        img.Tag,
-				Digest:     img.Digest,
-				GitRepoURL: r.getImageSourceURL(sub.GitRepoURL, img.Tag),
+				Tag:                 img.Tag,
+				Digest:              img.Digest,
+				GitRepoURL:          r.getImageSourceURL(sub.GitRepoURL, img.Tag),
+				VerificationFailure: img.VerificationFailure,
+				Labels:              img.Labels,
+				Annotations:         img.Annotations,
 			}
 			if img.CreatedAt != nil {
 				discovery.CreatedAt = &metav1.Time{Time: *img.CreatedAt}
@@ -104,8 +127,9 @@ func (r *reconciler) discoverImageRefs(
 	ctx context.Context,
 	sub kargoapi.ImageSubscription,
 	creds *image.Credentials,
+	verification *image.VerificationOptions,
 ) ([]image.Image, error) {
-	imageSelector, err := imageSelectorForSubscription(sub, creds)
+	imageSelector, err := imageSelectorForSubscription(sub, creds, verification)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error creating image selector for image %q: %w",
@@ -127,6 +151,13 @@ func (r *reconciler) discoverImageRefs(
 
 const (
 	githubURLPrefix = "https://github.com"
+
+	// certificateAuthoritiesSecretKey is the key in a keyless verification
+	// Secret holding the certificates of trusted certificate authorities.
+	certificateAuthoritiesSecretKey = "fulcio.pem"
+	// transparencyLogPublicKeySecretKey is the key in a keyless verification
+	// Secret holding the public key of the trusted transparency log.
+	transparencyLogPublicKeySecretKey = "rekor.pub"
 )
 
 func (r *reconciler) getImageSourceURL(gitRepoURL, tag string) string {
@@ -142,6 +173,7 @@ func imageDiscoveryLogFields(sub kargoapi.ImageSubscription) []any {
 	f := []any{
 		"imageSelectionStrategy", sub.ImageSelectionStrategy,
 		"platformConstrained", sub.Platform != "",
+		"metadataConstrained", len(sub.LabelMatchExpressions) > 0 || len(sub.AnnotationMatchExpressions) > 0,
 	}
 	switch sub.ImageSelectionStrategy {
 	case kargoapi.ImageSelectionStrategySemVer, kargoapi.ImageSelectionStrategyDigest:
@@ -161,23 +193,120 @@ func imageDiscoveryLogFields(sub kargoapi.ImageSubscription) []any {
 func imageSelectorForSubscription(
 	sub kargoapi.ImageSubscription,
 	creds *image.Credentials,
+	verification *image.VerificationOptions,
 ) (image.Selector, error) {
 	return image.NewSelector(
 		sub.RepoURL,
 		image.SelectionStrategy(sub.ImageSelectionStrategy),
 		&image.SelectorOptions{
-			StrictSemvers:         sub.StrictSemvers,
-			Constraint:            sub.SemverConstraint,
-			AllowRegex:            sub.AllowTags,
-			Ignore:                sub.IgnoreTags,
-			Platform:              sub.Platform,
-			Creds:                 creds,
-			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
-			DiscoveryLimit:        int(sub.DiscoveryLimit),
+			StrictSemvers:              sub.StrictSemvers,
+			Constraint:                 sub.SemverConstraint,
+			AllowRegex:                 sub.AllowTags,
+			Ignore:                     sub.IgnoreTags,
+			Platform:                   sub.Platform,
+			Creds:                      creds,
+			InsecureSkipTLSVerify:      sub.InsecureSkipTLSVerify,
+			DiscoveryLimit:             int(sub.DiscoveryLimit),
+			Verification:               verification,
+			LabelMatchExpressions:      metadataMatchExpressions(sub.LabelMatchExpressions),
+			AnnotationMatchExpressions: metadataMatchExpressions(sub.AnnotationMatchExpressions),
 		},
 	)
 }
 
+// metadataMatchExpressions converts the provided
+// kargoapi.ImageMetadataMatchExpressions to image.MetadataMatchExpressions.
+func metadataMatchExpressions(
+	exprs []kargoapi.ImageMetadataMatchExpression,
+) []image.MetadataMatchExpression {
+	if len(exprs) == 0 {
+		return nil
+	}
+	res := make([]image.MetadataMatchExpression, 0, len(exprs))
+	for _, expr := range exprs {
+		res = append(res, image.MetadataMatchExpression{
+			Key:      expr.Key,
+			Operator: image.MetadataMatchOperator(expr.Operator),
+			Values:   expr.Values,
+		})
+	}
+	return res
+}
+
+// getImageVerificationOptions assembles image.VerificationOptions from the
+// provided kargoapi.ImageVerification, reading public keys and trusted roots
+// from the Secrets it references in the given namespace.
+func (r *reconciler) getImageVerificationOptions(
+	ctx context.Context,
+	namespace string,
+	verification kargoapi.ImageVerification,
+) (*image.VerificationOptions, error) {
+	opts := &image.VerificationOptions{}
+	for _, att := range verification.Attestations {
+		opts.AttestationPredicateTypes = append(opts.AttestationPredicateTypes, att.PredicateType)
+	}
+
+	if verification.PublicKeysSecret != "" {
+		secret, err := r.getSecret(ctx, namespace, verification.PublicKeysSecret)
+		if err != nil {
+			return nil, err
+		}
+		keys := slices.Sorted(maps.Keys(secret.Data))
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("Secret %q holds no public keys", secret.Name)
+		}
+		for _, key := range keys {
+			opts.PublicKeys = append(opts.PublicKeys, secret.Data[key])
+		}
+	}
+
+	if keyless := verification.Keyless; keyless != nil {
+		secret, err := r.getSecret(ctx, namespace, keyless.TrustedRootSecret)
+		if err != nil {
+			return nil, err
+		}
+		opts.Keyless = &image.KeylessVerificationOptions{
+			Identities:               make([]image.KeylessIdentity, 0, len(keyless.Identities)),
+			CertificateAuthorities:   secret.Data[certificateAuthoritiesSecretKey],
+			TransparencyLogPublicKey: secret.Data[transparencyLogPublicKeySecretKey],
+		}
+		for _, identity := range keyless.Identities {
+			opts.Keyless.Identities = append(opts.Keyless.Identities, image.KeylessIdentity{
+				Issuer:       identity.Issuer,
+				IssuerRegex:  identity.IssuerRegex,
+				Subject:      identity.Subject,
+				SubjectRegex: identity.SubjectRegex,
+			})
+		}
+	}
+
+	return opts, nil
+}
+
+func (r *reconciler) getSecret(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	return secret, nil
+}
+
 func getGithubImageSourceURL(gitRepoURL, tag string) string {
 	return fmt.Sprintf("%s/tree/%s", git.NormalizeURL(gitRepoURL), tag)
 }
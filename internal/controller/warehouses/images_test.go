@@ -6,6 +6,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -60,10 +64,11 @@ func TestDiscoverImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					*image.VerificationOptions,
 				) ([]image.Image, error) {
 					return []image.Image{
-						{Tag: "xyz"},
-						{Tag: "abc"},
+						{Tag: "xyz", Labels: map[string]string{"ci.passed": "true"}},
+						{Tag: "abc", VerificationFailure: "no signatures found"},
 					}, nil
 				},
 			},
@@ -78,13 +83,32 @@ func TestDiscoverImages(t *testing.T) {
 					{
 						RepoURL: "fake-repo",
 						References: []kargoapi.DiscoveredImageReference{
-							{Tag: "xyz"},
-							{Tag: "abc"},
+							{Tag: "xyz", Labels: map[string]string{"ci.passed": "true"}},
+							{Tag: "abc", VerificationFailure: "no signatures found"},
 						},
 					},
 				}, results)
 			},
 		},
+		{
+			name: "error obtaining verification options",
+			reconciler: &reconciler{
+				client:        fake.NewClientBuilder().Build(),
+				credentialsDB: &credentials.FakeDB{},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{
+					RepoURL: "fake-repo",
+					Verification: &kargoapi.ImageVerification{
+						PublicKeysSecret: "fake-secret",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ImageDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining verification options")
+				require.Empty(t, results)
+			},
+		},
 		{
 			name: "error discovering image references",
 			reconciler: &reconciler{
@@ -93,6 +117,7 @@ func TestDiscoverImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					*image.VerificationOptions,
 				) ([]image.Image, error) {
 					return nil, fmt.Errorf("something went wrong")
 				},
@@ -113,6 +138,7 @@ func TestDiscoverImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					*image.VerificationOptions,
 				) ([]image.Image, error) {
 					return nil, nil
 				},
@@ -145,6 +171,122 @@ func TestDiscoverImages(t *testing.T) {
 	}
 }
 
+func TestGetImageVerificationOptions(t *testing.T) {
+	testCases := []struct {
+		name         string
+		objects      []client.Object
+		verification kargoapi.ImageVerification
+		assertions   func(*testing.T, *image.VerificationOptions, error)
+	}{
+		{
+			name: "public keys Secret not found",
+			verification: kargoapi.ImageVerification{
+				PublicKeysSecret: "fake-keys",
+			},
+			assertions: func(t *testing.T, _ *image.VerificationOptions, err error) {
+				require.ErrorContains(t, err, "error getting Secret \"fake-keys\"")
+			},
+		},
+		{
+			name: "public keys Secret is empty",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-keys",
+					},
+				},
+			},
+			verification: kargoapi.ImageVerification{
+				PublicKeysSecret: "fake-keys",
+			},
+			assertions: func(t *testing.T, _ *image.VerificationOptions, err error) {
+				require.ErrorContains(t, err, "holds no public keys")
+			},
+		},
+		{
+			name: "trusted root Secret not found",
+			verification: kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					TrustedRootSecret: "fake-roots",
+				},
+			},
+			assertions: func(t *testing.T, _ *image.VerificationOptions, err error) {
+				require.ErrorContains(t, err, "error getting Secret \"fake-roots\"")
+			},
+		},
+		{
+			name: "success",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-keys",
+					},
+					Data: map[string][]byte{
+						"b.pub": []byte("fake-key-b"),
+						"a.pub": []byte("fake-key-a"),
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-roots",
+					},
+					Data: map[string][]byte{
+						certificateAuthoritiesSecretKey:   []byte("fake-roots"),
+						transparencyLogPublicKeySecretKey: []byte("fake-log-key"),
+					},
+				},
+			},
+			verification: kargoapi.ImageVerification{
+				PublicKeysSecret: "fake-keys",
+				Keyless: &kargoapi.KeylessVerification{
+					TrustedRootSecret: "fake-roots",
+					Identities: []kargoapi.KeylessIdentity{{
+						Issuer:       "https://token.example.com",
+						SubjectRegex: "@example.com$",
+					}},
+				},
+				Attestations: []kargoapi.ImageAttestation{{
+					PredicateType: "https://slsa.dev/provenance/v1",
+				}},
+			},
+			assertions: func(t *testing.T, opts *image.VerificationOptions, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &image.VerificationOptions{
+					PublicKeys: [][]byte{
+						[]byte("fake-key-a"),
+						[]byte("fake-key-b"),
+					},
+					Keyless: &image.KeylessVerificationOptions{
+						Identities: []image.KeylessIdentity{{
+							Issuer:       "https://token.example.com",
+							SubjectRegex: "@example.com$",
+						}},
+						CertificateAuthorities:   []byte("fake-roots"),
+						TransparencyLogPublicKey: []byte("fake-log-key"),
+					},
+					AttestationPredicateTypes: []string{"https://slsa.dev/provenance/v1"},
+				}, opts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				client: fake.NewClientBuilder().WithObjects(testCase.objects...).Build(),
+			}
+			opts, err := r.getImageVerificationOptions(
+				context.TODO(),
+				"fake-namespace",
+				testCase.verification,
+			)
+			testCase.assertions(t, opts, err)
+		})
+	}
+}
+
 func TestGetImageSourceURL(t *testing.T) {
 	const testURLPrefix = "fake-url-prefix"
 	testCases := []struct {
@@ -0,0 +1,304 @@
+package warehouses
+
+import (
+	"fmt"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// unsatisfiedMatchRulesError is returned when no combination of discovered
+// artifacts satisfies all of a Warehouse's Freight match rules.
+type unsatisfiedMatchRulesError struct {
+	// rules holds descriptions of the rules that could not be satisfied.
+	rules []string
+}
+
+func (e *unsatisfiedMatchRulesError) Error() string {
+	return fmt.Sprintf(
+		"no combination of discovered artifacts satisfies all Freight match rules; unsatisfied: %s",
+		strings.Join(e.rules, "; "),
+	)
+}
+
+// artifactSelection holds, for each discovery result of each kind, the index
+// of the discovered artifact that should be included in Freight. An index of
+// -1 indicates that no suitable artifact was discovered.
+type artifactSelection struct {
+	git          []int
+	images       []int
+	charts       []int
+	ociArtifacts []int
+	files        []int
+}
+
+// matchSlot represents a single discovery result as seen by the Freight match
+// rules.
+type matchSlot struct {
+	kind    kargoapi.FreightMatchArtifactKind
+	repoURL string
+	name    string
+	// candidates holds the indices of the discovered artifacts that are
+	// eligible for inclusion in Freight, ordered from newest to oldest.
+	candidates []int
+	// versionOf returns the unmodified version of the discovered artifact at
+	// the given index.
+	versionOf func(int) string
+	// selected points to the entry in an artifactSelection that records the
+	// index of the selected artifact.
+	selected *int
+}
+
+// selectArtifacts selects the artifacts from which Freight should be built. In
+// the absence of any rules, the newest eligible artifact of each discovery
+// result is selected. Otherwise, the newest combination of eligible artifacts
+// that satisfies all rules is selected, giving precedence to the artifacts of
+// discovery results in the order in which they were discovered. If no such
+// combination exists, an *unsatisfiedMatchRulesError is returned.
+func selectArtifacts(
+	artifacts *kargoapi.DiscoveredArtifacts,
+	rules []kargoapi.FreightMatchRule,
+) (*artifactSelection, error) {
+	sel := &artifactSelection{
+		git:          make([]int, len(artifacts.Git)),
+		images:       make([]int, len(artifacts.Images)),
+		charts:       make([]int, len(artifacts.Charts)),
+		ociArtifacts: make([]int, len(artifacts.OCIArtifacts)),
+		files:        make([]int, len(artifacts.Files)),
+	}
+	slots := matchSlotsFor(artifacts, sel)
+
+	// Start out by selecting the newest eligible artifact of each discovery
+	// result. This is the final selection if there are no rules to satisfy.
+	for _, slot := range slots {
+		*slot.selected = -1
+		if len(slot.candidates) > 0 {
+			*slot.selected = slot.candidates[0]
+		}
+	}
+	if len(rules) == 0 {
+		return sel, nil
+	}
+
+	// Resolve the slots referenced by each rule.
+	type resolvedRule struct {
+		rule           kargoapi.FreightMatchRule
+		source, target int
+	}
+	resolved := make([]resolvedRule, len(rules))
+	for i, rule := range rules {
+		source := findMatchSlot(slots, rule.Source)
+		if source < 0 {
+			return nil, fmt.Errorf(
+				"no discovered artifacts found for source of Freight match rule %q",
+				describeMatchRule(i, rule),
+			)
+		}
+		target := findMatchSlot(slots, rule.Target)
+		if target < 0 {
+			return nil, fmt.Errorf(
+				"no discovered artifacts found for target of Freight match rule %q",
+				describeMatchRule(i, rule),
+			)
+		}
+		if len(slots[source].candidates) == 0 || len(slots[target].candidates) == 0 {
+			// Leave it to the caller to report the missing artifacts.
+			return sel, nil
+		}
+		resolved[i] = resolvedRule{rule: rule, source: source, target: target}
+	}
+
+	// Only the slots referenced by a rule need to be searched. All others keep
+	// their newest eligible artifact. A rule can be evaluated as soon as both
+	// of the slots it references have been assigned an artifact.
+	var searched []int
+	searchPos := make(map[int]int, len(slots))
+	for i := range slots {
+		for _, r := range resolved {
+			if r.source == i || r.target == i {
+				searchPos[i] = len(searched)
+				searched = append(searched, i)
+				break
+			}
+		}
+	}
+	rulesByDepth := make([][]resolvedRule, len(searched))
+	for _, r := range resolved {
+		depth := max(searchPos[r.source], searchPos[r.target])
+		rulesByDepth[depth] = append(rulesByDepth[depth], r)
+	}
+
+	satisfied := func(r resolvedRule) bool {
+		source, target := slots[r.source], slots[r.target]
+		return matchVersion(r.rule.Source, source.versionOf(*source.selected)) ==
+			matchVersion(r.rule.Target, target.versionOf(*target.selected))
+	}
+
+	var search func(depth int) bool
+	search = func(depth int) bool {
+		if depth == len(searched) {
+			return true
+		}
+		slot := slots[searched[depth]]
+	candidates:
+		for _, candidate := range slot.candidates {
+			*slot.selected = candidate
+			for _, r := range rulesByDepth[depth] {
+				if !satisfied(r) {
+					continue candidates
+				}
+			}
+			if search(depth + 1) {
+				return true
+			}
+		}
+		return false
+	}
+	if search(0) {
+		return sel, nil
+	}
+
+	// Figure out which rules cannot be satisfied by any pair of artifacts. If
+	// each rule can be satisfied on its own, the rules can only not be
+	// satisfied in combination, so all of them are reported.
+	var unsatisfied []string
+	for i, r := range resolved {
+		if !anyVersionsMatch(r.rule, slots[r.source], slots[r.target]) {
+			unsatisfied = append(unsatisfied, describeMatchRule(i, r.rule))
+		}
+	}
+	if len(unsatisfied) == 0 {
+		for i, r := range resolved {
+			unsatisfied = append(unsatisfied, describeMatchRule(i, r.rule))
+		}
+	}
+	return nil, &unsatisfiedMatchRulesError{rules: unsatisfied}
+}
+
+// matchSlotsFor returns a matchSlot for each of the provided discovery results,
+// wired to record selections in the provided artifactSelection.
+func matchSlotsFor(
+	artifacts *kargoapi.DiscoveredArtifacts,
+	sel *artifactSelection,
+) []matchSlot {
+	slots := make(
+		[]matchSlot,
+		0,
+		len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts)+
+			len(artifacts.OCIArtifacts)+len(artifacts.Files),
+	)
+	for i, result := range artifacts.Git {
+		slots = append(slots, matchSlot{
+			kind:       kargoapi.FreightMatchArtifactKindGit,
+			repoURL:    result.RepoURL,
+			candidates: allIndices(len(result.Commits)),
+			versionOf: func(j int) string {
+				if tag := result.Commits[j].Tag; tag != "" {
+					return tag
+				}
+				return result.Commits[j].ID
+			},
+			selected: &sel.git[i],
+		})
+	}
+	for i, result := range artifacts.Images {
+		var candidates []int
+		for j, ref := range result.References {
+			if isVerifiedImageReference(ref) {
+				candidates = append(candidates, j)
+			}
+		}
+		slots = append(slots, matchSlot{
+			kind:       kargoapi.FreightMatchArtifactKindImage,
+			repoURL:    result.RepoURL,
+			candidates: candidates,
+			versionOf:  func(j int) string { return result.References[j].Tag },
+			selected:   &sel.images[i],
+		})
+	}
+	for i, result := range artifacts.Charts {
+		slots = append(slots, matchSlot{
+			kind:       kargoapi.FreightMatchArtifactKindChart,
+			repoURL:    result.RepoURL,
+			name:       result.Name,
+			candidates: allIndices(len(result.Versions)),
+			versionOf:  func(j int) string { return result.Versions[j] },
+			selected:   &sel.charts[i],
+		})
+	}
+	for i, result := range artifacts.OCIArtifacts {
+		slots = append(slots, matchSlot{
+			kind:       kargoapi.FreightMatchArtifactKindOCIArtifact,
+			repoURL:    result.RepoURL,
+			candidates: allIndices(len(result.References)),
+			versionOf:  func(j int) string { return result.References[j].Tag },
+			selected:   &sel.ociArtifacts[i],
+		})
+	}
+	for i, result := range artifacts.Files {
+		slots = append(slots, matchSlot{
+			kind:       kargoapi.FreightMatchArtifactKindFile,
+			repoURL:    result.RepoURL,
+			candidates: allIndices(len(result.Files)),
+			versionOf:  func(j int) string { return result.Files[j].Version },
+			selected:   &sel.files[i],
+		})
+	}
+	return slots
+}
+
+// findMatchSlot returns the index of the first slot referenced by the provided
+// FreightMatchArtifact, or -1 if there is no such slot.
+func findMatchSlot(slots []matchSlot, ref kargoapi.FreightMatchArtifact) int {
+	for i, slot := range slots {
+		if slot.kind == ref.Kind && slot.repoURL == ref.RepoURL && slot.name == ref.Name {
+			return i
+		}
+	}
+	return -1
+}
+
+// anyVersionsMatch returns true if any pair of candidates of the provided
+// slots satisfies the provided rule.
+func anyVersionsMatch(rule kargoapi.FreightMatchRule, source, target matchSlot) bool {
+	targetVersions := make(map[string]struct{}, len(target.candidates))
+	for _, candidate := range target.candidates {
+		targetVersions[matchVersion(rule.Target, target.versionOf(candidate))] = struct{}{}
+	}
+	for _, candidate := range source.candidates {
+		if _, ok := targetVersions[matchVersion(rule.Source, source.versionOf(candidate))]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchVersion returns the provided version with the prefix and suffix
+// specified by the provided FreightMatchArtifact removed.
+func matchVersion(ref kargoapi.FreightMatchArtifact, version string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(version, ref.TrimPrefix), ref.TrimSuffix)
+}
+
+// describeMatchRule returns a human-readable description of the rule at the
+// provided index.
+func describeMatchRule(i int, rule kargoapi.FreightMatchRule) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	return fmt.Sprintf(
+		"freightMatchRules[%d] (%s %s must match %s %s)",
+		i,
+		rule.Source.Kind,
+		rule.Source.RepoURL,
+		rule.Target.Kind,
+		rule.Target.RepoURL,
+	)
+}
+
+func allIndices(n int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
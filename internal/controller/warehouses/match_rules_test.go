@@ -0,0 +1,218 @@
+package warehouses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSelectArtifacts(t *testing.T) {
+	imageRef := kargoapi.FreightMatchArtifact{
+		Kind:    kargoapi.FreightMatchArtifactKindImage,
+		RepoURL: "fake-image-repo",
+	}
+	gitRef := kargoapi.FreightMatchArtifact{
+		Kind:    kargoapi.FreightMatchArtifactKindGit,
+		RepoURL: "fake-git-repo",
+	}
+	chartRef := kargoapi.FreightMatchArtifact{
+		Kind:    kargoapi.FreightMatchArtifactKindChart,
+		RepoURL: "fake-chart-repo",
+		Name:    "fake-chart",
+	}
+
+	testCases := []struct {
+		name       string
+		artifacts  *kargoapi.DiscoveredArtifacts
+		rules      []kargoapi.FreightMatchRule
+		assertions func(*testing.T, *artifactSelection, error)
+	}{
+		{
+			name: "no rules",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-git-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "a"}, {ID: "b"}},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{{
+					RepoURL: "fake-image-repo",
+					References: []kargoapi.DiscoveredImageReference{
+						{Tag: "unverified", VerificationFailure: "no signatures found"},
+						{Tag: "verified"},
+					},
+				}},
+				Charts: []kargoapi.ChartDiscoveryResult{{
+					RepoURL: "fake-chart-repo",
+				}},
+			},
+			assertions: func(t *testing.T, sel *artifactSelection, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []int{0}, sel.git)
+				require.Equal(t, []int{1}, sel.images)
+				require.Equal(t, []int{-1}, sel.charts)
+			},
+		},
+		{
+			name: "source not found",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-git-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "a"}},
+				}},
+			},
+			rules: []kargoapi.FreightMatchRule{{Source: imageRef, Target: gitRef}},
+			assertions: func(t *testing.T, _ *artifactSelection, err error) {
+				require.ErrorContains(t, err, "no discovered artifacts found for source")
+			},
+		},
+		{
+			name: "target not found",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Images: []kargoapi.ImageDiscoveryResult{{
+					RepoURL:    "fake-image-repo",
+					References: []kargoapi.DiscoveredImageReference{{Tag: "v1.0.0"}},
+				}},
+			},
+			rules: []kargoapi.FreightMatchRule{{Source: imageRef, Target: gitRef}},
+			assertions: func(t *testing.T, _ *artifactSelection, err error) {
+				require.ErrorContains(t, err, "no discovered artifacts found for target")
+			},
+		},
+		{
+			name: "newest matching combination is selected",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-git-repo",
+					Commits: []kargoapi.DiscoveredCommit{
+						{ID: "c", Tag: "v1.5.0"},
+						{ID: "b", Tag: "v1.4.0"},
+						{ID: "a", Tag: "v1.3.0"},
+					},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{
+					{
+						RepoURL: "fake-image-repo",
+						References: []kargoapi.DiscoveredImageReference{
+							{Tag: "v1.5.0", VerificationFailure: "no signatures found"},
+							{Tag: "v1.4.0"},
+							{Tag: "v1.3.0"},
+						},
+					},
+					{
+						RepoURL:    "unconstrained-image-repo",
+						References: []kargoapi.DiscoveredImageReference{{Tag: "latest"}},
+					},
+				},
+				Charts: []kargoapi.ChartDiscoveryResult{{
+					RepoURL:  "fake-chart-repo",
+					Name:     "fake-chart",
+					Versions: []string{"1.5.0", "1.4.0", "1.3.0"},
+				}},
+			},
+			rules: []kargoapi.FreightMatchRule{
+				{Source: imageRef, Target: gitRef},
+				{
+					Source: chartRef,
+					Target: kargoapi.FreightMatchArtifact{
+						Kind:       imageRef.Kind,
+						RepoURL:    imageRef.RepoURL,
+						TrimPrefix: "v",
+					},
+				},
+			},
+			assertions: func(t *testing.T, sel *artifactSelection, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []int{1}, sel.git)
+				require.Equal(t, []int{1, 0}, sel.images)
+				require.Equal(t, []int{1}, sel.charts)
+			},
+		},
+		{
+			name: "commit ID is used as version when there is no tag",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-git-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "def456"}, {ID: "abc123"}},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{{
+					RepoURL:    "fake-image-repo",
+					References: []kargoapi.DiscoveredImageReference{{Tag: "sha-abc123"}},
+				}},
+			},
+			rules: []kargoapi.FreightMatchRule{{
+				Source: kargoapi.FreightMatchArtifact{
+					Kind:       imageRef.Kind,
+					RepoURL:    imageRef.RepoURL,
+					TrimPrefix: "sha-",
+				},
+				Target: gitRef,
+			}},
+			assertions: func(t *testing.T, sel *artifactSelection, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []int{1}, sel.git)
+				require.Equal(t, []int{0}, sel.images)
+			},
+		},
+		{
+			name: "rule cannot be satisfied",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-git-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "a", Tag: "v1.5.0"}},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{{
+					RepoURL:    "fake-image-repo",
+					References: []kargoapi.DiscoveredImageReference{{Tag: "v1.4.0"}},
+				}},
+			},
+			rules: []kargoapi.FreightMatchRule{{Source: imageRef, Target: gitRef}},
+			assertions: func(t *testing.T, _ *artifactSelection, err error) {
+				var matchErr *unsatisfiedMatchRulesError
+				require.ErrorAs(t, err, &matchErr)
+				require.Equal(
+					t,
+					[]string{"freightMatchRules[0] (Image fake-image-repo must match Git fake-git-repo)"},
+					matchErr.rules,
+				)
+			},
+		},
+		{
+			name: "rules cannot be satisfied in combination",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-git-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "a", Tag: "1.5.0"}},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{{
+					RepoURL: "fake-image-repo",
+					References: []kargoapi.DiscoveredImageReference{
+						{Tag: "1.5.0"},
+						{Tag: "1.4.0"},
+					},
+				}},
+				Charts: []kargoapi.ChartDiscoveryResult{{
+					RepoURL:  "fake-chart-repo",
+					Name:     "fake-chart",
+					Versions: []string{"1.4.0"},
+				}},
+			},
+			rules: []kargoapi.FreightMatchRule{
+				{Name: "image matches git", Source: imageRef, Target: gitRef},
+				{Name: "chart matches image", Source: chartRef, Target: imageRef},
+			},
+			assertions: func(t *testing.T, _ *artifactSelection, err error) {
+				var matchErr *unsatisfiedMatchRulesError
+				require.ErrorAs(t, err, &matchErr)
+				require.Equal(t, []string{"image matches git", "chart matches image"}, matchErr.rules)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sel, err := selectArtifacts(testCase.artifacts, testCase.rules)
+			testCase.assertions(t, sel, err)
+		})
+	}
+}
@@ -2,9 +2,14 @@ package warehouses
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +22,8 @@ import (
 	"github.com/akuity/kargo/internal/controller"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/file"
+	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/helm"
 	"github.com/akuity/kargo/internal/image"
 	"github.com/akuity/kargo/internal/kargo"
@@ -24,6 +31,27 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// ReconcilerConfig represents configuration for the Warehouse reconciler.
+type ReconcilerConfig struct {
+	ShardName string `envconfig:"SHARD_NAME"`
+	// DiscoveryCacheDir is the path to a directory in which Git repository
+	// mirrors and image metadata are persisted between discovery runs and
+	// across controller restarts. If empty, nothing is persisted.
+	DiscoveryCacheDir string `envconfig:"DISCOVERY_CACHE_DIR"`
+	// DiscoveryCacheMaxIdle is the period after which Git repository mirrors
+	// that have not been used are evicted from the DiscoveryCacheDir. If zero,
+	// mirrors are never evicted.
+	DiscoveryCacheMaxIdle time.Duration `envconfig:"DISCOVERY_CACHE_MAX_IDLE" default:"168h"`
+}
+
+// ReconcilerConfigFromEnv returns a ReconcilerConfig populated from
+// environment variables.
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 // reconciler reconciles Warehouse resources.
 type reconciler struct {
 	client                     client.Client
@@ -38,13 +66,44 @@ type reconciler struct {
 
 	discoverImagesFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ImageDiscoveryResult, error)
 
-	discoverImageRefsFn func(context.Context, kargoapi.ImageSubscription, *image.Credentials) ([]image.Image, error)
+	discoverImageRefsFn func(
+		context.Context,
+		kargoapi.ImageSubscription,
+		*image.Credentials,
+		*image.VerificationOptions,
+	) ([]image.Image, error)
 
 	discoverChartsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ChartDiscoveryResult, error)
 
-	discoverChartVersionsFn func(context.Context, string, string, string, *helm.Credentials) ([]string, error)
+	discoverChartVersionsFn func(
+		context.Context,
+		string,
+		string,
+		*helm.Credentials,
+		*helm.DiscoveryOptions,
+	) ([]helm.ChartVersion, error)
+
+	discoverOCIArtifactsFn func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.OCIArtifactDiscoveryResult, error)
+
+	discoverOCIArtifactRefsFn func(
+		context.Context,
+		kargoapi.OCIArtifactSubscription,
+		*image.Credentials,
+	) ([]image.Artifact, error)
+
+	discoverFilesFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.FileDiscoveryResult, error)
+
+	selectFilesFn func(context.Context, kargoapi.FileSubscription, *file.Credentials) ([]file.File, error)
 
-	buildFreightFromLatestArtifactsFn func(string, *kargoapi.DiscoveredArtifacts) (*kargoapi.Freight, error)
+	buildFreightFromLatestArtifactsFn func(
+		string,
+		*kargoapi.DiscoveredArtifacts,
+		[]kargoapi.FreightMatchRule,
+	) (*kargoapi.Freight, error)
 
 	gitCloneFn func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error)
 
@@ -52,12 +111,27 @@ type reconciler struct {
 
 	listTagsFn func(repo git.Repo) ([]git.TagMetadata, error)
 
-	discoverBranchHistoryFn func(repo git.Repo, sub kargoapi.GitSubscription) ([]git.CommitMetadata, error)
+	discoverBranchHistoryFn func(
+		ctx context.Context,
+		repo git.Repo,
+		sub kargoapi.GitSubscription,
+	) ([]git.CommitMetadata, error)
 
 	discoverTagsFn func(repo git.Repo, sub kargoapi.GitSubscription) ([]git.TagMetadata, error)
 
 	getDiffPathsForCommitIDFn func(repo git.Repo, commitID string) ([]string, error)
 
+	discoverPullRequestsFn func(
+		ctx context.Context,
+		sub kargoapi.GitSubscription,
+		creds *git.RepoCredentials,
+	) ([]kargoapi.DiscoveredCommit, error)
+
+	newGitProviderServiceFn func(
+		repoURL string,
+		opts *gitprovider.GitProviderOptions,
+	) (gitprovider.GitProviderService, error)
+
 	createFreightFn func(context.Context, client.Object, ...client.CreateOption) error
 
 	patchStatusFn func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error
@@ -68,14 +142,31 @@ type reconciler struct {
 func SetupReconcilerWithManager(
 	mgr manager.Manager,
 	credentialsDB credentials.Database,
-	shardName string,
+	cfg ReconcilerConfig,
 ) error {
 
-	shardPredicate, err := controller.GetShardPredicate(shardName)
+	shardPredicate, err := controller.GetShardPredicate(cfg.ShardName)
 	if err != nil {
 		return fmt.Errorf("error creating shard selector predicate: %w", err)
 	}
 
+	r := newReconciler(mgr.GetClient(), credentialsDB)
+	if cfg.DiscoveryCacheDir != "" {
+		mirrorCache, err := git.NewMirrorCache(
+			filepath.Join(cfg.DiscoveryCacheDir, "git"),
+			cfg.DiscoveryCacheMaxIdle,
+		)
+		if err != nil {
+			return fmt.Errorf("error initializing Git mirror cache: %w", err)
+		}
+		r.gitCloneFn = mirrorCache.Clone
+		if err = image.EnablePersistentCache(
+			filepath.Join(cfg.DiscoveryCacheDir, "images"),
+		); err != nil {
+			return fmt.Errorf("error initializing image metadata cache: %w", err)
+		}
+	}
+
 	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&kargoapi.Warehouse{}).
 		WithEventFilter(
@@ -94,7 +185,7 @@ func SetupReconcilerWithManager(
 		).
 		WithEventFilter(shardPredicate).
 		WithOptions(controller.CommonOptions()).
-		Complete(newReconciler(mgr.GetClient(), credentialsDB)); err != nil {
+		Complete(r); err != nil {
 		return fmt.Errorf("error building Warehouse reconciler: %w", err)
 	}
 	return nil
@@ -108,7 +199,8 @@ func newReconciler(
 		client:                  kubeClient,
 		credentialsDB:           credentialsDB,
 		gitCloneFn:              git.Clone,
-		discoverChartVersionsFn: helm.DiscoverChartVersions,
+		newGitProviderServiceFn: gitprovider.NewGitProviderService,
+		discoverChartVersionsFn: helm.DiscoverCharts,
 		imageSourceURLFnsByBaseURL: map[string]func(string, string) string{
 			githubURLPrefix: getGithubImageSourceURL,
 		},
@@ -120,12 +212,17 @@ func newReconciler(
 	r.discoverImagesFn = r.discoverImages
 	r.discoverImageRefsFn = r.discoverImageRefs
 	r.discoverChartsFn = r.discoverCharts
+	r.discoverOCIArtifactsFn = r.discoverOCIArtifacts
+	r.discoverOCIArtifactRefsFn = r.discoverOCIArtifactRefs
+	r.discoverFilesFn = r.discoverFiles
+	r.selectFilesFn = r.selectFiles
 	r.buildFreightFromLatestArtifactsFn = r.buildFreightFromLatestArtifacts
 	r.listCommitsFn = r.listCommits
 	r.listTagsFn = r.listTags
 	r.discoverBranchHistoryFn = r.discoverBranchHistory
 	r.discoverTagsFn = r.discoverTags
 	r.getDiffPathsForCommitIDFn = r.getDiffPathsForCommitID
+	r.discoverPullRequestsFn = r.discoverPullRequests
 	r.patchStatusFn = r.patchStatus
 	return r
 }
@@ -310,7 +407,33 @@ func (r *reconciler) syncWarehouse(
 		)
 
 		// Build a Freight from the latest discovered artifacts.
-		freight, err := r.buildFreightFromLatestArtifactsFn(warehouse.Namespace, status.DiscoveredArtifacts)
+		freight, err := r.buildFreightFromLatestArtifactsFn(
+			warehouse.Namespace,
+			status.DiscoveredArtifacts,
+			warehouse.Spec.FreightMatchRules,
+		)
+		var matchErr *unsatisfiedMatchRulesError
+		if errors.As(err, &matchErr) {
+			// No combination of the discovered artifacts satisfies the
+			// Warehouse's Freight match rules. This is not an error we can
+			// recover from by retrying, so we only make it visible in the
+			// status and wait for new artifacts to be discovered.
+			conditions.Delete(&status, kargoapi.ConditionTypeReconciling)
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:   kargoapi.ConditionTypeReady,
+					Status: metav1.ConditionFalse,
+					Reason: "UnsatisfiedFreightMatchRules",
+					Message: fmt.Sprintf(
+						"No combination of discovered artifacts satisfies all Freight "+
+							"match rules. Unsatisfied rules: %s",
+						strings.Join(matchErr.rules, "; "),
+					),
+				},
+			)
+			return status, nil
+		}
 		if err != nil {
 			// Make the error visible in the status and mark the Warehouse as
 			// not ready.
@@ -404,49 +527,71 @@ func (r *reconciler) discoverArtifacts(
 		return nil, fmt.Errorf("error discovering charts: %w", err)
 	}
 
+	ociArtifacts, err := r.discoverOCIArtifactsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering OCI artifacts: %w", err)
+	}
+
+	files, err := r.discoverFilesFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering files: %w", err)
+	}
+
 	return &kargoapi.DiscoveredArtifacts{
 		DiscoveredAt: metav1.Now(),
 		Git:          commits,
 		Images:       images,
 		Charts:       charts,
+		OCIArtifacts: ociArtifacts,
+		Files:        files,
 	}, nil
 }
 
 func (r *reconciler) buildFreightFromLatestArtifacts(
 	namespace string,
 	artifacts *kargoapi.DiscoveredArtifacts,
+	rules []kargoapi.FreightMatchRule,
 ) (*kargoapi.Freight, error) {
 	if artifacts == nil {
 		return nil, fmt.Errorf("no artifacts discovered")
 	}
 
+	sel, err := selectArtifacts(artifacts, rules)
+	if err != nil {
+		return nil, err
+	}
+
 	freight := &kargoapi.Freight{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 		},
 	}
 
-	for _, result := range artifacts.Git {
+	for i, result := range artifacts.Git {
 		if len(result.Commits) == 0 {
 			return nil, fmt.Errorf("no commits discovered for repository %q", result.RepoURL)
 		}
-		latestCommit := result.Commits[0]
+		latestCommit := result.Commits[sel.git[i]]
 		freight.Commits = append(freight.Commits, kargoapi.GitCommit{
-			RepoURL:   result.RepoURL,
-			ID:        latestCommit.ID,
-			Branch:    latestCommit.Branch,
-			Tag:       latestCommit.Tag,
-			Message:   latestCommit.Subject,
-			Author:    latestCommit.Author,
-			Committer: latestCommit.Committer,
+			RepoURL:     result.RepoURL,
+			ID:          latestCommit.ID,
+			Branch:      latestCommit.Branch,
+			Tag:         latestCommit.Tag,
+			Message:     latestCommit.Subject,
+			Author:      latestCommit.Author,
+			Committer:   latestCommit.Committer,
+			PullRequest: latestCommit.PullRequest,
 		})
 	}
 
-	for _, result := range artifacts.Images {
+	for i, result := range artifacts.Images {
 		if len(result.References) == 0 {
 			return nil, fmt.Errorf("no images discovered for repository %q", result.RepoURL)
 		}
-		latestImage := result.References[0]
+		if sel.images[i] < 0 {
+			return nil, fmt.Errorf("no verified images discovered for repository %q", result.RepoURL)
+		}
+		latestImage := result.References[sel.images[i]]
 		freight.Images = append(freight.Images, kargoapi.Image{
 			RepoURL:    result.RepoURL,
 			GitRepoURL: latestImage.GitRepoURL,
@@ -455,7 +600,7 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		})
 	}
 
-	for _, result := range artifacts.Charts {
+	for i, result := range artifacts.Charts {
 		if len(result.Versions) == 0 {
 			return nil, fmt.Errorf(
 				"no versions discovered for chart %q from repository %q",
@@ -463,11 +608,45 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 				result.Name,
 			)
 		}
-		latestChart := result.Versions[0]
-		freight.Charts = append(freight.Charts, kargoapi.Chart{
+		idx := sel.charts[i]
+		chart := kargoapi.Chart{
 			RepoURL: result.RepoURL,
 			Name:    result.Name,
-			Version: latestChart,
+			Version: result.Versions[idx],
+		}
+		// References are parallel to Versions, but may be absent for results
+		// recorded before they were introduced.
+		if idx < len(result.References) {
+			chart.AppVersion = result.References[idx].AppVersion
+			chart.Digest = result.References[idx].Digest
+		}
+		freight.Charts = append(freight.Charts, chart)
+	}
+
+	for i, result := range artifacts.OCIArtifacts {
+		if len(result.References) == 0 {
+			return nil, fmt.Errorf("no artifacts discovered for repository %q", result.RepoURL)
+		}
+		latestArtifact := result.References[sel.ociArtifacts[i]]
+		freight.Artifacts = append(freight.Artifacts, kargoapi.OCIArtifact{
+			RepoURL:      result.RepoURL,
+			Tag:          latestArtifact.Tag,
+			Digest:       latestArtifact.Digest,
+			ArtifactType: latestArtifact.ArtifactType,
+		})
+	}
+
+	for i, result := range artifacts.Files {
+		if len(result.Files) == 0 {
+			return nil, fmt.Errorf("no files discovered for %q", result.RepoURL)
+		}
+		latestFile := result.Files[sel.files[i]]
+		freight.Files = append(freight.Files, kargoapi.File{
+			RepoURL:  result.RepoURL,
+			URL:      latestFile.URL,
+			Version:  latestFile.Version,
+			ETag:     latestFile.ETag,
+			Checksum: latestFile.Checksum,
 		})
 	}
 
@@ -477,6 +656,13 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 	return freight, nil
 }
 
+// isVerifiedImageReference returns true if the provided image reference did not
+// fail signature verification and is therefore eligible to become part of
+// Freight. It returns false otherwise.
+func isVerifiedImageReference(ref kargoapi.DiscoveredImageReference) bool {
+	return ref.VerificationFailure == ""
+}
+
 func (r *reconciler) patchStatus(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
@@ -494,7 +680,9 @@ func validateDiscoveredArtifacts(
 ) bool {
 	artifacts := newStatus.DiscoveredArtifacts
 
-	if artifacts == nil || len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts) == 0 {
+	if artifacts == nil ||
+		len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts)+
+			len(artifacts.OCIArtifacts)+len(artifacts.Files) == 0 {
 		message := "No artifacts discovered"
 		conditions.Set(
 			newStatus,
@@ -573,6 +761,31 @@ func validateDiscoveredArtifacts(
 			return false
 		}
 
+		if !slices.ContainsFunc(artifact.References, isVerifiedImageReference) {
+			message := fmt.Sprintf(
+				"No references discovered for image repository %q passed verification",
+				artifact.RepoURL,
+			)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoVerifiedImageReferencesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingVerifiedImageReferences",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
 		subscriptions++
 		images += count
 	}
@@ -612,6 +825,66 @@ func validateDiscoveredArtifacts(
 		charts += count
 	}
 
+	var ociArtifacts int
+	for _, artifact := range artifacts.OCIArtifacts {
+		count := len(artifact.References)
+
+		if count == 0 {
+			message := fmt.Sprintf("No references discovered for artifact repository %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoOCIArtifactReferencesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingOCIArtifactReferences",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		ociArtifacts += count
+	}
+
+	var files int
+	for _, artifact := range artifacts.Files {
+		count := len(artifact.Files)
+
+		if count == 0 {
+			message := fmt.Sprintf("No files discovered for %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoFilesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingFiles",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		files += count
+	}
+
 	var parts []string
 	if commits > 0 {
 		parts = append(parts, fmt.Sprintf("%d commits", commits))
@@ -622,6 +895,12 @@ func validateDiscoveredArtifacts(
 	if charts > 0 {
 		parts = append(parts, fmt.Sprintf("%d charts", charts))
 	}
+	if ociArtifacts > 0 {
+		parts = append(parts, fmt.Sprintf("%d artifacts", ociArtifacts))
+	}
+	if files > 0 {
+		parts = append(parts, fmt.Sprintf("%d files", files))
+	}
 
 	var message string
 	if len(parts) == 1 {
@@ -33,12 +33,18 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.discoverCommitsFn)
 	require.NotNil(t, e.discoverImagesFn)
 	require.NotNil(t, e.discoverChartsFn)
+	require.NotNil(t, e.discoverOCIArtifactsFn)
+	require.NotNil(t, e.discoverOCIArtifactRefsFn)
+	require.NotNil(t, e.discoverFilesFn)
+	require.NotNil(t, e.selectFilesFn)
 	require.NotNil(t, e.buildFreightFromLatestArtifactsFn)
 	require.NotNil(t, e.listCommitsFn)
 	require.NotNil(t, e.listTagsFn)
 	require.NotNil(t, e.discoverBranchHistoryFn)
 	require.NotNil(t, e.discoverTagsFn)
 	require.NotNil(t, e.getDiffPathsForCommitIDFn)
+	require.NotNil(t, e.discoverPullRequestsFn)
+	require.NotNil(t, e.newGitProviderServiceFn)
 	require.NotNil(t, e.createFreightFn)
 	require.NotNil(t, e.patchStatusFn)
 }
@@ -147,6 +153,7 @@ func TestSyncWarehouse(t *testing.T) {
 				buildFreightFromLatestArtifactsFn: func(
 					string,
 					*kargoapi.DiscoveredArtifacts,
+					[]kargoapi.FreightMatchRule,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -193,6 +200,7 @@ func TestSyncWarehouse(t *testing.T) {
 				buildFreightFromLatestArtifactsFn: func(
 					string,
 					*kargoapi.DiscoveredArtifacts,
+					[]kargoapi.FreightMatchRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -248,6 +256,7 @@ func TestSyncWarehouse(t *testing.T) {
 				buildFreightFromLatestArtifactsFn: func(
 					string,
 					*kargoapi.DiscoveredArtifacts,
+					[]kargoapi.FreightMatchRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -302,6 +311,7 @@ func TestSyncWarehouse(t *testing.T) {
 				buildFreightFromLatestArtifactsFn: func(
 					string,
 					*kargoapi.DiscoveredArtifacts,
+					[]kargoapi.FreightMatchRule,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -346,6 +356,61 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "unsatisfied Freight match rules",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+					[]kargoapi.FreightMatchRule,
+				) (*kargoapi.Freight, error) {
+					return nil, &unsatisfiedMatchRulesError{rules: []string{"fake-rule"}}
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.Fail(t, "should not create Freight")
+					return nil
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, status.DiscoveredArtifacts)
+				require.Empty(t, status.LastFreightID)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				// Ensure that the Ready condition is set to False.
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "UnsatisfiedFreightMatchRules", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "fake-rule")
+
+				// Ensure that the Healthy condition is set to True.
+				healthyCondition := conditions.Get(&status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionTrue, healthyCondition.Status)
+			},
+		},
+
 		{
 			name: "manual Freight creation",
 			reconciler: &reconciler{
@@ -571,6 +636,80 @@ func TestDiscoverArtifacts(t *testing.T) {
 				require.Nil(t, discoveredArtifacts)
 			},
 		},
+		{
+			name: "error discovering OCI artifacts",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering OCI artifacts")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
+		{
+			name: "error discovering files",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{}, nil
+				},
+				discoverFilesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.FileDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering files")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -604,12 +743,34 @@ func TestDiscoverArtifacts(t *testing.T) {
 						}},
 					}, nil
 				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "fake-tag"},
+						}},
+					}, nil
+				},
+				discoverFilesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.FileDiscoveryResult, error) {
+					return []kargoapi.FileDiscoveryResult{
+						{RepoURL: "s3://fake-bucket/", Files: []kargoapi.DiscoveredFile{
+							{URL: "s3://fake-bucket/fake-file"},
+						}},
+					}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.NoError(t, err)
 				require.Len(t, discoveredArtifacts.Git, 1)
 				require.Len(t, discoveredArtifacts.Images, 1)
 				require.Len(t, discoveredArtifacts.Charts, 1)
+				require.Len(t, discoveredArtifacts.OCIArtifacts, 1)
+				require.Len(t, discoveredArtifacts.Files, 1)
 			},
 		},
 	}
@@ -629,6 +790,7 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 	testCases := []struct {
 		name       string
 		artifacts  *kargoapi.DiscoveredArtifacts
+		rules      []kargoapi.FreightMatchRule
 		assertions func(*testing.T, *kargoapi.Freight, error)
 	}{
 		{
@@ -666,6 +828,43 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Nil(t, freight)
 			},
 		},
+		{
+			name: "no verified images discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Images: []kargoapi.ImageDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						References: []kargoapi.DiscoveredImageReference{
+							{Tag: "fake-tag", VerificationFailure: "no signatures found"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no verified images discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "skips images that failed verification",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Images: []kargoapi.ImageDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						References: []kargoapi.DiscoveredImageReference{
+							{Tag: "unsigned-tag", VerificationFailure: "no signatures found"},
+							{Tag: "signed-tag"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+				require.Len(t, freight.Images, 1)
+				require.Equal(t, "signed-tag", freight.Images[0].Tag)
+			},
+		},
 		{
 			name: "no charts discovered",
 			artifacts: &kargoapi.DiscoveredArtifacts{
@@ -684,6 +883,30 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Nil(t, freight)
 			},
 		},
+		{
+			name: "no OCI artifacts discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no artifacts discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "no files discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Files: []kargoapi.FileDiscoveryResult{
+					{RepoURL: "s3://fake-bucket/", Files: []kargoapi.DiscoveredFile{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no files discovered for")
+				require.Nil(t, freight)
+			},
+		},
 		{
 			name: "success",
 			artifacts: &kargoapi.DiscoveredArtifacts{
@@ -697,7 +920,31 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				},
 				Charts: []kargoapi.ChartDiscoveryResult{
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
-					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
+					{
+						RepoURL:  "other-fake-repo",
+						Versions: []string{"fake-version"},
+						References: []kargoapi.DiscoveredChartReference{{
+							Version:    "fake-version",
+							AppVersion: "fake-app-version",
+							Digest:     "fake-digest",
+						}},
+					},
+				},
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{
+						{Tag: "fake-tag", Digest: "fake-digest", ArtifactType: "fake-type"},
+					}},
+				},
+				Files: []kargoapi.FileDiscoveryResult{
+					{RepoURL: "s3://fake-bucket/", Files: []kargoapi.DiscoveredFile{
+						{
+							URL:      "s3://fake-bucket/app-1.1.0.tgz",
+							Version:  "1.1.0",
+							ETag:     "fake-etag",
+							Checksum: "sha256:fake-checksum",
+						},
+						{URL: "s3://fake-bucket/app-1.0.0.tgz", Version: "1.0.0", ETag: "other-etag"},
+					}},
 				},
 			},
 			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
@@ -705,7 +952,95 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.NotNil(t, freight)
 				require.Len(t, freight.Commits, 2)
 				require.Len(t, freight.Images, 2)
-				require.Len(t, freight.Charts, 2)
+				require.Equal(t, []kargoapi.Chart{
+					{RepoURL: "fake-repo", Version: "fake-version"},
+					{
+						RepoURL:    "other-fake-repo",
+						Version:    "fake-version",
+						AppVersion: "fake-app-version",
+						Digest:     "fake-digest",
+					},
+				}, freight.Charts)
+				require.Equal(t, []kargoapi.OCIArtifact{{
+					RepoURL:      "fake-repo",
+					Tag:          "fake-tag",
+					Digest:       "fake-digest",
+					ArtifactType: "fake-type",
+				}}, freight.Artifacts)
+				require.Equal(t, []kargoapi.File{{
+					RepoURL:  "s3://fake-bucket/",
+					URL:      "s3://fake-bucket/app-1.1.0.tgz",
+					Version:  "1.1.0",
+					ETag:     "fake-etag",
+					Checksum: "sha256:fake-checksum",
+				}}, freight.Files)
+			},
+		},
+		{
+			name: "success with Freight match rules",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{
+					{RepoURL: "fake-git-repo", Commits: []kargoapi.DiscoveredCommit{
+						{ID: "fake-commit-3", Tag: "v1.5.0"},
+						{ID: "fake-commit-2", Tag: "v1.4.0"},
+					}},
+				},
+				Images: []kargoapi.ImageDiscoveryResult{
+					{RepoURL: "fake-image-repo", References: []kargoapi.DiscoveredImageReference{
+						{Tag: "v1.4.0"},
+						{Tag: "v1.3.0"},
+					}},
+				},
+			},
+			rules: []kargoapi.FreightMatchRule{{
+				Source: kargoapi.FreightMatchArtifact{
+					Kind:    kargoapi.FreightMatchArtifactKindImage,
+					RepoURL: "fake-image-repo",
+				},
+				Target: kargoapi.FreightMatchArtifact{
+					Kind:    kargoapi.FreightMatchArtifactKindGit,
+					RepoURL: "fake-git-repo",
+				},
+			}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+				require.Len(t, freight.Commits, 1)
+				require.Equal(t, "fake-commit-2", freight.Commits[0].ID)
+				require.Len(t, freight.Images, 1)
+				require.Equal(t, "v1.4.0", freight.Images[0].Tag)
+			},
+		},
+		{
+			name: "unsatisfied Freight match rules",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{
+					{RepoURL: "fake-git-repo", Commits: []kargoapi.DiscoveredCommit{
+						{ID: "fake-commit", Tag: "v1.5.0"},
+					}},
+				},
+				Images: []kargoapi.ImageDiscoveryResult{
+					{RepoURL: "fake-image-repo", References: []kargoapi.DiscoveredImageReference{
+						{Tag: "v1.4.0"},
+					}},
+				},
+			},
+			rules: []kargoapi.FreightMatchRule{{
+				Name: "image matches config",
+				Source: kargoapi.FreightMatchArtifact{
+					Kind:    kargoapi.FreightMatchArtifactKindImage,
+					RepoURL: "fake-image-repo",
+				},
+				Target: kargoapi.FreightMatchArtifact{
+					Kind:    kargoapi.FreightMatchArtifactKindGit,
+					RepoURL: "fake-git-repo",
+				},
+			}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "image matches config")
+				var matchErr *unsatisfiedMatchRulesError
+				require.ErrorAs(t, err, &matchErr)
+				require.Nil(t, freight)
 			},
 		},
 	}
@@ -715,6 +1050,7 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 			freight, err := (&reconciler{}).buildFreightFromLatestArtifacts(
 				"fake-namespace",
 				testCase.artifacts,
+				testCase.rules,
 			)
 			testCase.assertions(t, freight, err)
 		})
@@ -820,6 +1156,43 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
 		},
+		{
+			name: "image repository with no verified references",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Images: []kargoapi.ImageDiscoveryResult{
+						{
+							RepoURL: "docker.io/example/image",
+							References: []kargoapi.DiscoveredImageReference{
+								{Tag: "v1.0.0", VerificationFailure: "no signatures found"},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingVerifiedImageReferences", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "passed verification")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoVerifiedImageReferencesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "passed verification")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
 		{
 			name: "chart repository with no versions",
 			warehouse: &kargoapi.Warehouse{
@@ -852,6 +1225,70 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
 		},
+		{
+			name: "artifact repository with no references",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/artifact"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingOCIArtifactReferences", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No references discovered for artifact repository")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoOCIArtifactReferencesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No references discovered for artifact repository")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
+		{
+			name: "file subscription with no files",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Files: []kargoapi.FileDiscoveryResult{
+						{RepoURL: "s3://example-bucket/app/"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingFiles", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No files discovered for")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoFilesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No files discovered for")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
 		{
 			name: "successful discovery with all artifact types",
 			warehouse: &kargoapi.Warehouse{
@@ -875,6 +1312,17 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 					Charts: []kargoapi.ChartDiscoveryResult{
 						{RepoURL: "https://charts.example.com", Name: "mychart", Versions: []string{"1.0.0", "1.1.0"}},
 					},
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/artifact", References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "1.0.0"},
+						}},
+					},
+					Files: []kargoapi.FileDiscoveryResult{
+						{RepoURL: "s3://example-bucket/app/", Files: []kargoapi.DiscoveredFile{
+							{URL: "s3://example-bucket/app/app-1.0.0.tgz"},
+							{URL: "s3://example-bucket/app/app-0.9.0.tgz"},
+						}},
+					},
 				},
 			},
 			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
@@ -889,7 +1337,7 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Contains(
 					t,
 					healthyCondition.Message,
-					"Successfully discovered 3 commits, 2 images, and 2 charts from 4 subscriptions",
+					"Successfully discovered 3 commits, 2 images, 2 charts, 1 artifacts, and 2 files from 6 subscriptions",
 				)
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
@@ -22,6 +22,11 @@ func (t Type) String() string {
 }
 
 const (
+	// TypeFile represents credentials for a location from which files are
+	// served, e.g. a bucket in an S3-compatible object store. For S3-compatible
+	// object stores, the username and password are used as the access key ID
+	// and secret access key, respectively.
+	TypeFile Type = "file"
 	// TypeGit represents credentials for a Git repository.
 	TypeGit Type = "git"
 	// TypeHelm represents credentials for a Helm chart repository.
@@ -1,6 +1,7 @@
 package directives
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 
@@ -16,6 +17,11 @@ import (
 type StepContext struct {
 	// WorkDir is the root directory for the execution of a step.
 	WorkDir string
+	// DryRun indicates that the Promotion is a dry run. Steps executed as part
+	// of a dry run must not make any changes outside of the WorkDir. Instead,
+	// they should describe the changes they would have made in the Plan of
+	// their Result.
+	DryRun bool
 	// SharedState is the state shared between steps.
 	SharedState State
 	// Alias is the alias of the step that is currently being executed.
@@ -77,8 +83,8 @@ type StepContext struct {
 }
 
 // State is a type that represents shared state between steps.
-// It is not safe for concurrent use at present, as we expect steps to
-// be executed sequentially.
+// It is not safe for concurrent use. Steps that are executed concurrently,
+// as part of a step group, are each given their own copy.
 type State map[string]any
 
 // Set stores a value in the shared state.
@@ -97,10 +103,99 @@ func (s *State) DeepCopy() State {
 	if s == nil {
 		return nil
 	}
-	// TODO(hidde): we piggyback on the runtime package for now, as we expect
-	// the configuration to originate from a Kubernetes API object. We should
-	// consider writing our own implementation in the future.
-	return runtime.DeepCopyJSON(*s)
+	// Unlike Config, State may contain the (nested) State output of previous
+	// steps, which runtime.DeepCopyJSON does not know how to copy.
+	return deepCopyStateValue(*s).(State) // nolint: forcetypeassert
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It restores a State that was
+// persisted as JSON by a previous execution of steps. The top-level values
+// of the State are the outputs of steps and are restored as States, while
+// integral numbers are restored as int64 values, as these are the types that
+// steps produce and expect to find in the shared state.
+func (s *State) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var m map[string]any
+	if err := dec.Decode(&m); err != nil {
+		return err
+	}
+	if m == nil {
+		*s = nil
+		return nil
+	}
+	state := make(State, len(m))
+	for key, val := range m {
+		val = normalizeJSONValue(val)
+		if output, ok := val.(map[string]any); ok {
+			val = State(output)
+		}
+		state[key] = val
+	}
+	*s = state
+	return nil
+}
+
+// normalizeJSONValue recursively converts the json.Number values contained
+// by the provided value into int64 or float64 values.
+func normalizeJSONValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for key, val := range v {
+			v[key] = normalizeJSONValue(val)
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = normalizeJSONValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// deepCopyStateValue returns a deep copy of the provided value. Nested States,
+// maps and slices are copied recursively, while any other value is assumed to
+// be an immutable JSON-compatible value and is returned as-is.
+func deepCopyStateValue(v any) any {
+	switch v := v.(type) {
+	case State:
+		if v == nil {
+			return State(nil)
+		}
+		c := make(State, len(v))
+		for key, val := range v {
+			c[key] = deepCopyStateValue(val)
+		}
+		return c
+	case map[string]any:
+		if v == nil {
+			return map[string]any(nil)
+		}
+		c := make(map[string]any, len(v))
+		for key, val := range v {
+			c[key] = deepCopyStateValue(val)
+		}
+		return c
+	case []any:
+		if v == nil {
+			return []any(nil)
+		}
+		c := make([]any, len(v))
+		for i, val := range v {
+			c[i] = deepCopyStateValue(val)
+		}
+		return c
+	default:
+		return v
+	}
 }
 
 // Config is a map of configuration values that can be passed to a step.
@@ -142,6 +237,10 @@ type Result struct {
 	Status Status
 	// Output is the output of the directive execution.
 	Output State
+	// Plan describes the changes the directive would have made had the
+	// Promotion not been a dry run. It is only set by directives executed as
+	// part of a dry run.
+	Plan *kargoapi.PromotionPlan
 }
 
 // Directive is an interface that a directive must implement. A directive is
@@ -1,6 +1,7 @@
 package directives
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -156,6 +157,102 @@ func TestState_Get(t *testing.T) {
 	}
 }
 
+func TestState_DeepCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		state      State
+		assertions func(*testing.T, State, State)
+	}{
+		{
+			name:  "nil state",
+			state: nil,
+			assertions: func(t *testing.T, _, copied State) {
+				assert.Nil(t, copied)
+			},
+		},
+		{
+			name: "nested step output",
+			state: State{
+				"key1": "value1",
+				"fake-step": State{
+					"branch":   "fake-branch",
+					"prNumber": int64(42),
+					"list":     []any{"a", map[string]any{"b": "c"}},
+				},
+			},
+			assertions: func(t *testing.T, original, copied State) {
+				assert.Equal(t, original, copied)
+
+				originalOutput := original["fake-step"].(State) // nolint: forcetypeassert
+				copiedOutput, ok := copied["fake-step"].(State)
+				assert.True(t, ok, "Expected nested State to retain its type")
+
+				originalOutput["branch"] = "modified"
+				assert.Equal(t, "fake-branch", copiedOutput["branch"])
+
+				originalList := originalOutput["list"].([]any) // nolint: forcetypeassert
+				originalList[1].(map[string]any)["b"] = "modified"
+				assert.Equal(
+					t,
+					[]any{"a", map[string]any{"b": "c"}},
+					copiedOutput["list"],
+				)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.assertions(t, tt.state, tt.state.DeepCopy())
+		})
+	}
+}
+
+func TestState_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		assertions func(*testing.T, State, error)
+	}{
+		{
+			name: "invalid JSON",
+			data: `[]`,
+			assertions: func(t *testing.T, _ State, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "null",
+			data: `null`,
+			assertions: func(t *testing.T, state State, err error) {
+				assert.NoError(t, err)
+				assert.Nil(t, state)
+			},
+		},
+		{
+			name: "step outputs",
+			data: `{"key1":"value1","open-pr":{"prNumber":42,"ratio":0.5,"list":[1,{"a":2}]}}`,
+			assertions: func(t *testing.T, state State, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, State{
+					"key1": "value1",
+					"open-pr": State{
+						"prNumber": int64(42),
+						"ratio":    0.5,
+						"list":     []any{int64(1), map[string]any{"a": int64(2)}},
+					},
+				}, state)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var state State
+			err := json.Unmarshal([]byte(tt.data), &state)
+			tt.assertions(t, state, err)
+		})
+	}
+}
+
 func TestConfig_DeepCopy(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -2,11 +2,19 @@ package directives
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"os"
+	"strconv"
+	"time"
 
+	"golang.org/x/sync/errgroup"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -19,6 +27,112 @@ type Step struct {
 	Alias string
 	// Config is a map of configuration values that can be passed to the step.
 	Config Config
+	// Timeout is the maximum duration of a single attempt at executing the
+	// step. A zero value means that attempts are not limited in duration.
+	Timeout time.Duration
+	// Retries is the number of times a failed attempt at executing the step
+	// is retried before the step is considered to have failed.
+	Retries int64
+	// RetryBackoff is the duration to wait before the first retry of a failed
+	// attempt. The duration is doubled for every subsequent retry. The Engine
+	// does not wait itself, but reports the step as pending until its next
+	// attempt is due.
+	RetryBackoff time.Duration
+	// ContinueOnError indicates whether execution should continue with the
+	// next step when the step has failed.
+	ContinueOnError bool
+	// Steps are the child steps of a step group. When non-empty, the step is a
+	// group and, instead of executing a directive, its child steps are executed
+	// concurrently. The Directive, Config, Timeout and Retries of a group are
+	// ignored, while its ContinueOnError applies to the failure of any of its
+	// child steps.
+	Steps []Step
+	// MaxConcurrency is the maximum number of child steps of a step group that
+	// are executed concurrently. A zero value means that all child steps are
+	// executed concurrently.
+	MaxConcurrency int64
+}
+
+// PromotionContext is the context of the Promotion on whose behalf the Engine
+// executes a list of Steps. Its fields are made available to each Step through
+// its StepContext.
+type PromotionContext struct {
+	// Project is the Project that the Promotion is associated with.
+	Project string
+	// Stage is the Stage that the Promotion is targeting.
+	Stage string
+	// FreightRequests is the list of Freight from various origins that is
+	// requested by the Stage targeted by the Promotion.
+	FreightRequests []kargoapi.FreightRequest
+	// Freight is the collection of all Freight referenced by the Promotion.
+	Freight kargoapi.FreightCollection
+	// Vars is the list of variables declared by the Promotion. They may be
+	// referenced by expressions in the Config of each Step.
+	Vars []kargoapi.PromotionVariable
+	// WorkDir is the working directory in which the Steps are executed. For a
+	// resumed execution to find the files left behind by previously executed
+	// Steps, the caller must ensure it persists between executions of the same
+	// Promotion, and remove it once the Promotion has finished. If empty, a
+	// temporary directory is created for the duration of a single execution.
+	WorkDir string
+	// StartFromStep is the index of the Step from which execution should
+	// (re)start. Steps with a lower index are assumed to have been executed
+	// successfully by a previous execution.
+	StartFromStep int64
+	// State is the shared state accumulated by the Steps that were executed
+	// by a previous execution. It is used to initialize the shared state of a
+	// resumed execution.
+	State State
+	// DryRun indicates that the Promotion is a dry run, in which case the Steps
+	// describe the changes they would make instead of making them.
+	DryRun bool
+}
+
+// StepResult is the outcome of the execution of a single Step by the Engine.
+type StepResult struct {
+	// Directive is the name of the directive that executed the Step.
+	Directive string
+	// Alias is the alias of the Step, if it has one.
+	Alias string
+	// Result is the Result returned by the directive.
+	Result Result
+	// Err is the error returned by the directive, if any. If the Step was
+	// attempted multiple times, this is the error of the last attempt.
+	Err error
+	// Attempts is the number of times the execution of the Step was
+	// attempted.
+	Attempts int64
+	// NextAttempt is the time at which the Step is to be attempted again. It
+	// is only set if the last attempt failed and is to be retried, in which
+	// case the Result has a Status of StatusPending and Err is the error of
+	// the last attempt.
+	NextAttempt time.Time
+}
+
+// awaitingRetry returns true if the Step failed, but is to be attempted again
+// once its NextAttempt is due.
+func (s StepResult) awaitingRetry() bool {
+	return !s.NextAttempt.IsZero()
+}
+
+// ExecutionResult is the outcome of the execution of a list of Steps by the
+// Engine.
+type ExecutionResult struct {
+	// Status is the high-level outcome of the execution. It is the Status of
+	// the last Step that was executed, or StatusFailure if the execution could
+	// not be started.
+	Status Status
+	// StepResults holds the outcome of every Step that was executed, in the
+	// order of execution. The outcome of a step group is described by the
+	// StepResults of its child Steps, in order of declaration.
+	StepResults []StepResult
+	// CurrentStep is the index of the last Step that was executed. When the
+	// Status is StatusPending, execution should be resumed from this Step.
+	CurrentStep int64
+	// State is the shared state as accumulated by all executed Steps. When the
+	// Status is StatusPending, it should be used to initialize the shared state
+	// of the resumed execution.
+	State State
 }
 
 // Engine is a simple engine that executes a list of directives in sequence.
@@ -44,56 +158,523 @@ func NewEngine(
 	}
 }
 
-// Execute runs the provided list of directives in sequence.
-func (e *Engine) Execute(ctx context.Context, steps []Step) (Status, error) {
-	// TODO(hidde): allow the workDir to be restored from a previous execution.
-	workDir, err := os.MkdirTemp("", "run-")
-	if err != nil {
-		return StatusFailure, fmt.Errorf("temporary working directory creation failed: %w", err)
+// Execute runs the provided list of directives in sequence on behalf of the
+// Promotion described by the provided PromotionContext, starting from the Step
+// at index PromotionContext.StartFromStep. Execution stops at the first Step
+// that does not succeed. The returned ExecutionResult always describes the
+// Steps that were executed, even when an error is returned.
+func (e *Engine) Execute(
+	ctx context.Context,
+	promoCtx PromotionContext,
+	steps []Step,
+) (ExecutionResult, error) {
+	if promoCtx.StartFromStep < 0 || promoCtx.StartFromStep > int64(len(steps)) {
+		return ExecutionResult{Status: StatusFailure}, fmt.Errorf(
+			"invalid step index %d to start from: Promotion has %d steps",
+			promoCtx.StartFromStep, len(steps),
+		)
+	}
+
+	workDir := promoCtx.WorkDir
+	if workDir == "" {
+		var err error
+		if workDir, err = os.MkdirTemp("", "run-"); err != nil {
+			return ExecutionResult{Status: StatusFailure},
+				fmt.Errorf("temporary working directory creation failed: %w", err)
+		}
+		defer os.RemoveAll(workDir)
 	}
-	defer os.RemoveAll(workDir)
 
-	// Initialize the shared state that will be passed to each step.
-	state := make(State)
+	// Initialize the shared state that will be passed to each step, restoring
+	// any state left behind by a previous execution.
+	state := promoCtx.State.DeepCopy()
+	if state == nil {
+		state = make(State)
+	}
+
+	execResult := ExecutionResult{
+		Status:      StatusSuccess,
+		StepResults: make([]StepResult, 0, len(steps)),
+		CurrentStep: promoCtx.StartFromStep,
+		State:       state,
+	}
+
+	for i := promoCtx.StartFromStep; i < int64(len(steps)); i++ {
+		d := steps[i]
+		execResult.CurrentStep = i
 
-	for _, d := range steps {
 		select {
 		case <-ctx.Done():
-			return StatusFailure, ctx.Err()
+			execResult.Status = StatusFailure
+			return execResult, ctx.Err()
 		default:
-			reg, err := e.registry.GetDirectiveRegistration(d.Directive)
-			if err != nil {
-				return StatusFailure, fmt.Errorf("failed to get step %q: %w", d.Directive, err)
-			}
-
-			stateCopy := state.DeepCopy()
+		}
 
-			stepCtx := &StepContext{
-				WorkDir:     workDir,
-				SharedState: stateCopy,
-				Alias:       d.Alias,
-				Config:      d.Config.DeepCopy(),
+		if len(d.Steps) > 0 {
+			groupRes, err := e.executeStepGroup(ctx, promoCtx, workDir, state, d, i)
+			execResult.StepResults = append(execResult.StepResults, groupRes.StepResults...)
+			delete(state, stepGroupProgressKey(i))
+			for j := range d.Steps {
+				delete(state, stepRetryKey(i, int64(j)))
 			}
-			// Selectively provide these capabilities via the StepContext.
-			if reg.Permissions.AllowCredentialsDB {
-				stepCtx.CredentialsDB = e.credentialsDB
-			}
-			if reg.Permissions.AllowKargoClient {
-				stepCtx.KargoClient = e.kargoClient
+			// The outputs of the child steps that succeeded are retained even
+			// if others failed, so that subsequent steps can make use of them
+			// when the group is allowed to fail.
+			maps.Copy(state, groupRes.State)
+			if err != nil {
+				if d.ContinueOnError {
+					continue
+				}
+				execResult.Status = StatusFailure
+				return execResult, err
 			}
-			if reg.Permissions.AllowArgoCDClient {
-				stepCtx.ArgoCDClient = e.argoCDClient
+			if groupRes.Status == StatusPending {
+				execResult.Status = StatusPending
+				return execResult, nil
 			}
+			continue
+		}
 
-			result, err := reg.Directive.Run(ctx, stepCtx)
-			if err != nil {
-				return result.Status, fmt.Errorf("failed to run step %q: %w", d.Directive, err)
+		retryKey := stepRetryKey(i)
+		directive, stepCtx, err := e.prepareStep(ctx, promoCtx, workDir, state, d)
+		var retry *stepRetryRecord
+		if err == nil {
+			retry, err = getStepRetry(state, retryKey)
+		}
+		if err != nil {
+			execResult.Status = StatusFailure
+			execResult.StepResults = append(execResult.StepResults, StepResult{
+				Directive: d.Directive,
+				Alias:     d.Alias,
+				Result:    Result{Status: StatusFailure},
+				Err:       err,
+			})
+			return execResult, err
+		}
+
+		stepRes := runStep(ctx, d, directive, stepCtx, retry)
+		execResult.StepResults = append(execResult.StepResults, stepRes)
+		delete(state, retryKey)
+		if stepRes.awaitingRetry() {
+			// The step failed, but is to be attempted again. Execution cannot
+			// proceed past this step until the next attempt has been made.
+			state[retryKey] = newStepRetryRecord(stepRes)
+			execResult.Status = StatusPending
+			return execResult, nil
+		}
+		if stepRes.Err != nil {
+			if d.ContinueOnError {
+				// The failure is recorded in the result of the step, but does
+				// not prevent the execution of subsequent steps.
+				continue
 			}
+			execResult.Status = StatusFailure
+			return execResult, fmt.Errorf("failed to run step %q: %w", d.Directive, stepRes.Err)
+		}
+
+		if d.Alias != "" {
+			state[d.Alias] = stepRes.Result.Output
+		}
+
+		if stepRes.Result.Status == StatusPending {
+			// The step is waiting on some external state. Execution cannot
+			// proceed past this step until it has completed.
+			execResult.Status = StatusPending
+			return execResult, nil
+		}
+	}
+	return execResult, nil
+}
+
+// prepareStep looks up the Directive that should execute the provided Step and
+// builds the StepContext for it. Expressions in the Config of the Step are
+// evaluated against the provided shared State, so that the Step can make use
+// of the outputs of the Steps that preceded it. The StepContext is given its
+// own copy of the shared State.
+func (e *Engine) prepareStep(
+	ctx context.Context,
+	promoCtx PromotionContext,
+	workDir string,
+	state State,
+	step Step,
+) (Directive, *StepContext, error) {
+	reg, err := e.registry.GetDirectiveRegistration(step.Directive)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get step %q: %w", step.Directive, err)
+	}
+
+	cfg, err := e.evaluateConfig(ctx, promoCtx, state, step.Config)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to evaluate configuration of step %q: %w", step.Directive, err,
+		)
+	}
+
+	stepCtx := &StepContext{
+		WorkDir:         workDir,
+		DryRun:          promoCtx.DryRun,
+		SharedState:     state.DeepCopy(),
+		Alias:           step.Alias,
+		Config:          cfg,
+		Project:         promoCtx.Project,
+		Stage:           promoCtx.Stage,
+		FreightRequests: promoCtx.FreightRequests,
+		Freight:         promoCtx.Freight,
+	}
+	// Selectively provide these capabilities via the StepContext.
+	if reg.Permissions.AllowCredentialsDB {
+		stepCtx.CredentialsDB = e.credentialsDB
+	}
+	if reg.Permissions.AllowKargoClient {
+		stepCtx.KargoClient = e.kargoClient
+	}
+	if reg.Permissions.AllowArgoCDClient {
+		stepCtx.ArgoCDClient = e.argoCDClient
+	}
+	return reg.Directive, stepCtx, nil
+}
+
+// stepGroupProgressKey returns the key under which the progress of the step
+// group at the provided index is recorded in the shared State while the group
+// is pending. As aliases cannot start with an underscore, the key cannot clash
+// with the alias of any Step.
+func stepGroupProgressKey(index int64) string {
+	return fmt.Sprintf("__group-%d", index)
+}
+
+// stepRetryKey returns the key under which the previous attempts of the Step
+// at the provided index are recorded in the shared State while the Step is
+// waiting to be attempted again. The index of a child Step of a step group is
+// preceded by the index of the group. As aliases cannot start with an
+// underscore, the key cannot clash with the alias of any Step.
+func stepRetryKey(indices ...int64) string {
+	key := "__retry"
+	for _, i := range indices {
+		key += "-" + strconv.FormatInt(i, 10)
+	}
+	return key
+}
+
+// executeStepGroup executes the child Steps of the step group at the provided
+// index concurrently, with no more than group.MaxConcurrency of them running at the
+// same time. Every child Step is given its own copy of the provided shared
+// State, which means child Steps cannot make use of each other's outputs. The
+// returned ExecutionResult holds the outcome of every child Step, in order of
+// declaration, and a State holding the outputs of the child Steps keyed by
+// their aliases. An error is returned if any child Step failed and was not
+// allowed to fail by its ContinueOnError field.
+//
+// While the group is pending, the outcome of every child Step that has
+// completed is recorded in the returned State under the key returned by
+// stepGroupProgressKey, and the previous attempts of every child Step that is
+// waiting to be attempted again under the key returned by stepRetryKey. When
+// the group is resumed, completed child Steps are not run again, but their
+// recorded outcome is returned instead.
+func (e *Engine) executeStepGroup(
+	ctx context.Context,
+	promoCtx PromotionContext,
+	workDir string,
+	state State,
+	group Step,
+	index int64,
+) (ExecutionResult, error) {
+	groupRes := ExecutionResult{
+		Status: StatusSuccess,
+		State:  make(State, len(group.Steps)),
+	}
+
+	progressKey := stepGroupProgressKey(index)
+	progress, err := getStepGroupProgress(state, progressKey)
+	if err != nil {
+		groupRes.Status = StatusFailure
+		return groupRes, err
+	}
+
+	// All child steps are prepared before any of them is run, so that a child
+	// step that cannot be run at all does not leave the group partially
+	// executed.
+	stepDirectives := make([]Directive, len(group.Steps))
+	stepCtxs := make([]*StepContext, len(group.Steps))
+	retries := make([]*stepRetryRecord, len(group.Steps))
+	for i, d := range group.Steps {
+		if _, completed := progress[i]; completed {
+			continue
+		}
+		stepDirectives[i], stepCtxs[i], err = e.prepareStep(ctx, promoCtx, workDir, state, d)
+		if err == nil {
+			retries[i], err = getStepRetry(state, stepRetryKey(index, int64(i)))
+		}
+		if err != nil {
+			groupRes.Status = StatusFailure
+			groupRes.StepResults = []StepResult{{
+				Directive: d.Directive,
+				Alias:     d.Alias,
+				Result:    Result{Status: StatusFailure},
+				Err:       err,
+			}}
+			return groupRes, err
+		}
+	}
+
+	groupRes.StepResults = make([]StepResult, len(group.Steps))
+	var g errgroup.Group
+	if group.MaxConcurrency > 0 {
+		g.SetLimit(int(group.MaxConcurrency))
+	}
+	for i, d := range group.Steps {
+		if stepRes, completed := progress[i]; completed {
+			groupRes.StepResults[i] = stepRes
+			continue
+		}
+		g.Go(func() error {
+			// Every child step writes to its own element of the slice, so no
+			// further synchronization is required.
+			groupRes.StepResults[i] = runStep(ctx, d, stepDirectives[i], stepCtxs[i], retries[i])
+			return nil
+		})
+	}
+	_ = g.Wait()
 
-			if d.Alias != "" {
-				state[d.Alias] = result.Output
+	var errs []error
+	for i, d := range group.Steps {
+		stepRes := groupRes.StepResults[i]
+		if stepRes.awaitingRetry() {
+			groupRes.State[stepRetryKey(index, int64(i))] = newStepRetryRecord(stepRes)
+			groupRes.Status = StatusPending
+			continue
+		}
+		if stepRes.Err != nil {
+			if !d.ContinueOnError {
+				errs = append(
+					errs,
+					fmt.Errorf("failed to run step %q: %w", d.Directive, stepRes.Err),
+				)
+				continue
 			}
+		} else if d.Alias != "" {
+			groupRes.State[d.Alias] = stepRes.Result.Output
 		}
+		if stepRes.Err == nil && stepRes.Result.Status == StatusPending {
+			// The group cannot be considered complete until all of its child
+			// steps have completed.
+			groupRes.Status = StatusPending
+			continue
+		}
+		progress[i] = stepRes
+	}
+	if len(errs) > 0 {
+		groupRes.Status = StatusFailure
+		return groupRes, errors.Join(errs...)
+	}
+	if groupRes.Status == StatusPending {
+		groupRes.State[progressKey] = stepGroupProgressToState(progress)
+	}
+	return groupRes, nil
+}
+
+// getStepGroupProgress returns the outcome of the child Steps of a step group
+// that have completed, as recorded in the provided shared State under the
+// provided progress key, indexed by the position of the child Step within the
+// group.
+func getStepGroupProgress(state State, progressKey string) (map[int]StepResult, error) {
+	progress := map[int]StepResult{}
+	recorded, ok := state[progressKey]
+	if !ok {
+		return progress, nil
+	}
+	// The shared State may have made a round trip through JSON, so the
+	// recorded progress is decoded the same way in all cases.
+	data, err := json.Marshal(recorded)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling progress of step group: %w", err)
+	}
+	var records map[int]stepGroupProgressRecord
+	if err = json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("error unmarshaling progress of step group: %w", err)
+	}
+	for i, record := range records {
+		stepRes := StepResult{
+			Directive: record.Directive,
+			Alias:     record.Alias,
+			Result: Result{
+				Status: record.Status,
+				Output: record.Output,
+			},
+			Attempts: record.Attempts,
+		}
+		if record.Error != "" {
+			stepRes.Err = errors.New(record.Error)
+		}
+		progress[i] = stepRes
+	}
+	return progress, nil
+}
+
+// stepGroupProgressToState converts the provided outcome of the completed
+// child Steps of a step group into a value that can be recorded in the shared
+// State.
+func stepGroupProgressToState(progress map[int]StepResult) map[string]any {
+	state := make(map[string]any, len(progress))
+	for i, stepRes := range progress {
+		record := stepGroupProgressRecord{
+			Directive: stepRes.Directive,
+			Alias:     stepRes.Alias,
+			Status:    stepRes.Result.Status,
+			Output:    stepRes.Result.Output,
+			Attempts:  stepRes.Attempts,
+		}
+		if stepRes.Err != nil {
+			record.Error = stepRes.Err.Error()
+		}
+		state[strconv.Itoa(i)] = record
+	}
+	return state
+}
+
+// stepGroupProgressRecord records the outcome of a completed child Step of a
+// step group.
+type stepGroupProgressRecord struct {
+	Directive string `json:"directive"`
+	Alias     string `json:"alias,omitempty"`
+	Status    Status `json:"status"`
+	Output    State  `json:"output,omitempty"`
+	Attempts  int64  `json:"attempts,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// stepRetryRecord records the previous attempts of a Step that is waiting to
+// be attempted again.
+type stepRetryRecord struct {
+	Attempts    int64     `json:"attempts"`
+	NextAttempt time.Time `json:"nextAttempt"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// newStepRetryRecord returns a stepRetryRecord for the provided StepResult of
+// a Step that is waiting to be attempted again.
+func newStepRetryRecord(stepRes StepResult) stepRetryRecord {
+	record := stepRetryRecord{
+		Attempts:    stepRes.Attempts,
+		NextAttempt: stepRes.NextAttempt,
+	}
+	if stepRes.Err != nil {
+		record.Error = stepRes.Err.Error()
+	}
+	return record
+}
+
+// getStepRetry returns the previous attempts of a Step as recorded in the
+// provided shared State under the provided key, or nil if none are recorded.
+func getStepRetry(state State, retryKey string) (*stepRetryRecord, error) {
+	recorded, ok := state[retryKey]
+	if !ok {
+		return nil, nil
+	}
+	// The shared State may have made a round trip through JSON, so the
+	// recorded attempts are decoded the same way in all cases.
+	data, err := json.Marshal(recorded)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling previous attempts of step: %w", err)
+	}
+	record := &stepRetryRecord{}
+	if err = json.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("error unmarshaling previous attempts of step: %w", err)
+	}
+	return record, nil
+}
+
+// runStep makes an attempt at running the provided Step using the provided
+// Directive, giving it its own copy of the provided StepContext. If the Step
+// was attempted before, the provided stepRetryRecord describes its previous
+// attempts, and no attempt is made before the next one is due. A failed
+// attempt is retried as configured by the Step, but instead of waiting for the
+// retry, the returned StepResult reports the Step as pending until the next
+// attempt is due.
+func runStep(
+	ctx context.Context,
+	step Step,
+	directive Directive,
+	stepCtx *StepContext,
+	retry *stepRetryRecord,
+) StepResult {
+	stepRes := StepResult{
+		Directive: step.Directive,
+		Alias:     step.Alias,
+	}
+	if retry != nil {
+		stepRes.Attempts = retry.Attempts
+		if time.Now().Before(retry.NextAttempt) {
+			stepRes.Result = Result{Status: StatusPending}
+			stepRes.Err = errors.New(retry.Error)
+			stepRes.NextAttempt = retry.NextAttempt
+			return stepRes
+		}
+	}
+
+	attemptCtx := *stepCtx
+	attemptCtx.SharedState = stepCtx.SharedState.DeepCopy()
+	attemptCtx.Config = stepCtx.Config.DeepCopy()
+
+	stepRes.Attempts++
+	stepRes.Result, stepRes.Err = runStepAttempt(ctx, step, directive, &attemptCtx)
+	if stepRes.Err != nil && stepRes.Attempts <= step.Retries && ctx.Err() == nil {
+		stepRes.Result = Result{Status: StatusPending}
+		stepRes.NextAttempt = time.Now().Add(
+			getRetryBackoff(step.RetryBackoff, stepRes.Attempts),
+		)
+	}
+	return stepRes
+}
+
+// getRetryBackoff returns the duration to wait after the provided number of
+// failed attempts, starting from the provided backoff after the first failed
+// attempt and doubling it after every subsequent one.
+func getRetryBackoff(backoff time.Duration, attempts int64) time.Duration {
+	for ; attempts > 1 && backoff <= math.MaxInt64/2; attempts-- {
+		backoff *= 2
+	}
+	return backoff
+}
+
+// runStepAttempt makes a single attempt at running the provided Step using the
+// provided Directive. The attempt is limited in duration by the Timeout of the
+// Step.
+func runStepAttempt(
+	ctx context.Context,
+	step Step,
+	directive Directive,
+	stepCtx *StepContext,
+) (Result, error) {
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, step.Timeout)
+		defer cancel()
+	}
+	result, err := directive.Run(ctx, stepCtx)
+	if err == nil && result.Status == StatusFailure {
+		err = fmt.Errorf("directive reported %s", StatusFailure)
+	}
+	if err != nil {
+		if step.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("step timed out after %s: %w", step.Timeout, err)
+		}
+		result.Status = StatusFailure
+	}
+	return result, err
+}
+
+// evaluateConfig returns a copy of the provided Config in which all
+// expressions have been evaluated against the provided PromotionContext and
+// shared State.
+func (e *Engine) evaluateConfig(
+	ctx context.Context,
+	promoCtx PromotionContext,
+	state State,
+	cfg Config,
+) (Config, error) {
+	evaluator, err := newExprEvaluator(ctx, e.kargoClient, promoCtx, state)
+	if err != nil {
+		return nil, err
 	}
-	return StatusSuccess, nil
+	return evaluator.evaluateConfig(cfg)
 }
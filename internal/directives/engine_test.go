@@ -3,6 +3,7 @@ package directives
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,10 +15,11 @@ func TestEngine_Execute(t *testing.T) {
 	successResult := Result{Status: StatusSuccess}
 	tests := []struct {
 		name         string
+		promoCtx     PromotionContext
 		directives   []Step
 		initRegistry func() DirectiveRegistry
 		ctx          context.Context
-		assertions   func(t *testing.T, status Status, err error)
+		assertions   func(t *testing.T, result ExecutionResult, err error)
 	}{
 		{
 			name: "success: single directive",
@@ -36,8 +38,8 @@ func TestEngine_Execute(t *testing.T) {
 				return registry
 			},
 			ctx: context.Background(),
-			assertions: func(t *testing.T, status Status, err error) {
-				assert.Equal(t, StatusSuccess, status)
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusSuccess, result.Status)
 				assert.NoError(t, err)
 			},
 		},
@@ -66,9 +68,388 @@ func TestEngine_Execute(t *testing.T) {
 				return registry
 			},
 			ctx: context.Background(),
-			assertions: func(t *testing.T, status Status, err error) {
-				assert.Equal(t, StatusSuccess, status)
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusSuccess, result.Status)
 				assert.NoError(t, err)
+				assert.Len(t, result.StepResults, 2)
+			},
+		},
+		{
+			name: "success: output is shared with subsequent directives",
+			promoCtx: PromotionContext{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+			},
+			directives: []Step{
+				{Directive: "producer", Alias: "produce"},
+				{Directive: "consumer", Alias: "consume"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "producer",
+						runResult: Result{
+							Status: StatusSuccess,
+							Output: State{"key": "value"},
+						},
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "consumer",
+						runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+							if stepCtx.Project != "fake-project" || stepCtx.Stage != "fake-stage" {
+								return failureResult, errors.New("unexpected promotion context")
+							}
+							output, ok := stepCtx.SharedState.Get("produce")
+							if !ok {
+								return failureResult, errors.New("missing output")
+							}
+							return Result{
+								Status: StatusSuccess,
+								Output: State{"received": output},
+							}, nil
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 2)
+				assert.Equal(t, "consume", result.StepResults[1].Alias)
+				assert.Equal(
+					t,
+					State{"received": State{"key": "value"}},
+					result.StepResults[1].Result.Output,
+				)
+			},
+		},
+		{
+			name: "success: config expressions are evaluated",
+			promoCtx: PromotionContext{
+				Stage: "fake-stage",
+			},
+			directives: []Step{
+				{Directive: "producer", Alias: "produce"},
+				{
+					Directive: "consumer",
+					Config:    Config{"value": "${{ .ctx.stage }}/${{ .outputs.produce.key }}"},
+				},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "producer",
+						runResult: Result{
+							Status: StatusSuccess,
+							Output: State{"key": "value"},
+						},
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "consumer",
+						runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+							if stepCtx.Config["value"] != "fake-stage/value" {
+								return failureResult, errors.New("unexpected config value")
+							}
+							return successResult, nil
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 2)
+			},
+		},
+		{
+			name: "failure: config expression cannot be evaluated",
+			directives: []Step{
+				{
+					Directive: "mock",
+					Config:    Config{"value": "${{ .outputs.unknown.key }}"},
+				},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "mock",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
+				assert.ErrorContains(t, err, "failed to evaluate configuration of step")
+				assert.Len(t, result.StepResults, 1)
+			},
+		},
+		{
+			name: "pending: failed attempt is to be retried",
+			directives: []Step{
+				{Directive: "mock"},
+				{Directive: "flaky", Retries: 3, RetryBackoff: time.Minute},
+				{Directive: "never"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "mock",
+						runResult: successResult,
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "flaky",
+						runResult: failureResult,
+						runErr:    errors.New("transient error"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "never",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusPending, result.Status)
+				assert.Equal(t, int64(1), result.CurrentStep)
+				assert.Len(t, result.StepResults, 2)
+				stepRes := result.StepResults[1]
+				assert.Equal(t, StatusPending, stepRes.Result.Status)
+				assert.ErrorContains(t, stepRes.Err, "transient error")
+				assert.Equal(t, int64(1), stepRes.Attempts)
+				assert.WithinDuration(t, time.Now().Add(time.Minute), stepRes.NextAttempt, 5*time.Second)
+				assert.Contains(t, result.State, stepRetryKey(1))
+			},
+		},
+		{
+			name: "pending: step is not attempted again before its next attempt is due",
+			promoCtx: PromotionContext{
+				State: State{
+					stepRetryKey(0): map[string]any{
+						"attempts":    2,
+						"nextAttempt": time.Now().Add(time.Minute).Format(time.RFC3339),
+						"error":       "transient error",
+					},
+				},
+			},
+			directives: []Step{
+				{Directive: "never", Retries: 3},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "never",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusPending, result.Status)
+				assert.Len(t, result.StepResults, 1)
+				stepRes := result.StepResults[0]
+				assert.Equal(t, StatusPending, stepRes.Result.Status)
+				assert.EqualError(t, stepRes.Err, "transient error")
+				assert.Equal(t, int64(2), stepRes.Attempts)
+				assert.Contains(t, result.State, stepRetryKey(0))
+			},
+		},
+		{
+			name: "success: failed step continues on error",
+			directives: []Step{
+				{Directive: "failing", Alias: "failing", ContinueOnError: true},
+				{Directive: "mock"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "failing",
+						runResult: failureResult,
+						runErr:    errors.New("something went wrong"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "mock",
+						runResult: successResult,
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 2)
+				assert.Equal(t, StatusFailure, result.StepResults[0].Result.Status)
+				assert.ErrorContains(t, result.StepResults[0].Err, "something went wrong")
+				assert.NotContains(t, result.State, "failing")
+			},
+		},
+		{
+			name: "pending: directive is waiting on external state",
+			directives: []Step{
+				{Directive: "waiting"},
+				{Directive: "never"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "waiting",
+						runResult: Result{Status: StatusPending},
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "never",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusPending, result.Status)
+				assert.Len(t, result.StepResults, 1)
+				assert.Equal(t, int64(0), result.CurrentStep)
+			},
+		},
+		{
+			name: "success: resumes from step with restored state",
+			promoCtx: PromotionContext{
+				WorkDir:       "fake-work-dir",
+				StartFromStep: 1,
+				State:         State{"previous": State{"key": "value"}},
+			},
+			directives: []Step{
+				{Directive: "never"},
+				{Directive: "resumed", Alias: "resumed"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "never",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "resumed",
+						runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+							if stepCtx.WorkDir != "fake-work-dir" {
+								return failureResult, errors.New("unexpected work dir")
+							}
+							output, ok := stepCtx.SharedState.Get("previous")
+							if !ok {
+								return failureResult, errors.New("missing restored state")
+							}
+							return Result{
+								Status: StatusSuccess,
+								Output: State{"received": output},
+							}, nil
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 1)
+				assert.Equal(t, "resumed", result.StepResults[0].Directive)
+				assert.Equal(t, int64(1), result.CurrentStep)
+				assert.Equal(
+					t,
+					State{
+						"previous": State{"key": "value"},
+						"resumed":  State{"received": State{"key": "value"}},
+					},
+					result.State,
+				)
+			},
+		},
+		{
+			name: "failure: invalid step to start from",
+			promoCtx: PromotionContext{
+				StartFromStep: 2,
+			},
+			directives: []Step{
+				{Directive: "mock"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				return make(DirectiveRegistry)
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
+				assert.ErrorContains(t, err, "invalid step index 2")
+				assert.Empty(t, result.StepResults)
+			},
+		},
+		{
+			name: "failure: directive reports failure without error",
+			directives: []Step{
+				{Directive: "failing"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "failing",
+						runResult: failureResult,
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
+				assert.ErrorContains(t, err, "directive reported Failure")
+				assert.Len(t, result.StepResults, 1)
+				assert.Error(t, result.StepResults[0].Err)
 			},
 		},
 		{
@@ -80,8 +461,8 @@ func TestEngine_Execute(t *testing.T) {
 				return make(DirectiveRegistry)
 			},
 			ctx: context.Background(),
-			assertions: func(t *testing.T, status Status, err error) {
-				assert.Equal(t, StatusFailure, status)
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
 				assert.ErrorContains(t, err, "not found")
 			},
 		},
@@ -103,9 +484,83 @@ func TestEngine_Execute(t *testing.T) {
 				return registry
 			},
 			ctx: context.Background(),
-			assertions: func(t *testing.T, status Status, err error) {
-				assert.Equal(t, StatusFailure, status)
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
+				assert.ErrorContains(t, err, "something went wrong")
+				assert.Len(t, result.StepResults, 1)
+				assert.Equal(t, "alias1", result.StepResults[0].Alias)
+				assert.ErrorContains(t, result.StepResults[0].Err, "something went wrong")
+			},
+		},
+		{
+			name: "failure: retries exhausted",
+			promoCtx: PromotionContext{
+				State: State{
+					stepRetryKey(0): map[string]any{
+						"attempts":    2,
+						"nextAttempt": time.Now().Add(-time.Minute).Format(time.RFC3339),
+						"error":       "something went wrong",
+					},
+				},
+			},
+			directives: []Step{
+				{Directive: "failing", Retries: 2, RetryBackoff: time.Millisecond},
+				{Directive: "never"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "failing",
+						runResult: failureResult,
+						runErr:    errors.New("something went wrong"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "never",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
 				assert.ErrorContains(t, err, "something went wrong")
+				assert.Len(t, result.StepResults, 1)
+				assert.Equal(t, int64(3), result.StepResults[0].Attempts)
+				assert.NotContains(t, result.State, stepRetryKey(0))
+			},
+		},
+		{
+			name: "failure: step times out",
+			directives: []Step{
+				{Directive: "slow", Timeout: 10 * time.Millisecond},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "slow",
+						runFunc: func(ctx context.Context, _ *StepContext) (Result, error) {
+							<-ctx.Done()
+							return failureResult, ctx.Err()
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
+				assert.ErrorContains(t, err, "step timed out after 10ms")
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+				assert.Len(t, result.StepResults, 1)
+				assert.Equal(t, int64(1), result.StepResults[0].Attempts)
 			},
 		},
 		{
@@ -136,17 +591,474 @@ func TestEngine_Execute(t *testing.T) {
 				}()
 				return ctx
 			}(),
-			assertions: func(t *testing.T, status Status, err error) {
-				assert.Equal(t, StatusFailure, status)
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.Equal(t, StatusFailure, result.Status)
 				assert.ErrorIs(t, err, context.Canceled)
 			},
 		},
+		{
+			name: "success: step group outputs are merged",
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "producer", Alias: "produce1"},
+						{Directive: "producer", Alias: "produce2"},
+					},
+				},
+				{Directive: "consumer", Alias: "consume"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "producer",
+						runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+							// Sibling steps must not see each other's output
+							if len(stepCtx.SharedState) > 0 {
+								return failureResult, errors.New("unexpected shared state")
+							}
+							return Result{
+								Status: StatusSuccess,
+								Output: State{"key": stepCtx.Alias},
+							}, nil
+						},
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "consumer",
+						runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+							return Result{
+								Status: StatusSuccess,
+								Output: State{"received": stepCtx.SharedState},
+							}, nil
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 3)
+				assert.Equal(t, "produce1", result.StepResults[0].Alias)
+				assert.Equal(t, "produce2", result.StepResults[1].Alias)
+				assert.Equal(
+					t,
+					State{
+						"received": State{
+							"produce1": State{"key": "produce1"},
+							"produce2": State{"key": "produce2"},
+						},
+					},
+					result.State["consume"],
+				)
+			},
+		},
+		{
+			name: "success: step group limits concurrency",
+			directives: []Step{
+				{
+					MaxConcurrency: 2,
+					Steps: []Step{
+						{Directive: "mock"},
+						{Directive: "mock"},
+						{Directive: "mock"},
+						{Directive: "mock"},
+					},
+				},
+			},
+			initRegistry: func() DirectiveRegistry {
+				var mu sync.Mutex
+				var running, maxRunning int
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "mock",
+						runFunc: func(context.Context, *StepContext) (Result, error) {
+							mu.Lock()
+							running++
+							maxRunning = max(maxRunning, running)
+							mu.Unlock()
+							time.Sleep(10 * time.Millisecond)
+							mu.Lock()
+							defer mu.Unlock()
+							running--
+							if maxRunning > 2 {
+								return failureResult, errors.New("too many concurrent steps")
+							}
+							return successResult, nil
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 4)
+			},
+		},
+		{
+			name: "pending: step group with pending child step",
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "success"},
+						{Directive: "pending"},
+					},
+				},
+				{Directive: "success"}, // This directive should not be executed
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "success",
+						runResult: successResult,
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "pending",
+						runResult: Result{Status: StatusPending},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusPending, result.Status)
+				assert.Equal(t, int64(0), result.CurrentStep)
+				assert.Len(t, result.StepResults, 2)
+				// Only the completed child step is recorded as such
+				progress, err := getStepGroupProgress(result.State, stepGroupProgressKey(0))
+				assert.NoError(t, err)
+				assert.Len(t, progress, 1)
+				assert.Equal(t, StatusSuccess, progress[0].Result.Status)
+			},
+		},
+		{
+			name: "success: resumed step group does not rerun completed child steps",
+			promoCtx: PromotionContext{
+				State: State{
+					stepGroupProgressKey(0): stepGroupProgressToState(map[int]StepResult{
+						0: {
+							Directive: "never",
+							Alias:     "completed",
+							Result: Result{
+								Status: StatusSuccess,
+								Output: State{"key": "value"},
+							},
+							Attempts: 1,
+						},
+					}),
+				},
+			},
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "never", Alias: "completed"},
+						{Directive: "success"},
+					},
+				},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "never",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "success",
+						runResult: successResult,
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 2)
+				assert.Equal(t, StatusSuccess, result.StepResults[0].Result.Status)
+				assert.Equal(t, State{"key": "value"}, result.State["completed"])
+				assert.NotContains(t, result.State, stepGroupProgressKey(0))
+			},
+		},
+		{
+			name: "failure: child step of step group fails",
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "failure"},
+						{Directive: "success"},
+					},
+				},
+				{Directive: "success"}, // This directive should not be executed
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "failure",
+						runResult: failureResult,
+						runErr:    errors.New("something went wrong"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "success",
+						runResult: successResult,
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.ErrorContains(t, err, "something went wrong")
+				assert.Equal(t, StatusFailure, result.Status)
+				// Sibling steps are not affected by the failure
+				assert.Len(t, result.StepResults, 2)
+				assert.Equal(t, StatusFailure, result.StepResults[0].Result.Status)
+				assert.Equal(t, StatusSuccess, result.StepResults[1].Result.Status)
+			},
+		},
+		{
+			name: "success: failed child step of step group continues on error",
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "failure", Alias: "fail", ContinueOnError: true},
+						{Directive: "success", Alias: "succeed"},
+					},
+				},
+				{Directive: "success"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "failure",
+						runResult: failureResult,
+						runErr:    errors.New("something went wrong"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "success",
+						runResult: successResult,
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 3)
+				assert.NotContains(t, result.State, "fail")
+				assert.Contains(t, result.State, "succeed")
+			},
+		},
+		{
+			name: "success: failed step group continues on error",
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "failure", Alias: "fail"},
+						{Directive: "producer", Alias: "produce"},
+					},
+					ContinueOnError: true,
+				},
+				{Directive: "consumer", Alias: "consume"},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:      "failure",
+						runResult: failureResult,
+						runErr:    errors.New("something went wrong"),
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "producer",
+						runResult: Result{
+							Status: StatusSuccess,
+							Output: State{"key": "value"},
+						},
+					},
+					nil,
+				)
+				registry.RegisterDirective(
+					&mockDirective{
+						name: "consumer",
+						runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+							return Result{
+								Status: StatusSuccess,
+								Output: State{"received": stepCtx.SharedState},
+							}, nil
+						},
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, StatusSuccess, result.Status)
+				assert.Len(t, result.StepResults, 3)
+				assert.Equal(t, StatusFailure, result.StepResults[0].Result.Status)
+				assert.Equal(t, StatusSuccess, result.StepResults[1].Result.Status)
+				assert.NotContains(t, result.State, "fail")
+				assert.Equal(t, State{"key": "value"}, result.State["produce"])
+				// The output of the succeeding child step is available to
+				// subsequent steps
+				assert.Equal(
+					t,
+					State{
+						"received": State{
+							"produce": State{"key": "value"},
+						},
+					},
+					result.State["consume"],
+				)
+			},
+		},
+		{
+			name: "failure: child step of step group not found",
+			directives: []Step{
+				{
+					Steps: []Step{
+						{Directive: "success"},
+						{Directive: "unknown"},
+					},
+				},
+			},
+			initRegistry: func() DirectiveRegistry {
+				registry := make(DirectiveRegistry)
+				registry.RegisterDirective(
+					&mockDirective{
+						name:   "success",
+						runErr: errors.New("should not be executed"),
+					},
+					nil,
+				)
+				return registry
+			},
+			ctx: context.Background(),
+			assertions: func(t *testing.T, result ExecutionResult, err error) {
+				assert.ErrorContains(t, err, "not found")
+				assert.Equal(t, StatusFailure, result.Status)
+				assert.Len(t, result.StepResults, 1)
+				assert.Equal(t, "unknown", result.StepResults[0].Directive)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			engine := NewEngine(tt.initRegistry(), nil, nil, nil)
-			status, err := engine.Execute(tt.ctx, tt.directives)
-			tt.assertions(t, status, err)
+			result, err := engine.Execute(tt.ctx, tt.promoCtx, tt.directives)
+			tt.assertions(t, result, err)
 		})
 	}
 }
+
+func TestEngine_Execute_Retries(t *testing.T) {
+	var attempts sync.Map
+	registry := make(DirectiveRegistry)
+	registry.RegisterDirective(
+		&mockDirective{
+			name: "flaky",
+			runFunc: func(_ context.Context, stepCtx *StepContext) (Result, error) {
+				n, _ := attempts.LoadOrStore(stepCtx.Alias, new(int))
+				if *n.(*int)++; *n.(*int) < 3 { // nolint: forcetypeassert
+					return Result{Status: StatusFailure}, errors.New("transient error")
+				}
+				return Result{
+					Status: StatusSuccess,
+					Output: State{"attempts": *n.(*int)}, // nolint: forcetypeassert
+				}, nil
+			},
+		},
+		nil,
+	)
+	engine := NewEngine(registry, nil, nil, nil)
+
+	steps := []Step{
+		{Directive: "flaky", Alias: "step", Retries: 2, RetryBackoff: time.Millisecond},
+		{
+			Steps: []Step{
+				{Directive: "flaky", Alias: "child1", Retries: 2, RetryBackoff: time.Millisecond},
+				{Directive: "flaky", Alias: "child2", Retries: 2, RetryBackoff: time.Millisecond},
+			},
+		},
+	}
+
+	// Every execution is resumed from where the previous one left, as the
+	// controller would once the next attempt is due.
+	promoCtx := PromotionContext{WorkDir: t.TempDir()}
+	var result ExecutionResult
+	var executions int
+	for {
+		var err error
+		result, err = engine.Execute(context.Background(), promoCtx, steps)
+		assert.NoError(t, err)
+		executions++
+		if result.Status != StatusPending {
+			break
+		}
+		for _, stepRes := range result.StepResults {
+			if stepRes.awaitingRetry() {
+				time.Sleep(time.Until(stepRes.NextAttempt))
+			}
+		}
+		promoCtx.StartFromStep = result.CurrentStep
+		promoCtx.State = result.State
+	}
+
+	assert.Equal(t, StatusSuccess, result.Status)
+	assert.Equal(t, 5, executions)
+	assert.Len(t, result.StepResults, 2)
+	for _, stepRes := range result.StepResults {
+		assert.NoError(t, stepRes.Err)
+		assert.Equal(t, int64(3), stepRes.Attempts)
+	}
+	assert.Equal(
+		t,
+		State{
+			"step":   State{"attempts": 3},
+			"child1": State{"attempts": 3},
+			"child2": State{"attempts": 3},
+		},
+		result.State,
+	)
+}
+
+func Test_getRetryBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, getRetryBackoff(10*time.Second, 1))
+	assert.Equal(t, 20*time.Second, getRetryBackoff(10*time.Second, 2))
+	assert.Equal(t, 80*time.Second, getRetryBackoff(10*time.Second, 4))
+	assert.Positive(t, getRetryBackoff(10*time.Second, 100))
+}
@@ -0,0 +1,306 @@
+package directives
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/freight"
+)
+
+const (
+	// exprLeftDelim is the delimiter that marks the start of an expression in
+	// a (string) value of a Config.
+	exprLeftDelim = "${{"
+	// exprRightDelim is the delimiter that marks the end of an expression in a
+	// (string) value of a Config.
+	exprRightDelim = "}}"
+)
+
+// exprEvaluator evaluates the expressions contained by the (string) values of
+// a Config. Expressions are enclosed in "${{" and "}}" and use the syntax of
+// Go templates. They are evaluated against an environment with the following
+// keys:
+//
+//   - ctx: the context of the Promotion (e.g. ${{ .ctx.project }} and
+//     ${{ .ctx.stage }}).
+//   - vars: the variables declared by the Promotion (e.g. ${{ .vars.foo }}).
+//   - outputs: the outputs of previously executed steps, keyed by their alias
+//     (e.g. ${{ .outputs.push.branch }}). As Go templates do not allow hyphens
+//     in field names, the outputs of a step with an alias that contains hyphens
+//     must be looked up using the index function (e.g.
+//     ${{ index .outputs "open-pr" "prNumber" }}).
+//
+// In addition, the imageFrom, commitFrom, chartFrom, artifactFrom and fileFrom
+// functions can be used to look up artifacts from the Freight referenced by the
+// Promotion (e.g. ${{ (imageFrom "nginx").tag }}).
+//
+// When a value consists of a single expression, the result of that expression
+// replaces the value as-is, which means it is not necessarily a string. In all
+// other cases, the results of the expressions are interpolated into the value.
+type exprEvaluator struct {
+	env   map[string]any
+	funcs template.FuncMap
+}
+
+// newExprEvaluator returns an exprEvaluator for the Promotion described by the
+// provided PromotionContext and the provided shared State. The variables
+// declared by the Promotion are evaluated in order of declaration, which means
+// the value of a variable may refer to the variables declared before it.
+func newExprEvaluator(
+	ctx context.Context,
+	kargoClient client.Client,
+	promoCtx PromotionContext,
+	state State,
+) (*exprEvaluator, error) {
+	vars := make(map[string]any, len(promoCtx.Vars))
+	e := &exprEvaluator{
+		env: map[string]any{
+			"ctx": map[string]any{
+				"project": promoCtx.Project,
+				"stage":   promoCtx.Stage,
+			},
+			"vars":    vars,
+			"outputs": state,
+		},
+		funcs: freightFuncs(ctx, kargoClient, promoCtx),
+	}
+	for _, v := range promoCtx.Vars {
+		val, err := e.evaluateString(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating variable %q: %w", v.Name, err)
+		}
+		vars[v.Name] = val
+	}
+	return e, nil
+}
+
+// evaluateConfig returns a copy of the provided Config in which all
+// expressions have been evaluated.
+func (e *exprEvaluator) evaluateConfig(cfg Config) (Config, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	evaluated, err := e.evaluate(map[string]any(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return evaluated.(map[string]any), nil // nolint: forcetypeassert
+}
+
+// evaluate recursively evaluates the expressions contained by the provided
+// value.
+func (e *exprEvaluator) evaluate(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for key, val := range v {
+			var err error
+			if c[key], err = e.evaluate(val); err != nil {
+				return nil, err
+			}
+		}
+		return c, nil
+	case []any:
+		c := make([]any, len(v))
+		for i, val := range v {
+			var err error
+			if c[i], err = e.evaluate(val); err != nil {
+				return nil, err
+			}
+		}
+		return c, nil
+	case string:
+		return e.evaluateString(v)
+	default:
+		return v, nil
+	}
+}
+
+// evaluateString evaluates the expressions contained by the provided string.
+func (e *exprEvaluator) evaluateString(s string) (any, error) {
+	if !strings.Contains(s, exprLeftDelim) {
+		return s, nil
+	}
+	tmpl, err := e.newTemplate(s)
+	if err != nil {
+		return nil, err
+	}
+	// If the string consists of a single expression, we want to retain the
+	// type of its result. To do so, the result is marshaled to JSON by the
+	// template and then unmarshaled again.
+	if nodes := tmpl.Tree.Root.Nodes; len(nodes) == 1 {
+		if action, ok := nodes[0].(*parse.ActionNode); ok && len(action.Pipe.Decl) == 0 {
+			if tmpl, err = e.newTemplate(fmt.Sprintf(
+				"%s toJSON (%s) %s", exprLeftDelim, action.Pipe, exprRightDelim,
+			)); err != nil {
+				return nil, err
+			}
+			res, err := e.execute(tmpl)
+			if err != nil {
+				return nil, err
+			}
+			dec := json.NewDecoder(strings.NewReader(res))
+			dec.UseNumber()
+			var val any
+			if err = dec.Decode(&val); err != nil {
+				return nil, fmt.Errorf("error unmarshaling result of expression %q: %w", s, err)
+			}
+			return normalizeJSONValue(val), nil
+		}
+	}
+	return e.execute(tmpl)
+}
+
+func (e *exprEvaluator) newTemplate(s string) (*template.Template, error) {
+	tmpl, err := template.New("expr").
+		Delims(exprLeftDelim, exprRightDelim).
+		Option("missingkey=error").
+		Funcs(e.funcs).
+		Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing expression %q: %w", s, err)
+	}
+	return tmpl, nil
+}
+
+func (e *exprEvaluator) execute(tmpl *template.Template) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, e.env); err != nil {
+		return "", fmt.Errorf("error evaluating expression: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// freightFuncs returns the functions available to expressions for looking up
+// artifacts from the Freight referenced by the Promotion described by the
+// provided PromotionContext.
+func freightFuncs(
+	ctx context.Context,
+	kargoClient client.Client,
+	promoCtx PromotionContext,
+) template.FuncMap {
+	return template.FuncMap{
+		"toJSON": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"imageFrom": func(repoURL string) (map[string]any, error) {
+			image, err := freight.FindImage(
+				ctx,
+				kargoClient,
+				promoCtx.Project,
+				promoCtx.FreightRequests,
+				nil,
+				promoCtx.Freight.References(),
+				repoURL,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if image == nil {
+				return nil, fmt.Errorf("no image found for repo URL %q", repoURL)
+			}
+			return toExprValue(image)
+		},
+		"commitFrom": func(repoURL string) (map[string]any, error) {
+			commit, err := freight.FindCommit(
+				ctx,
+				kargoClient,
+				promoCtx.Project,
+				promoCtx.FreightRequests,
+				nil,
+				promoCtx.Freight.References(),
+				repoURL,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if commit == nil {
+				return nil, fmt.Errorf("no commit found for repo URL %q", repoURL)
+			}
+			return toExprValue(commit)
+		},
+		"chartFrom": func(repoURL string, name string) (map[string]any, error) {
+			chart, err := freight.FindChart(
+				ctx,
+				kargoClient,
+				promoCtx.Project,
+				promoCtx.FreightRequests,
+				nil,
+				promoCtx.Freight.References(),
+				repoURL,
+				name,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if chart == nil {
+				return nil, fmt.Errorf(
+					"no chart %q found for repo URL %q", name, repoURL,
+				)
+			}
+			return toExprValue(chart)
+		},
+		"artifactFrom": func(repoURL string) (map[string]any, error) {
+			artifact, err := freight.FindArtifact(
+				ctx,
+				kargoClient,
+				promoCtx.Project,
+				promoCtx.FreightRequests,
+				nil,
+				promoCtx.Freight.References(),
+				repoURL,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if artifact == nil {
+				return nil, fmt.Errorf("no artifact found for repo URL %q", repoURL)
+			}
+			return toExprValue(artifact)
+		},
+		"fileFrom": func(repoURL string) (map[string]any, error) {
+			file, err := freight.FindFile(
+				ctx,
+				kargoClient,
+				promoCtx.Project,
+				promoCtx.FreightRequests,
+				nil,
+				promoCtx.Freight.References(),
+				repoURL,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if file == nil {
+				return nil, fmt.Errorf("no file found for repo URL %q", repoURL)
+			}
+			return toExprValue(file)
+		},
+	}
+}
+
+// toExprValue converts the provided artifact into a map, keyed by the JSON
+// field names of the artifact, so that expressions can refer to its fields in
+// the same way they are referred to in Kargo resources.
+func toExprValue[T kargoapi.Image | kargoapi.GitCommit | kargoapi.Chart | kargoapi.OCIArtifact | kargoapi.File](
+	artifact *T,
+) (map[string]any, error) {
+	b, err := json.Marshal(artifact)
+	if err != nil {
+		return nil, err
+	}
+	var val map[string]any
+	if err = json.Unmarshal(b, &val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
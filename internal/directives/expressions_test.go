@@ -0,0 +1,221 @@
+package directives
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestExprEvaluator_EvaluateConfig(t *testing.T) {
+	const testNamespace = "test-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	kargoClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		mockWarehouse(testNamespace, "warehouse1", kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{RepoURL: "nginx"}},
+				{Git: &kargoapi.GitSubscription{RepoURL: "https://github.com/example/repo"}},
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{RepoURL: "ghcr.io/example/bundle"}},
+				{File: &kargoapi.FileSubscription{RepoURL: "s3://example-bucket/app/"}},
+				{Chart: &kargoapi.ChartSubscription{RepoURL: "oci://ghcr.io/example/chart"}},
+			},
+		}),
+	).Build()
+
+	promoCtx := PromotionContext{
+		Project: testNamespace,
+		Stage:   "test-stage",
+		FreightRequests: []kargoapi.FreightRequest{
+			{Origin: kargoapi.FreightOrigin{Name: "warehouse1", Kind: "Warehouse"}},
+		},
+		Freight: kargoapi.FreightCollection{
+			Freight: map[string]kargoapi.FreightReference{
+				"Warehouse/warehouse1": {
+					Origin: kargoapi.FreightOrigin{Kind: "Warehouse", Name: "warehouse1"},
+					Images: []kargoapi.Image{{RepoURL: "nginx", Tag: "1.21.0"}},
+					Commits: []kargoapi.GitCommit{{
+						RepoURL: "https://github.com/example/repo",
+						ID:      "fake-commit",
+					}},
+					Artifacts: []kargoapi.OCIArtifact{{
+						RepoURL: "ghcr.io/example/bundle",
+						Tag:     "v1.0.0",
+						Digest:  "sha256:fake",
+					}},
+					Files: []kargoapi.File{{
+						RepoURL: "s3://example-bucket/app/",
+						URL:     "s3://example-bucket/app/app-1.0.0.tgz",
+						Version: "1.0.0",
+						ETag:    "fake-etag",
+					}},
+					Charts: []kargoapi.Chart{{
+						RepoURL:    "oci://ghcr.io/example/chart",
+						Version:    "1.0.0",
+						AppVersion: "v1.2.3",
+					}},
+				},
+			},
+		},
+		Vars: []kargoapi.PromotionVariable{
+			{Name: "env", Value: "prod"},
+			{Name: "branch", Value: "${{ .ctx.stage }}-${{ .vars.env }}"},
+		},
+	}
+	state := State{
+		"push":    State{"branch": "kargo/promotion"},
+		"open-pr": State{"prNumber": int64(42)},
+	}
+
+	tests := []struct {
+		name       string
+		promoCtx   PromotionContext
+		cfg        Config
+		assertions func(*testing.T, Config, error)
+	}{
+		{
+			name:     "nil config",
+			promoCtx: promoCtx,
+			assertions: func(t *testing.T, cfg Config, err error) {
+				assert.NoError(t, err)
+				assert.Nil(t, cfg)
+			},
+		},
+		{
+			name:     "literal values are left untouched",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"path":  "src",
+				"count": float64(1),
+				"list":  []any{"a", true},
+			},
+			assertions: func(t *testing.T, cfg Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, Config{
+					"path":  "src",
+					"count": float64(1),
+					"list":  []any{"a", true},
+				}, cfg)
+			},
+		},
+		{
+			name:     "context, vars and outputs",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"project":      "${{ .ctx.project }}",
+				"targetBranch": "${{ .vars.branch }}",
+				"sourceBranch": "${{ .outputs.push.branch }}",
+				"prNumber":     `${{ index .outputs "open-pr" "prNumber" }}`,
+				"nested": map[string]any{
+					"message": "PR #${{ index .outputs \"open-pr\" \"prNumber\" }} for ${{ .ctx.stage }}",
+				},
+			},
+			assertions: func(t *testing.T, cfg Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, Config{
+					"project":      testNamespace,
+					"targetBranch": "test-stage-prod",
+					"sourceBranch": "kargo/promotion",
+					"prNumber":     int64(42),
+					"nested": map[string]any{
+						"message": "PR #42 for test-stage",
+					},
+				}, cfg)
+			},
+		},
+		{
+			name:     "freight",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"tag":    `${{ (imageFrom "nginx").tag }}`,
+				"image":  `${{ imageFrom "nginx" }}`,
+				"commit": `${{ (commitFrom "https://github.com/example/repo").id }}`,
+				"digest": `${{ (artifactFrom "ghcr.io/example/bundle").digest }}`,
+				"file":   `${{ (fileFrom "s3://example-bucket/app/").url }}`,
+				"app":    `${{ (chartFrom "oci://ghcr.io/example/chart" "").appVersion }}`,
+			},
+			assertions: func(t *testing.T, cfg Config, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, Config{
+					"tag":    "1.21.0",
+					"image":  map[string]any{"repoURL": "nginx", "tag": "1.21.0"},
+					"commit": "fake-commit",
+					"digest": "sha256:fake",
+					"file":   "s3://example-bucket/app/app-1.0.0.tgz",
+					"app":    "v1.2.3",
+				}, cfg)
+			},
+		},
+		{
+			name:     "image not found",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"tag": `${{ (imageFrom "unknown").tag }}`,
+			},
+			assertions: func(t *testing.T, _ Config, err error) {
+				assert.ErrorContains(t, err, `no image found for repo URL "unknown"`)
+			},
+		},
+		{
+			name:     "missing output",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"branch": "${{ .outputs.unknown.branch }}",
+			},
+			assertions: func(t *testing.T, _ Config, err error) {
+				assert.ErrorContains(t, err, "error evaluating expression")
+			},
+		},
+		{
+			name:     "invalid expression",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"branch": "${{ .outputs.push.branch ",
+			},
+			assertions: func(t *testing.T, _ Config, err error) {
+				assert.ErrorContains(t, err, "error parsing expression")
+			},
+		},
+		{
+			name:     "hyphenated alias referenced as field",
+			promoCtx: promoCtx,
+			cfg: Config{
+				"prNumber": "${{ .outputs.open-pr.prNumber }}",
+			},
+			assertions: func(t *testing.T, _ Config, err error) {
+				// Aliases containing hyphens can only be referenced using the index
+				// function.
+				assert.ErrorContains(t, err, "error parsing expression")
+			},
+		},
+		{
+			name: "invalid variable",
+			promoCtx: PromotionContext{
+				Vars: []kargoapi.PromotionVariable{
+					{Name: "foo", Value: "${{ .vars.bar }}"},
+				},
+			},
+			assertions: func(t *testing.T, _ Config, err error) {
+				assert.ErrorContains(t, err, `error evaluating variable "foo"`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewEngine(nil, nil, kargoClient, nil)
+			cfg, err := engine.evaluateConfig(
+				context.Background(),
+				tt.promoCtx,
+				state,
+				tt.cfg,
+			)
+			tt.assertions(t, cfg, err)
+		})
+	}
+}
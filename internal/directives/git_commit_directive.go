@@ -1,20 +1,45 @@
 package directives
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/xeipuuv/gojsonschema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 )
 
 func init() {
 	// Register the git-commit directive with the builtins registry.
-	builtins.RegisterDirective(newGitCommitDirective(), nil)
+	builtins.RegisterDirective(
+		newGitCommitDirective(),
+		&DirectivePermissions{
+			AllowKargoClient: true,
+		},
+	)
 }
 
+const (
+	// signingKeySecretFieldKey is the field of a Git signing key Secret that
+	// contains the signing key.
+	signingKeySecretFieldKey = "signingKey"
+	// signingKeySecretFieldType is the optional field of a Git signing key
+	// Secret that specifies the type of the signing key.
+	signingKeySecretFieldType = "type"
+	// signingKeySecretFieldName is the optional field of a Git signing key
+	// Secret that specifies the name of the committer.
+	signingKeySecretFieldName = "name"
+	// signingKeySecretFieldEmail is the optional field of a Git signing key
+	// Secret that specifies the email address of the committer.
+	signingKeySecretFieldEmail = "email"
+)
+
 // gitCommitDirective is a directive that makes a commit to a local Git
 // repository.
 type gitCommitDirective struct {
@@ -56,7 +81,7 @@ func (g *gitCommitDirective) validate(cfg Config) error {
 }
 
 func (g *gitCommitDirective) run(
-	_ context.Context,
+	ctx context.Context,
 	stepCtx *StepContext,
 	cfg GitCommitConfig,
 ) (Result, error) {
@@ -72,6 +97,9 @@ func (g *gitCommitDirective) run(
 		return Result{Status: StatusFailure},
 			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
 	}
+	if stepCtx.DryRun {
+		return g.plan(workTree)
+	}
 	if err = workTree.AddAll(); err != nil {
 		return Result{Status: StatusFailure},
 			fmt.Errorf("error adding all changes to working tree: %w", err)
@@ -82,8 +110,23 @@ func (g *gitCommitDirective) run(
 			fmt.Errorf("error building commit message: %w", err)
 	}
 	commitOpts := &git.CommitOptions{}
+	if cfg.SigningKeySecret != "" {
+		if commitOpts.Author, err = g.getSigningAuthor(
+			ctx,
+			stepCtx,
+			workTree.HomeDir(),
+			cfg.SigningKeySecret,
+		); err != nil {
+			return Result{Status: StatusFailure},
+				fmt.Errorf("error getting signing key: %w", err)
+		}
+		// The signing key is only needed for this commit
+		defer os.Remove(commitOpts.Author.SigningKeyPath)
+	}
 	if cfg.Author != nil {
-		commitOpts.Author = &git.User{}
+		if commitOpts.Author == nil {
+			commitOpts.Author = &git.User{}
+		}
 		if cfg.Author.Name != "" {
 			commitOpts.Author.Name = cfg.Author.Name
 		}
@@ -98,6 +141,105 @@ func (g *gitCommitDirective) run(
 	return Result{Status: StatusSuccess}, nil
 }
 
+// getSigningAuthor returns a commit author with the signing key, and the
+// identity, if any, contained in the Git signing key Secret with the provided
+// name in the Project's namespace. The signing key is written to a file in the
+// provided directory, which should not be part of the working tree, in a form
+// that can be used as is. The caller is responsible for removing the file.
+func (g *gitCommitDirective) getSigningAuthor(
+	ctx context.Context,
+	stepCtx *StepContext,
+	dir string,
+	secretName string,
+) (*git.User, error) {
+	secret := &corev1.Secret{}
+	if err := stepCtx.KargoClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: stepCtx.Project,
+			Name:      secretName,
+		},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			secretName, stepCtx.Project, err,
+		)
+	}
+	// Only Secrets explicitly designated as signing keys may be used, so that
+	// a Promotion cannot be used to exfiltrate the contents of other Secrets.
+	if secret.Labels[kargoapi.CredentialTypeLabelKey] !=
+		kargoapi.CredentialTypeLabelValueGitSigningKey {
+		return nil, fmt.Errorf(
+			"secret %q in namespace %q is not labeled as a Git signing key",
+			secretName, stepCtx.Project,
+		)
+	}
+	key := secret.Data[signingKeySecretFieldKey]
+	if len(key) == 0 {
+		return nil, fmt.Errorf(
+			"secret %q in namespace %q has no %q field",
+			secretName, stepCtx.Project, signingKeySecretFieldKey,
+		)
+	}
+	author := &git.User{
+		Name:           string(secret.Data[signingKeySecretFieldName]),
+		Email:          string(secret.Data[signingKeySecretFieldEmail]),
+		SigningKeyType: git.SigningKeyType(secret.Data[signingKeySecretFieldType]),
+	}
+	switch author.SigningKeyType {
+	case git.SigningKeyTypeGPG, git.SigningKeyTypeSSH:
+	case "":
+		author.SigningKeyType = git.SigningKeyTypeGPG
+	default:
+		return nil, fmt.Errorf(
+			"secret %q in namespace %q specifies unsupported signing key type %q",
+			secretName, stepCtx.Project, author.SigningKeyType,
+		)
+	}
+	// ssh-keygen rejects keys lacking a trailing newline
+	if !bytes.HasSuffix(key, []byte("\n")) {
+		key = append(key, '\n')
+	}
+	// os.CreateTemp creates files with mode 0600, which ssh-keygen requires
+	keyFile, err := os.CreateTemp(dir, "signing-key-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating signing key file: %w", err)
+	}
+	defer keyFile.Close()
+	if _, err = keyFile.Write(key); err != nil {
+		_ = os.Remove(keyFile.Name())
+		return nil, fmt.Errorf("error writing signing key to %q: %w", keyFile.Name(), err)
+	}
+	author.SigningKeyPath = keyFile.Name()
+	return author, nil
+}
+
+// plan describes the changes that would have been committed to the provided
+// working tree, without committing them.
+func (g *gitCommitDirective) plan(workTree git.WorkTree) (Result, error) {
+	diff, err := workTree.Diff()
+	if err != nil {
+		return Result{Status: StatusFailure},
+			fmt.Errorf("error diffing changes in working tree: %w", err)
+	}
+	branch, err := workTree.CurrentBranch()
+	if err != nil {
+		return Result{Status: StatusFailure},
+			fmt.Errorf("error getting current branch: %w", err)
+	}
+	return Result{
+		Status: StatusSuccess,
+		Plan: &kargoapi.PromotionPlan{
+			GitRepoChanges: []kargoapi.GitRepoChange{{
+				RepoURL: workTree.URL(),
+				Branch:  branch,
+				Diff:    diff,
+			}},
+		},
+	}, nil
+}
+
 func (g *gitCommitDirective) buildCommitMessage(
 	sharedState State,
 	cfg GitCommitConfig,
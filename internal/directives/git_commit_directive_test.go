@@ -10,7 +10,12 @@ import (
 
 	"github.com/sosedoff/gitkit"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 )
 
@@ -123,6 +128,17 @@ func TestGitCommitDirective_Validate(t *testing.T) {
 				"message": "fake commit message",
 			},
 		},
+		{
+			name: "signingKeySecret is empty string",
+			config: Config{
+				"path":             "/tmp/foo",
+				"message":          "fake commit message",
+				"signingKeySecret": "",
+			},
+			expectedProblems: []string{
+				"signingKeySecret: String length must be greater than or equal to 1",
+			},
+		},
 		{
 			name: "valid kitchen sink",
 			config: Config{
@@ -130,8 +146,9 @@ func TestGitCommitDirective_Validate(t *testing.T) {
 					"email": "tony@starkindustries.com",
 					"name":  "Tony Stark",
 				},
-				"path":    "/tmp/foo",
-				"message": "fake commit message",
+				"path":             "/tmp/foo",
+				"message":          "fake commit message",
+				"signingKeySecret": "fake-secret",
 			},
 		},
 	}
@@ -208,11 +225,33 @@ func TestGitCommitDirective_Run(t *testing.T) {
 	dir, ok := d.(*gitCommitDirective)
 	require.True(t, ok)
 
+	// A dry run should describe the changes without committing them.
+	res, err := dir.run(
+		context.Background(),
+		&StepContext{
+			WorkDir: workDir,
+			DryRun:  true,
+		},
+		GitCommitConfig{
+			Path:    "master",
+			Message: "Initial commit",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, res.Status)
+	require.NotNil(t, res.Plan)
+	require.Len(t, res.Plan.GitRepoChanges, 1)
+	require.Equal(t, testRepoURL, res.Plan.GitRepoChanges[0].RepoURL)
+	require.Equal(t, "master", res.Plan.GitRepoChanges[0].Branch)
+	require.Contains(t, res.Plan.GitRepoChanges[0].Diff, "+foo")
+	_, err = workTree.LastCommitID()
+	require.Error(t, err)
+
 	stepCtx := &StepContext{
 		WorkDir: workDir,
 	}
 
-	res, err := dir.run(
+	res, err = dir.run(
 		context.Background(),
 		stepCtx,
 		GitCommitConfig{
@@ -227,6 +266,139 @@ func TestGitCommitDirective_Run(t *testing.T) {
 	require.Equal(t, "Initial commit", lastCommitMsg)
 }
 
+func TestGitCommitDirective_GetSigningAuthor(t *testing.T) {
+	const testNamespace = "fake-project"
+	newSecret := func(name, credType string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      name,
+				Labels: map[string]string{
+					kargoapi.CredentialTypeLabelKey: credType,
+				},
+			},
+			Data: data,
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	stepCtx := &StepContext{
+		Project: testNamespace,
+		KargoClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			newSecret(
+				"not-a-signing-key",
+				"git",
+				map[string][]byte{"signingKey": []byte("fake-key")},
+			),
+			newSecret(
+				"no-key",
+				kargoapi.CredentialTypeLabelValueGitSigningKey,
+				nil,
+			),
+			newSecret(
+				"unsupported-type",
+				kargoapi.CredentialTypeLabelValueGitSigningKey,
+				map[string][]byte{
+					"signingKey": []byte("fake-key"),
+					"type":       []byte("x509"),
+				},
+			),
+			newSecret(
+				"gpg-key",
+				kargoapi.CredentialTypeLabelValueGitSigningKey,
+				map[string][]byte{"signingKey": []byte("fake-gpg-key")},
+			),
+			newSecret(
+				"ssh-key",
+				kargoapi.CredentialTypeLabelValueGitSigningKey,
+				map[string][]byte{
+					"signingKey": []byte("fake-ssh-key"),
+					"type":       []byte("ssh"),
+					"name":       []byte("Tony Stark"),
+					"email":      []byte("tony@starkindustries.com"),
+				},
+			),
+		).Build(),
+	}
+
+	testCases := []struct {
+		name       string
+		secretName string
+		assertions func(*testing.T, *git.User, error)
+	}{
+		{
+			name:       "Secret not found",
+			secretName: "missing",
+			assertions: func(t *testing.T, _ *git.User, err error) {
+				require.ErrorContains(t, err, "error getting Secret")
+			},
+		},
+		{
+			name:       "Secret not labeled as a signing key",
+			secretName: "not-a-signing-key",
+			assertions: func(t *testing.T, _ *git.User, err error) {
+				require.ErrorContains(t, err, "is not labeled as a Git signing key")
+			},
+		},
+		{
+			name:       "Secret has no signing key",
+			secretName: "no-key",
+			assertions: func(t *testing.T, _ *git.User, err error) {
+				require.ErrorContains(t, err, `has no "signingKey" field`)
+			},
+		},
+		{
+			name:       "unsupported signing key type",
+			secretName: "unsupported-type",
+			assertions: func(t *testing.T, _ *git.User, err error) {
+				require.ErrorContains(t, err, `unsupported signing key type "x509"`)
+			},
+		},
+		{
+			name:       "signing key type defaults to GPG",
+			secretName: "gpg-key",
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Empty(t, author.Name)
+				require.Empty(t, author.Email)
+				require.Equal(t, git.SigningKeyTypeGPG, author.SigningKeyType)
+				key, err := os.ReadFile(author.SigningKeyPath)
+				require.NoError(t, err)
+				require.Equal(t, "fake-gpg-key\n", string(key))
+			},
+		},
+		{
+			name:       "SSH signing key with identity",
+			secretName: "ssh-key",
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Tony Stark", author.Name)
+				require.Equal(t, "tony@starkindustries.com", author.Email)
+				require.Equal(t, git.SigningKeyTypeSSH, author.SigningKeyType)
+				fi, err := os.Stat(author.SigningKeyPath)
+				require.NoError(t, err)
+				require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+				key, err := os.ReadFile(author.SigningKeyPath)
+				require.NoError(t, err)
+				require.Equal(t, "fake-ssh-key\n", string(key))
+			},
+		},
+	}
+	d := &gitCommitDirective{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			author, err := d.getSigningAuthor(
+				context.Background(),
+				stepCtx,
+				t.TempDir(),
+				testCase.secretName,
+			)
+			testCase.assertions(t, author, err)
+		})
+	}
+}
+
 func TestBuildCommitMessage(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -2,6 +2,7 @@ package directives
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/xeipuuv/gojsonschema"
@@ -65,6 +66,10 @@ func (g *gitOpenPRDirective) run(
 	stepCtx *StepContext,
 	cfg GitOpenPRConfig,
 ) (Result, error) {
+	if stepCtx.DryRun {
+		// No PR is opened as part of a dry run.
+		return Result{Status: StatusSuccess}, nil
+	}
 	sourceBranch, err := getSourceBranch(stepCtx.SharedState, cfg)
 	if err != nil {
 		return Result{Status: StatusFailure},
@@ -121,8 +126,11 @@ func (g *gitOpenPRDirective) run(
 			fmt.Errorf("error creating git provider service: %w", err)
 	}
 
-	// Short-circuit if a pull request already exists with the same head commit
-	mustOpen, err := mustOpenPR(
+	// Short-circuit if a pull request already exists with the same head commit.
+	// This happens when the step is retried after the pull request was opened,
+	// so its number is still output and, while it is open, superseded pull
+	// requests that may not have been closed before are closed now.
+	pr, err := getExistingPR(
 		ctx,
 		repo,
 		gitProviderSvc,
@@ -133,8 +141,16 @@ func (g *gitOpenPRDirective) run(
 		return Result{Status: StatusFailure},
 			fmt.Errorf("error determining if pull request must be opened: %w", err)
 	}
-	if !mustOpen {
-		return Result{Status: StatusSuccess}, nil
+	if pr != nil {
+		if !pr.IsOpen() {
+			return Result{
+				Status: StatusSuccess,
+				Output: State{
+					prNumberKey: pr.Number,
+				},
+			}, nil
+		}
+		return g.finish(ctx, gitProviderSvc, pr, cfg)
 	}
 
 	// Get the title from the commit message of the head of the source branch
@@ -159,18 +175,45 @@ func (g *gitOpenPRDirective) run(
 		)
 	}
 
-	pr, err := gitProviderSvc.CreatePullRequest(
+	if pr, err = gitProviderSvc.CreatePullRequest(
 		ctx,
 		gitprovider.CreatePullRequestOpts{
-			Head:  cfg.SourceBranch,
-			Base:  cfg.TargetBranch,
-			Title: title,
+			Head:      cfg.SourceBranch,
+			Base:      cfg.TargetBranch,
+			Title:     title,
+			Labels:    cfg.Labels,
+			Assignees: cfg.Assignees,
+			Reviewers: cfg.Reviewers,
+			AutoMerge: cfg.AutoMerge,
 		},
-	)
-	if err != nil {
+	); err != nil {
 		return Result{Status: StatusFailure},
 			fmt.Errorf("error creating pull request: %w", err)
 	}
+	return g.finish(ctx, gitProviderSvc, pr, cfg)
+}
+
+// finish closes the pull requests superseded by the provided one, if so
+// configured, and returns a successful Result holding the number of the
+// provided pull request.
+func (g *gitOpenPRDirective) finish(
+	ctx context.Context,
+	gitProviderSvc gitprovider.GitProviderService,
+	pr *gitprovider.PullRequest,
+	cfg GitOpenPRConfig,
+) (Result, error) {
+	if cfg.CloseSuperseded {
+		if err := closeSupersededPRs(
+			ctx,
+			gitProviderSvc,
+			pr,
+			cfg.TargetBranch,
+			cfg.Labels,
+		); err != nil {
+			return Result{Status: StatusFailure},
+				fmt.Errorf("error closing superseded pull requests: %w", err)
+		}
+	}
 	return Result{
 		Status: StatusSuccess,
 		Output: State{
@@ -256,20 +299,21 @@ func ensureRemoteTargetBranch(repo git.Repo, branch string, create bool) error {
 	return nil
 }
 
-// mustOpenPR determines if a pull request must be opened. It returns true if no
-// PR exists for the head commit of the source branch to the target branch.
-// Whether the PR is open or closed is irrelevant as we must NOT create a new PR
-// if one already exists for the same head commit and has already been closed.
-func mustOpenPR(
+// getExistingPR returns the pull request for the head commit of the source
+// branch to the target branch, if one exists. If it returns nil, a pull request
+// must be opened. Whether the PR is open or closed is irrelevant as we must NOT
+// create a new PR if one already exists for the same head commit and has
+// already been closed.
+func getExistingPR(
 	ctx context.Context,
 	repo git.Repo,
 	gitProviderSvc gitprovider.GitProviderService,
 	sourceBranch,
 	targetBranch string,
-) (bool, error) {
+) (*gitprovider.PullRequest, error) {
 	commitID, err := repo.LastCommitID()
 	if err != nil {
-		return false, fmt.Errorf("error getting last commit ID: %w", err)
+		return nil, fmt.Errorf("error getting last commit ID: %w", err)
 	}
 	prs, err := gitProviderSvc.ListPullRequests(
 		ctx,
@@ -279,15 +323,57 @@ func mustOpenPR(
 		},
 	)
 	if err != nil {
-		return false, fmt.Errorf("error listing pull requests: %w", err)
-	}
-	if len(prs) == 0 {
-		return true, nil
+		return nil, fmt.Errorf("error listing pull requests: %w", err)
 	}
 	for _, pr := range prs {
 		if pr.HeadSHA == commitID {
-			return false, nil
+			return pr, nil
 		}
 	}
-	return true, nil
+	return nil, nil
+}
+
+// closeSupersededPRs closes all open pull requests to the target branch that
+// have all the given labels, with the exception of the given, newly opened pull
+// request. Before each is closed, a comment linking to the new pull request is
+// added to it.
+func closeSupersededPRs(
+	ctx context.Context,
+	gitProviderSvc gitprovider.GitProviderService,
+	newPR *gitprovider.PullRequest,
+	targetBranch string,
+	labels []string,
+) error {
+	// Without any labels to go by, every open pull request to the target branch
+	// would be considered superseded.
+	if len(labels) == 0 {
+		return errors.New("labels must be specified to identify superseded pull requests")
+	}
+	prs, err := gitProviderSvc.ListPullRequests(
+		ctx,
+		gitprovider.ListPullRequestOpts{
+			State:  gitprovider.PullRequestStateOpen,
+			Base:   targetBranch,
+			Labels: labels,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error listing pull requests: %w", err)
+	}
+	for _, pr := range prs {
+		if pr.Number == newPR.Number {
+			continue
+		}
+		if err = gitProviderSvc.CommentOnPullRequest(
+			ctx,
+			pr.Number,
+			fmt.Sprintf("Superseded by %s", newPR.URL),
+		); err != nil {
+			return fmt.Errorf("error commenting on pull request %d: %w", pr.Number, err)
+		}
+		if err = gitProviderSvc.ClosePullRequest(ctx, pr.Number); err != nil {
+			return fmt.Errorf("error closing pull request %d: %w", pr.Number, err)
+		}
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http/httptest"
+	"slices"
 	"testing"
 
 	"github.com/sosedoff/gitkit"
@@ -79,6 +80,34 @@ func TestGitOpenPRDirective_Validate(t *testing.T) {
 				"provider: provider must be one of the following:",
 			},
 		},
+		{
+			name: "closeSuperseded without labels",
+			config: Config{
+				"closeSuperseded": true,
+			},
+			expectedProblems: []string{
+				"(root): labels is required",
+			},
+		},
+		{
+			name: "closeSuperseded with empty labels",
+			config: Config{
+				"closeSuperseded": true,
+				"labels":          []string{},
+			},
+			expectedProblems: []string{
+				"labels: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "label is empty string",
+			config: Config{
+				"labels": []string{""},
+			},
+			expectedProblems: []string{
+				"labels.0: String length must be greater than or equal to 1",
+			},
+		},
 		{
 			name: "valid without explicit provider",
 			config: Config{
@@ -96,6 +125,19 @@ func TestGitOpenPRDirective_Validate(t *testing.T) {
 				"targetBranch": "another-fake-branch",
 			},
 		},
+		{
+			name: "valid with pull request options",
+			config: Config{
+				"repoURL":         "https://github.com/example/repo.git",
+				"sourceBranch":    "fake-branch",
+				"targetBranch":    "another-fake-branch",
+				"labels":          []string{"kargo"},
+				"assignees":       []string{"alice"},
+				"reviewers":       []string{"bob"},
+				"autoMerge":       true,
+				"closeSuperseded": true,
+			},
+		},
 		{
 			name: "valid with source branch from push",
 			config: Config{
@@ -158,6 +200,8 @@ func TestGitOpenPRDirective_Run(t *testing.T) {
 	// Set up a fake git provider
 	const fakeGitProviderName = "fake"
 	const testPRNumber int64 = 42
+	var existingPR *gitprovider.PullRequest
+	var closedPRs []int64
 	gitprovider.RegisterProvider(
 		fakeGitProviderName,
 		gitprovider.ProviderRegistration{
@@ -167,17 +211,35 @@ func TestGitOpenPRDirective_Run(t *testing.T) {
 			) (gitprovider.GitProviderService, error) {
 				return &gitprovider.FakeGitProviderService{
 					ListPullRequestsFn: func(
-						context.Context,
-						gitprovider.ListPullRequestOpts,
+						_ context.Context,
+						opts gitprovider.ListPullRequestOpts,
 					) ([]*gitprovider.PullRequest, error) {
-						// Avoid opening of a PR being short-circuited by simulating
-						// conditions where the PR in question doesn't already exist.
-						return nil, nil
+						if len(opts.Labels) > 0 {
+							// Superseded pull requests
+							return []*gitprovider.PullRequest{{Number: 1}, existingPR}, nil
+						}
+						// Unless existingPR is set, avoid opening of a PR being
+						// short-circuited by simulating conditions where the PR in
+						// question doesn't already exist.
+						if existingPR == nil {
+							return nil, nil
+						}
+						return []*gitprovider.PullRequest{existingPR}, nil
+					},
+					CommentOnPullRequestFn: func(context.Context, int64, string) error {
+						return nil
+					},
+					ClosePullRequestFn: func(_ context.Context, number int64) error {
+						closedPRs = append(closedPRs, number)
+						return nil
 					},
 					CreatePullRequestFn: func(
-						context.Context,
-						gitprovider.CreatePullRequestOpts,
+						_ context.Context,
+						opts gitprovider.CreatePullRequestOpts,
 					) (*gitprovider.PullRequest, error) {
+						if !slices.Equal(opts.Labels, []string{"kargo"}) || !opts.AutoMerge {
+							return nil, fmt.Errorf("unexpected options: %+v", opts)
+						}
 						return &gitprovider.PullRequest{Number: testPRNumber}, nil
 					},
 				}, nil
@@ -211,6 +273,8 @@ func TestGitOpenPRDirective_Run(t *testing.T) {
 			TargetBranch:         testTargetBranch,
 			CreateTargetBranch:   true,
 			Provider:             ptr.To(Provider(fakeGitProviderName)),
+			Labels:               []string{"kargo"},
+			AutoMerge:            true,
 		},
 	)
 	require.NoError(t, err)
@@ -222,4 +286,150 @@ func TestGitOpenPRDirective_Run(t *testing.T) {
 	exists, err := repo.RemoteBranchExists(testTargetBranch)
 	require.NoError(t, err)
 	require.True(t, exists)
+
+	// Running again once the PR exists should output its number and close the
+	// PRs it supersedes without opening another one
+	headSHA, err := repo.LastCommitID()
+	require.NoError(t, err)
+	existingPR = &gitprovider.PullRequest{
+		Number:  testPRNumber,
+		State:   gitprovider.PullRequestStateOpen,
+		HeadSHA: headSHA,
+	}
+	res, err = dir.run(
+		context.Background(),
+		&StepContext{
+			Project:       "fake-project",
+			Stage:         "fake-stage",
+			WorkDir:       workDir,
+			CredentialsDB: &credentials.FakeDB{},
+		},
+		GitOpenPRConfig{
+			RepoURL:         testRepoURL,
+			SourceBranch:    testSourceBranch,
+			TargetBranch:    testTargetBranch,
+			Provider:        ptr.To(Provider(fakeGitProviderName)),
+			Labels:          []string{"other"},
+			CloseSuperseded: true,
+		},
+	)
+	require.NoError(t, err)
+	prNumber, ok = res.Output.Get(prNumberKey)
+	require.True(t, ok)
+	require.Equal(t, testPRNumber, prNumber)
+	require.Equal(t, []int64{1}, closedPRs)
+}
+
+func Test_closeSupersededPRs(t *testing.T) {
+	newPR := &gitprovider.PullRequest{
+		Number: 2,
+		URL:    "https://github.com/example/repo/pull/2",
+	}
+	testCases := []struct {
+		name       string
+		labels     []string
+		svc        func(*[]string) gitprovider.GitProviderService
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name: "no labels",
+			svc: func(*[]string) gitprovider.GitProviderService {
+				return &gitprovider.FakeGitProviderService{}
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "labels must be specified")
+			},
+		},
+		{
+			name:   "error listing pull requests",
+			labels: []string{"kargo"},
+			svc: func(*[]string) gitprovider.GitProviderService {
+				return &gitprovider.FakeGitProviderService{
+					ListPullRequestsFn: func(
+						context.Context,
+						gitprovider.ListPullRequestOpts,
+					) ([]*gitprovider.PullRequest, error) {
+						return nil, fmt.Errorf("something went wrong")
+					},
+				}
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error listing pull requests")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "error closing pull request",
+			labels: []string{"kargo"},
+			svc: func(*[]string) gitprovider.GitProviderService {
+				return &gitprovider.FakeGitProviderService{
+					ListPullRequestsFn: func(
+						context.Context,
+						gitprovider.ListPullRequestOpts,
+					) ([]*gitprovider.PullRequest, error) {
+						return []*gitprovider.PullRequest{{Number: 1}}, nil
+					},
+					CommentOnPullRequestFn: func(context.Context, int64, string) error {
+						return nil
+					},
+					ClosePullRequestFn: func(context.Context, int64) error {
+						return fmt.Errorf("something went wrong")
+					},
+				}
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error closing pull request 1")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "success",
+			labels: []string{"kargo"},
+			svc: func(calls *[]string) gitprovider.GitProviderService {
+				return &gitprovider.FakeGitProviderService{
+					ListPullRequestsFn: func(
+						_ context.Context,
+						opts gitprovider.ListPullRequestOpts,
+					) ([]*gitprovider.PullRequest, error) {
+						if opts.Base != "main" || !slices.Equal(opts.Labels, []string{"kargo"}) {
+							return nil, fmt.Errorf("unexpected options: %+v", opts)
+						}
+						return []*gitprovider.PullRequest{{Number: 1}, newPR}, nil
+					},
+					CommentOnPullRequestFn: func(_ context.Context, number int64, body string) error {
+						*calls = append(*calls, fmt.Sprintf("comment %d: %s", number, body))
+						return nil
+					},
+					ClosePullRequestFn: func(_ context.Context, number int64) error {
+						*calls = append(*calls, fmt.Sprintf("close %d", number))
+						return nil
+					},
+				}
+			},
+			assertions: func(t *testing.T, calls []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"comment 1: Superseded by https://github.com/example/repo/pull/2",
+						"close 1",
+					},
+					calls,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls []string
+			err := closeSupersededPRs(
+				context.Background(),
+				testCase.svc(&calls),
+				newPR,
+				"main",
+				testCase.labels,
+			)
+			testCase.assertions(t, calls, err)
+		})
+	}
 }
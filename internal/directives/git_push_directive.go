@@ -122,9 +122,13 @@ func (g *gitPushDirective) run(
 				fmt.Errorf("error getting current branch: %w", err)
 		}
 	}
-	if err := workTree.Push(pushOpts); err != nil {
-		return Result{Status: StatusFailure},
-			fmt.Errorf("error pushing commits to remote: %w", err)
+	// Nothing is pushed as part of a dry run, but the branch that would have
+	// been pushed to is still returned for the benefit of subsequent steps.
+	if !stepCtx.DryRun {
+		if err := workTree.Push(pushOpts); err != nil {
+			return Result{Status: StatusFailure},
+				fmt.Errorf("error pushing commits to remote: %w", err)
+		}
 	}
 	return Result{
 		Status: StatusSuccess,
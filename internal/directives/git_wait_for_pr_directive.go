@@ -64,6 +64,11 @@ func (g *gitWaitForPRDirective) run(
 	stepCtx *StepContext,
 	cfg GitWaitForPRConfig,
 ) (Result, error) {
+	if stepCtx.DryRun {
+		// No PR was opened as part of a dry run, so there is nothing to wait
+		// for.
+		return Result{Status: StatusSuccess}, nil
+	}
 	prNumber, err := getPRNumber(stepCtx.SharedState, cfg)
 	if err != nil {
 		return Result{Status: StatusFailure},
@@ -144,7 +149,7 @@ func getPRNumber(sharedState State, cfg GitWaitForPRConfig) (int64, error) {
 				cfg.PRNumberFromOpen,
 			)
 		}
-		prNumberAny, exists := stepOutputState.Get(branchKey)
+		prNumberAny, exists := stepOutputState.Get(prNumberKey)
 		if !exists {
 			return 0, fmt.Errorf(
 				"no PR number found in output from step with alias %q",
@@ -99,9 +99,26 @@ func TestGitWaitForPRDirective_Validate(t *testing.T) {
 func TestGitWaitForPRDirective__Run(t *testing.T) {
 	testCases := []struct {
 		name       string
+		dryRun     bool
 		provider   gitprovider.GitProviderService
 		assertions func(*testing.T, Result, error)
 	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			provider: &gitprovider.FakeGitProviderService{
+				GetPullRequestFn: func(
+					context.Context,
+					int64,
+				) (*gitprovider.PullRequest, error) {
+					return nil, errors.New("GetPullRequestFn should not be called")
+				},
+			},
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusSuccess, res.Status)
+			},
+		},
 		{
 			name: "error finding PR",
 			provider: &gitprovider.FakeGitProviderService{
@@ -224,6 +241,7 @@ func TestGitWaitForPRDirective__Run(t *testing.T) {
 			res, err := dir.run(
 				context.Background(),
 				&StepContext{
+					DryRun:        testCase.dryRun,
 					CredentialsDB: &credentials.FakeDB{},
 				},
 				GitWaitForPRConfig{
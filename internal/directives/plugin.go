@@ -0,0 +1,351 @@
+package directives
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/net/http2"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+
+	"github.com/akuity/kargo/internal/credentials"
+	pluginapi "github.com/akuity/kargo/pkg/api/plugin/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/plugin/v1alpha1/pluginv1alpha1connect"
+)
+
+// PluginSocketEnvVar is the name of the environment variable through which a
+// plugin binary started by the controller is informed of the path of the Unix
+// domain socket it is expected to serve the plugin protocol on.
+const PluginSocketEnvVar = "KARGO_PLUGIN_SOCKET"
+
+// pluginStartupTimeout is the maximum amount of time to wait for a plugin to
+// start serving the plugin protocol.
+const pluginStartupTimeout = 30 * time.Second
+
+// PluginsConfig is the configuration of all out-of-process directive plugins
+// known to the directive execution engine.
+type PluginsConfig struct {
+	// Plugins is the list of plugins to register.
+	Plugins []PluginConfig `json:"plugins,omitempty"`
+}
+
+// PluginConfig is the configuration of a single out-of-process directive
+// plugin. A plugin implements the DirectivePluginService and serves it over
+// gRPC. The name of the directive implemented by the plugin is obtained from
+// the plugin itself.
+type PluginConfig struct {
+	// Address is the address of a plugin that is already running, e.g. as a
+	// sidecar of the controller. An address of the form unix:///path/to/socket
+	// refers to a Unix domain socket. This field is mutually exclusive with the
+	// Command field.
+	Address string `json:"address,omitempty"`
+	// Command is the path of a local plugin binary to be started by the
+	// controller. The binary is expected to serve the plugin protocol on the
+	// Unix domain socket whose path it is provided with through the
+	// KARGO_PLUGIN_SOCKET environment variable. This field is mutually exclusive
+	// with the Address field.
+	Command string `json:"command,omitempty"`
+	// Args are the arguments to start the local plugin binary with.
+	Args []string `json:"args,omitempty"`
+	// AllowCredentials indicates whether the plugin may receive the credentials
+	// listed in the Credentials field.
+	AllowCredentials bool `json:"allowCredentials,omitempty"`
+	// Credentials lists the credentials that are sent to the plugin along with
+	// every step it executes, provided the plugin is allowed to receive
+	// credentials and the credentials exist in the Project of the Promotion.
+	Credentials []PluginCredentials `json:"credentials,omitempty"`
+}
+
+// PluginCredentials identifies credentials that may be sent to a plugin.
+type PluginCredentials struct {
+	// Type is the type of the credentials.
+	Type credentials.Type `json:"type"`
+	// RepoURL is the URL of the repository the credentials are for.
+	RepoURL string `json:"repoURL"`
+}
+
+// LoadPluginsConfig loads the configuration of out-of-process directive
+// plugins from the YAML file at the specified path.
+func LoadPluginsConfig(path string) (PluginsConfig, error) {
+	var cfg PluginsConfig
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("error reading plugins configuration: %w", err)
+	}
+	if err = yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("error parsing plugins configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// RegisterPlugin registers the out-of-process directive plugin described by
+// the provided PluginConfig. If the plugin is a local binary, it is started
+// and kept running until the provided context is canceled. An error is
+// returned if a Directive with the same name as the one implemented by the
+// plugin has already been registered, as a plugin must never take the place,
+// and thereby the permissions, of a builtin Directive or of another plugin.
+func (r DirectiveRegistry) RegisterPlugin(
+	ctx context.Context,
+	cfg PluginConfig,
+) error {
+	address := cfg.Address
+	switch {
+	case cfg.Address != "" && cfg.Command != "":
+		return errors.New("plugin address and command are mutually exclusive")
+	case cfg.Command != "":
+		var err error
+		if address, err = startPlugin(ctx, cfg.Command, cfg.Args); err != nil {
+			return err
+		}
+	case cfg.Address == "":
+		return errors.New("one of plugin address or command must be specified")
+	}
+	directive, err := newPluginDirective(ctx, address, cfg.Credentials)
+	if err != nil {
+		return err
+	}
+	if _, exists := r[directive.Name()]; exists {
+		return fmt.Errorf(
+			"plugin at %q implements directive %q, which is already registered",
+			address, directive.Name(),
+		)
+	}
+	r.RegisterDirective(
+		directive,
+		&DirectivePermissions{AllowCredentialsDB: cfg.AllowCredentials},
+	)
+	return nil
+}
+
+// startPlugin starts the local plugin binary at the specified path and
+// returns the address it serves the plugin protocol on. The plugin is
+// terminated when the provided context is canceled.
+func startPlugin(ctx context.Context, command string, args []string) (string, error) {
+	dir, err := os.MkdirTemp("", "plugin-")
+	if err != nil {
+		return "", fmt.Errorf(
+			"error creating socket directory for plugin %q: %w", command, err,
+		)
+	}
+	socket := filepath.Join(dir, "plugin.sock")
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Env = append(os.Environ(), PluginSocketEnvVar+"="+socket)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err = cmd.Start(); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", fmt.Errorf("error starting plugin %q: %w", command, err)
+	}
+	go func() {
+		_ = cmd.Wait()
+		_ = os.RemoveAll(dir)
+	}()
+	return "unix://" + socket, nil
+}
+
+// pluginDirective is a Directive that delegates its execution to an
+// out-of-process plugin.
+type pluginDirective struct {
+	name        string
+	client      pluginv1alpha1connect.DirectivePluginServiceClient
+	credentials []PluginCredentials
+}
+
+// newPluginDirective creates a Directive for the plugin serving the plugin
+// protocol at the specified address. It waits for the plugin to become
+// available and obtains the name of the directive it implements.
+func newPluginDirective(
+	ctx context.Context,
+	address string,
+	creds []PluginCredentials,
+) (Directive, error) {
+	httpClient, baseURL := newPluginHTTPClient(address)
+	p := &pluginDirective{
+		client: pluginv1alpha1connect.NewDirectivePluginServiceClient(
+			httpClient,
+			baseURL,
+			connect.WithGRPC(),
+		),
+		credentials: creds,
+	}
+	var lastErr error
+	if err := wait.PollUntilContextTimeout(
+		ctx,
+		time.Second,
+		pluginStartupTimeout,
+		true,
+		func(ctx context.Context) (bool, error) {
+			res, err := p.client.GetName(
+				ctx,
+				connect.NewRequest(&pluginapi.GetNameRequest{}),
+			)
+			if err != nil {
+				lastErr = err
+				return false, nil
+			}
+			p.name = res.Msg.Name
+			return true, nil
+		},
+	); err != nil {
+		if lastErr != nil {
+			err = lastErr
+		}
+		return nil, fmt.Errorf(
+			"error getting directive name from plugin at %q: %w", address, err,
+		)
+	}
+	if p.name == "" {
+		return nil, fmt.Errorf("plugin at %q returned an empty directive name", address)
+	}
+	return p, nil
+}
+
+// newPluginHTTPClient returns an HTTP/2 client without TLS, as is used by
+// gRPC, for the specified plugin address, along with the base URL of the
+// plugin. Plugins are expected to run alongside the controller, which is why
+// TLS is not used.
+func newPluginHTTPClient(address string) (*http.Client, string) {
+	dial := func(ctx context.Context, _, addr string, _ *tls.Config) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+	baseURL := address
+	if socket, ok := strings.CutPrefix(address, "unix://"); ok {
+		baseURL = "http://localhost"
+		dial = func(ctx context.Context, _, _ string, _ *tls.Config) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+	} else if !strings.Contains(address, "://") {
+		baseURL = "http://" + address
+	}
+	return &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP:      true,
+			DialTLSContext: dial,
+		},
+	}, baseURL
+}
+
+// Name implements the Directive interface.
+func (p *pluginDirective) Name() string {
+	return p.name
+}
+
+// Run implements the Directive interface.
+func (p *pluginDirective) Run(
+	ctx context.Context,
+	stepCtx *StepContext,
+) (Result, error) {
+	pluginStepCtx, err := p.buildStepContext(ctx, stepCtx)
+	if err != nil {
+		return Result{Status: StatusFailure}, err
+	}
+	res, err := p.client.Run(
+		ctx,
+		connect.NewRequest(&pluginapi.RunRequest{StepContext: pluginStepCtx}),
+	)
+	if err != nil {
+		return Result{Status: StatusFailure},
+			fmt.Errorf("error running plugin for directive %q: %w", p.name, err)
+	}
+	var status Status
+	switch res.Msg.Status {
+	case pluginapi.Status_STATUS_SUCCESS:
+		status = StatusSuccess
+	case pluginapi.Status_STATUS_PENDING:
+		status = StatusPending
+	case pluginapi.Status_STATUS_FAILURE:
+		status = StatusFailure
+	default:
+		return Result{Status: StatusFailure}, fmt.Errorf(
+			"plugin for directive %q returned unknown status %q",
+			p.name, res.Msg.Status,
+		)
+	}
+	var output State
+	if len(res.Msg.Output) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(res.Msg.Output))
+		dec.UseNumber()
+		var m map[string]any
+		if err = dec.Decode(&m); err != nil {
+			return Result{Status: StatusFailure}, fmt.Errorf(
+				"error unmarshaling output of plugin for directive %q: %w", p.name, err,
+			)
+		}
+		output = State(normalizeJSONValue(m).(map[string]any)) // nolint: forcetypeassert
+	}
+	return Result{Status: status, Output: output}, nil
+}
+
+// buildStepContext serializes the provided StepContext for the plugin. The
+// credentials the plugin has been configured to receive are only included if
+// the StepContext provides access to the credentials database, i.e. if the
+// plugin has been permitted access to credentials.
+func (p *pluginDirective) buildStepContext(
+	ctx context.Context,
+	stepCtx *StepContext,
+) (*pluginapi.StepContext, error) {
+	pluginStepCtx := &pluginapi.StepContext{
+		WorkDir: stepCtx.WorkDir,
+		Alias:   stepCtx.Alias,
+		Project: stepCtx.Project,
+		Stage:   stepCtx.Stage,
+		DryRun:  stepCtx.DryRun,
+	}
+	var err error
+	if pluginStepCtx.SharedState, err = json.Marshal(stepCtx.SharedState); err != nil {
+		return nil, fmt.Errorf("error marshaling shared state: %w", err)
+	}
+	if pluginStepCtx.Config, err = json.Marshal(stepCtx.Config); err != nil {
+		return nil, fmt.Errorf("error marshaling step configuration: %w", err)
+	}
+	if pluginStepCtx.FreightRequests, err = json.Marshal(stepCtx.FreightRequests); err != nil {
+		return nil, fmt.Errorf("error marshaling Freight requests: %w", err)
+	}
+	if pluginStepCtx.Freight, err = json.Marshal(stepCtx.Freight); err != nil {
+		return nil, fmt.Errorf("error marshaling Freight: %w", err)
+	}
+	if stepCtx.CredentialsDB == nil {
+		return pluginStepCtx, nil
+	}
+	for _, ref := range p.credentials {
+		creds, found, err := stepCtx.CredentialsDB.Get(
+			ctx,
+			stepCtx.Project,
+			ref.Type,
+			ref.RepoURL,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting %s credentials for %q: %w", ref.Type, ref.RepoURL, err,
+			)
+		}
+		if !found {
+			continue
+		}
+		pluginStepCtx.Credentials = append(
+			pluginStepCtx.Credentials,
+			&pluginapi.Credentials{
+				Type:          ref.Type.String(),
+				RepoUrl:       ref.RepoURL,
+				Username:      creds.Username,
+				Password:      creds.Password,
+				SshPrivateKey: creds.SSHPrivateKey,
+			},
+		)
+	}
+	return pluginStepCtx, nil
+}
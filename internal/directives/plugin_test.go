@@ -0,0 +1,351 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/akuity/kargo/internal/credentials"
+	pluginapi "github.com/akuity/kargo/pkg/api/plugin/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/plugin/v1alpha1/pluginv1alpha1connect"
+)
+
+// testPluginService is a DirectivePluginService implementing a directive that
+// echoes its configuration, along with the repository URLs of the
+// credentials it received, as its output.
+type testPluginService struct {
+	status pluginapi.Status
+	err    error
+}
+
+func (s *testPluginService) GetName(
+	context.Context,
+	*connect.Request[pluginapi.GetNameRequest],
+) (*connect.Response[pluginapi.GetNameResponse], error) {
+	return connect.NewResponse(&pluginapi.GetNameResponse{Name: "echo"}), nil
+}
+
+func (s *testPluginService) Run(
+	_ context.Context,
+	req *connect.Request[pluginapi.RunRequest],
+) (*connect.Response[pluginapi.RunResponse], error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	var cfg map[string]any
+	if err := json.Unmarshal(req.Msg.StepContext.Config, &cfg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	repoURLs := []string{}
+	for _, creds := range req.Msg.StepContext.Credentials {
+		repoURLs = append(repoURLs, creds.RepoUrl)
+	}
+	output, err := json.Marshal(map[string]any{
+		"config":      cfg,
+		"credentials": repoURLs,
+		"stage":       req.Msg.StepContext.Stage,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	status := s.status
+	if status == pluginapi.Status_STATUS_UNSPECIFIED {
+		status = pluginapi.Status_STATUS_SUCCESS
+	}
+	return connect.NewResponse(&pluginapi.RunResponse{
+		Status: status,
+		Output: output,
+	}), nil
+}
+
+func newTestPluginHandler(svc *testPluginService) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(pluginv1alpha1connect.NewDirectivePluginServiceHandler(svc))
+	return h2c.NewHandler(mux, &http2.Server{})
+}
+
+// TestPluginHelperProcess is not a real test. It serves the test plugin when
+// the test binary is started as a local plugin binary by RegisterPlugin.
+func TestPluginHelperProcess(*testing.T) {
+	socket := os.Getenv(PluginSocketEnvVar)
+	if socket == "" {
+		return
+	}
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		os.Exit(1)
+	}
+	_ = http.Serve(lis, newTestPluginHandler(&testPluginService{})) // nolint: gosec
+	os.Exit(0)
+}
+
+func TestLoadPluginsConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     string
+		assertions func(*testing.T, PluginsConfig, error)
+	}{
+		{
+			name:   "unknown field",
+			config: "plugins:\n- adress: localhost:9090\n",
+			assertions: func(t *testing.T, _ PluginsConfig, err error) {
+				require.ErrorContains(t, err, "error parsing plugins configuration")
+			},
+		},
+		{
+			name: "success",
+			config: `plugins:
+- address: localhost:9090
+  allowCredentials: true
+  credentials:
+  - type: git
+    repoURL: https://github.com/example/repo
+- command: /usr/local/bin/plugin
+  args: ["--verbose"]
+`,
+			assertions: func(t *testing.T, cfg PluginsConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					PluginsConfig{
+						Plugins: []PluginConfig{
+							{
+								Address:          "localhost:9090",
+								AllowCredentials: true,
+								Credentials: []PluginCredentials{{
+									Type:    credentials.TypeGit,
+									RepoURL: "https://github.com/example/repo",
+								}},
+							},
+							{
+								Command: "/usr/local/bin/plugin",
+								Args:    []string{"--verbose"},
+							},
+						},
+					},
+					cfg,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "plugins.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(testCase.config), 0o600))
+			cfg, err := LoadPluginsConfig(path)
+			testCase.assertions(t, cfg, err)
+		})
+	}
+}
+
+func TestDirectiveRegistry_RegisterPlugin(t *testing.T) {
+	srv := httptest.NewServer(newTestPluginHandler(&testPluginService{}))
+	t.Cleanup(srv.Close)
+
+	testCases := []struct {
+		name       string
+		registry   DirectiveRegistry
+		cfg        PluginConfig
+		assertions func(*testing.T, DirectiveRegistry, error)
+	}{
+		{
+			name: "address and command specified",
+			cfg: PluginConfig{
+				Address: srv.URL,
+				Command: os.Args[0],
+			},
+			assertions: func(t *testing.T, _ DirectiveRegistry, err error) {
+				require.ErrorContains(t, err, "mutually exclusive")
+			},
+		},
+		{
+			name: "neither address nor command specified",
+			assertions: func(t *testing.T, _ DirectiveRegistry, err error) {
+				require.ErrorContains(t, err, "must be specified")
+			},
+		},
+		{
+			name: "directive already registered",
+			registry: func() DirectiveRegistry {
+				r := DirectiveRegistry{}
+				r.RegisterDirective(
+					&mockDirective{name: "echo"},
+					&DirectivePermissions{AllowKargoClient: true},
+				)
+				return r
+			}(),
+			cfg: PluginConfig{
+				Address:          srv.URL,
+				AllowCredentials: true,
+			},
+			assertions: func(t *testing.T, r DirectiveRegistry, err error) {
+				require.ErrorContains(t, err, `directive "echo", which is already registered`)
+				reg, err := r.GetDirectiveRegistration("echo")
+				require.NoError(t, err)
+				require.IsType(t, &mockDirective{}, reg.Directive)
+				require.False(t, reg.Permissions.AllowCredentialsDB)
+			},
+		},
+		{
+			name: "plugin at address",
+			cfg: PluginConfig{
+				Address:          srv.URL,
+				AllowCredentials: true,
+			},
+			assertions: func(t *testing.T, r DirectiveRegistry, err error) {
+				require.NoError(t, err)
+				reg, err := r.GetDirectiveRegistration("echo")
+				require.NoError(t, err)
+				require.Equal(t, "echo", reg.Directive.Name())
+				require.True(t, reg.Permissions.AllowCredentialsDB)
+			},
+		},
+		{
+			name: "local plugin binary",
+			cfg: PluginConfig{
+				Command: os.Args[0],
+				Args:    []string{"-test.run=^TestPluginHelperProcess$"},
+			},
+			assertions: func(t *testing.T, r DirectiveRegistry, err error) {
+				require.NoError(t, err)
+				reg, err := r.GetDirectiveRegistration("echo")
+				require.NoError(t, err)
+				require.False(t, reg.Permissions.AllowCredentialsDB)
+
+				res, err := reg.Directive.Run(context.Background(), &StepContext{
+					Stage:  "fake-stage",
+					Config: Config{"fake-key": "fake-value"},
+				})
+				require.NoError(t, err)
+				require.Equal(t, StatusSuccess, res.Status)
+				require.Equal(t, "fake-stage", res.Output["stage"])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			r := testCase.registry
+			if r == nil {
+				r = DirectiveRegistry{}
+			}
+			testCase.assertions(t, r, r.RegisterPlugin(ctx, testCase.cfg))
+		})
+	}
+}
+
+func TestPluginDirective_Run(t *testing.T) {
+	testCases := []struct {
+		name       string
+		svc        *testPluginService
+		stepCtx    *StepContext
+		assertions func(*testing.T, Result, error)
+	}{
+		{
+			name: "plugin returns error",
+			svc: &testPluginService{
+				err: connect.NewError(connect.CodeInternal, errors.New("something went wrong")),
+			},
+			stepCtx: &StepContext{},
+			assertions: func(t *testing.T, res Result, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, StatusFailure, res.Status)
+			},
+		},
+		{
+			name:    "plugin returns pending",
+			svc:     &testPluginService{status: pluginapi.Status_STATUS_PENDING},
+			stepCtx: &StepContext{},
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusPending, res.Status)
+			},
+		},
+		{
+			name: "credentials not permitted",
+			svc:  &testPluginService{},
+			stepCtx: &StepContext{
+				Config: Config{"count": 1},
+			},
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusSuccess, res.Status)
+				require.Equal(
+					t,
+					State{
+						"config":      map[string]any{"count": int64(1)},
+						"credentials": []any{},
+						"stage":       "",
+					},
+					res.Output,
+				)
+			},
+		},
+		{
+			name: "credentials permitted",
+			svc:  &testPluginService{},
+			stepCtx: &StepContext{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				CredentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						_ context.Context,
+						_ string,
+						_ credentials.Type,
+						repo string,
+					) (credentials.Credentials, bool, error) {
+						if repo == "https://github.com/example/missing" {
+							return credentials.Credentials{}, false, nil
+						}
+						return credentials.Credentials{Password: "fake-password"}, true, nil
+					},
+				},
+			},
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusSuccess, res.Status)
+				require.Equal(
+					t,
+					[]any{"https://github.com/example/repo"},
+					res.Output["credentials"],
+				)
+				require.Equal(t, "fake-stage", res.Output["stage"])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := httptest.NewServer(newTestPluginHandler(testCase.svc))
+			t.Cleanup(srv.Close)
+			d, err := newPluginDirective(
+				context.Background(),
+				srv.URL,
+				[]PluginCredentials{
+					{
+						Type:    credentials.TypeGit,
+						RepoURL: "https://github.com/example/repo",
+					},
+					{
+						Type:    credentials.TypeGit,
+						RepoURL: "https://github.com/example/missing",
+					},
+				},
+			)
+			require.NoError(t, err)
+			res, err := d.Run(context.Background(), testCase.stepCtx)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -56,6 +56,13 @@ type GitCommitConfig struct {
 	MessageFrom []string `json:"messageFrom,omitempty"`
 	// The path to a working directory of a local repository.
 	Path string `json:"path"`
+	// The name of a Secret in the Project's namespace, labeled with 'kargo.akuity.io/cred-type:
+	// git-signing-key', containing a key with which to sign the commit. The key itself is read
+	// from the Secret's 'signingKey' field and its type, either 'gpg' or 'ssh', from the
+	// optional 'type' field, which defaults to 'gpg'. The optional 'name' and 'email' fields
+	// specify the identity with which to commit when no author is specified. When the signing
+	// key is a GPG key, this identity must match the key's identity.
+	SigningKeySecret string `json:"signingKeySecret,omitempty"`
 }
 
 // The author of the commit.
@@ -67,16 +74,34 @@ type Author struct {
 }
 
 type GitOpenPRConfig struct {
+	// The usernames of the users to assign the pull request to. Not all Git providers support
+	// this.
+	Assignees []string `json:"assignees,omitempty"`
+	// Indicates whether the pull request should be merged automatically once all of the
+	// repository's requirements for merging it are satisfied. This must be permitted by the
+	// repository's settings. Not all Git providers support this. Default is false.
+	AutoMerge bool `json:"autoMerge,omitempty"`
+	// Indicates whether open pull requests to the target branch that have all of the specified
+	// labels should be closed, with a comment linking to the new pull request, once it has been
+	// opened. Using labels that identify the Stage allows pull requests from previous
+	// promotions to the same Stage to be closed. Requires labels to be specified. Default is
+	// false.
+	CloseSuperseded bool `json:"closeSuperseded,omitempty"`
 	// Indicates whether a new, empty orphan branch should be created and pushed to the remote
 	// if the target branch does not already exist there. Default is false.
 	CreateTargetBranch bool `json:"createTargetBranch,omitempty"`
 	// Indicates whether to skip TLS verification when cloning the repository. Default is false.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
-	// The name of the Git provider to use. Currently only 'github' and 'gitlab' are supported.
+	// Labels to apply to the pull request. Not all Git providers support this.
+	Labels []string `json:"labels,omitempty"`
+	// The name of the Git provider to use. Currently only 'github', 'gitlab', 'bitbucket', 'gitea', and 'azure' are supported.
 	// Kargo will try to infer the provider if it is not explicitly specified.
 	Provider *Provider `json:"provider,omitempty"`
 	// The URL of a remote Git repository to clone.
 	RepoURL string `json:"repoURL"`
+	// The usernames of the users to request reviews of the pull request from. Not all Git
+	// providers support this.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// The branch containing the changes to be merged. This branch must already exist and be up
 	// to date on the remote.
 	SourceBranch string `json:"sourceBranch,omitempty"`
@@ -116,7 +141,7 @@ type GitWaitForPRConfig struct {
 	PRNumber int64 `json:"prNumber,omitempty"`
 	// References a previous open step by alias and will use the PR number opened by that step.
 	PRNumberFromOpen string `json:"prNumberFromOpen,omitempty"`
-	// The name of the Git provider to use. Currently only 'github' and 'gitlab' are supported.
+	// The name of the Git provider to use. Currently only 'github', 'gitlab', 'bitbucket', 'gitea', and 'azure' are supported.
 	// Kargo will try to infer the provider if it is not explicitly specified.
 	Provider *Provider `json:"provider,omitempty"`
 	// The URL of a remote Git repository to clone.
@@ -220,13 +245,16 @@ const (
 	Warehouse Kind = "Warehouse"
 )
 
-// The name of the Git provider to use. Currently only 'github' and 'gitlab' are supported.
+// The name of the Git provider to use. Currently only 'github', 'gitlab', 'bitbucket', 'gitea', and 'azure' are supported.
 // Kargo will try to infer the provider if it is not explicitly specified.
 type Provider string
 
 const (
-	Github Provider = "github"
-	Gitlab Provider = "gitlab"
+	Azure     Provider = "azure"
+	Bitbucket Provider = "bitbucket"
+	Gitea     Provider = "gitea"
+	Github    Provider = "github"
+	Gitlab    Provider = "gitlab"
 )
 
 // Specifies the new value for the specified key in the Helm values file.
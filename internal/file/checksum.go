@@ -0,0 +1,83 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	// checksumPrefix is the prefix of all checksums, which identifies the hash
+	// algorithm used to compute them.
+	checksumPrefix = "sha256:"
+	// contentDownloadTimeout is the maximum amount of time that may be spent
+	// downloading a file's content in order to compute its checksum.
+	contentDownloadTimeout = 10 * time.Minute
+	// maxChecksumFileSize is the maximum size of a checksum file published
+	// alongside a file that will be read.
+	maxChecksumFileSize = 4 << 10
+)
+
+// checksumCache caches the checksums of files, indexed by URL and ETag, so that
+// the content of a file only has to be downloaded when it changes.
+var checksumCache = cache.New(24*time.Hour, time.Hour)
+
+// formatChecksum returns the provided SHA-256 sum formatted as a checksum.
+func formatChecksum(sum []byte) string {
+	return checksumPrefix + hex.EncodeToString(sum)
+}
+
+// parseChecksumFile returns the checksum held by the provided content of a
+// checksum file in the format produced by sha256sum, i.e. a hex-encoded
+// SHA-256 sum optionally followed by the name of the file. It returns an empty
+// string if the content does not begin with a valid SHA-256 sum.
+func parseChecksumFile(data []byte) string {
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return ""
+	}
+	sum, err := hex.DecodeString(fields[0])
+	if err != nil || len(sum) != sha256.Size {
+		return ""
+	}
+	return formatChecksum(sum)
+}
+
+// hashContent downloads the content returned in response to the provided
+// request using the provided client and returns its checksum. The client's
+// timeout is replaced with contentDownloadTimeout, as downloading the content
+// of a file can take much longer than listing files.
+func hashContent(
+	ctx context.Context,
+	httpClient *http.Client,
+	req *http.Request,
+) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, contentDownloadTimeout)
+	defer cancel()
+	contentClient := *httpClient
+	contentClient.Timeout = contentDownloadTimeout
+	res, err := contentClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("error downloading %q: %w", req.URL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf(
+			"unexpected HTTP status %d downloading %q",
+			res.StatusCode,
+			req.URL,
+		)
+	}
+	h := sha256.New()
+	if _, err = io.Copy(h, res.Body); err != nil {
+		return "", fmt.Errorf("error downloading %q: %w", req.URL, err)
+	}
+	return formatChecksum(h.Sum(nil)), nil
+}
@@ -0,0 +1,14 @@
+package file
+
+// Credentials represents the credentials for connecting to a server from which
+// files are retrieved.
+type Credentials struct {
+	// Username identifies a principal, which combined with the value of the
+	// Password field, can be used for reading files from some server. For
+	// S3-compatible object stores, this is an access key ID.
+	Username string
+	// Password, when combined with the principal identified by the Username
+	// field, can be used for reading files from some server. For S3-compatible
+	// object stores, this is a secret access key.
+	Password string
+}
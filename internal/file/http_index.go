@@ -0,0 +1,220 @@
+package file
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/html"
+)
+
+// newHTTPClient returns an *http.Client suitable for retrieving file listings
+// and metadata.
+func newHTTPClient(insecureSkipTLSVerify bool) *http.Client {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	return &http.Client{
+		Transport: httpTransport,
+		Timeout:   30 * time.Second,
+	}
+}
+
+// httpIndexLister is an implementation of the lister interface that lists the
+// files linked to by an HTML page, e.g. a web server's directory listing.
+type httpIndexLister struct {
+	indexURL   *url.URL
+	creds      *Credentials
+	httpClient *http.Client
+}
+
+func newHTTPIndexLister(
+	indexURL *url.URL,
+	creds *Credentials,
+	httpClient *http.Client,
+) *httpIndexLister {
+	return &httpIndexLister{
+		indexURL:   indexURL,
+		creds:      creds,
+		httpClient: httpClient,
+	}
+}
+
+// list implements the lister interface. Only links to files that reside in the
+// same directory as the index itself are considered. Links to directories,
+// links with a query string, and links to other hosts are ignored.
+func (h *httpIndexLister) list(ctx context.Context) ([]File, error) {
+	req, err := h.newRequest(ctx, http.MethodGet, h.indexURL.String())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving index %q: %w", h.indexURL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"unexpected HTTP status %d retrieving index %q",
+			res.StatusCode,
+			h.indexURL,
+		)
+	}
+
+	doc, err := html.Parse(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing index %q: %w", h.indexURL, err)
+	}
+
+	// Links are resolved relative to the URL the index was ultimately served
+	// from, which may differ from the configured URL if there were redirects.
+	baseURL := res.Request.URL
+	baseDir := baseURL.Path
+	if !strings.HasSuffix(baseDir, "/") {
+		baseDir = path.Dir(baseDir) + "/"
+	}
+
+	var files []File
+	seen := map[string]struct{}{}
+	for _, href := range findLinks(doc) {
+		ref, err := url.Parse(href)
+		if err != nil {
+			continue
+		}
+		fileURL := baseURL.ResolveReference(ref)
+		fileURL.Fragment = ""
+		if fileURL.Host != baseURL.Host ||
+			fileURL.RawQuery != "" ||
+			strings.HasSuffix(fileURL.Path, "/") ||
+			path.Dir(fileURL.Path)+"/" != baseDir {
+			continue
+		}
+		if _, ok := seen[fileURL.String()]; ok {
+			continue
+		}
+		seen[fileURL.String()] = struct{}{}
+		files = append(files, File{
+			Name: path.Base(fileURL.Path),
+			URL:  fileURL.String(),
+		})
+	}
+	return files, nil
+}
+
+// stat implements the lister interface. It issues a HEAD request for the
+// provided File and populates its ETag and LastModified fields from the
+// ETag and Last-Modified response headers, respectively.
+func (h *httpIndexLister) stat(ctx context.Context, f *File) error {
+	req, err := h.newRequest(ctx, http.MethodHead, f.URL)
+	if err != nil {
+		return err
+	}
+	res, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status %d", res.StatusCode)
+	}
+	if f.ETag == "" {
+		f.ETag = normalizeETag(res.Header.Get("ETag"))
+	}
+	if f.LastModified == nil {
+		if lastModified, err := http.ParseTime(res.Header.Get("Last-Modified")); err == nil {
+			f.LastModified = &lastModified
+		}
+	}
+	return nil
+}
+
+// checksum implements the lister interface. If a checksum file in the format
+// produced by sha256sum is published alongside the provided File, i.e. at its
+// URL with a ".sha256" suffix, the checksum is taken from it. Otherwise, the
+// content of the File is downloaded and hashed.
+func (h *httpIndexLister) checksum(ctx context.Context, f *File) (string, error) {
+	sum, err := h.getChecksumFile(ctx, f.URL+".sha256")
+	if err != nil {
+		return "", err
+	}
+	if sum != "" {
+		return sum, nil
+	}
+	req, err := h.newRequest(ctx, http.MethodGet, f.URL)
+	if err != nil {
+		return "", err
+	}
+	return hashContent(ctx, h.httpClient, req)
+}
+
+// getChecksumFile returns the checksum held by the checksum file at the
+// provided URL. It returns an empty string if there is no such file or if it
+// does not hold a valid checksum.
+func (h *httpIndexLister) getChecksumFile(ctx context.Context, fileURL string) (string, error) {
+	req, err := h.newRequest(ctx, http.MethodGet, fileURL)
+	if err != nil {
+		return "", err
+	}
+	res, err := h.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving %q: %w", fileURL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", nil
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxChecksumFileSize))
+	if err != nil {
+		return "", fmt.Errorf("error reading %q: %w", fileURL, err)
+	}
+	return parseChecksumFile(data), nil
+}
+
+func (h *httpIndexLister) newRequest(
+	ctx context.Context,
+	method string,
+	reqURL string,
+) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %q: %w", reqURL, err)
+	}
+	if h.creds != nil {
+		req.SetBasicAuth(h.creds.Username, h.creds.Password)
+	}
+	return req, nil
+}
+
+// findLinks returns the href attributes of all anchors in the provided HTML
+// document, in document order.
+func findLinks(n *html.Node) []string {
+	var links []string
+	if n.Type == html.ElementNode && n.Data == "a" {
+		for _, attr := range n.Attr {
+			if attr.Key == "href" {
+				links = append(links, attr.Val)
+				break
+			}
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		links = append(links, findLinks(c)...)
+	}
+	return links
+}
+
+// normalizeETag strips the weak validator prefix and surrounding quotes from
+// the provided ETag.
+func normalizeETag(etag string) string {
+	return strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+}
@@ -0,0 +1,202 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testIndex = `<!DOCTYPE html>
+<html>
+<body>
+<h1>Index of /files/</h1>
+<a href="../">../</a>
+<a href="nested/">nested/</a>
+<a href="app-1.0.0.tgz">app-1.0.0.tgz</a>
+<a href="/files/app-1.1.0.tgz">app-1.1.0.tgz</a>
+<a href="app-1.1.0.tgz#fragment">app-1.1.0.tgz</a>
+<a href="?C=M;O=A">Last modified</a>
+<a href="https://example.com/files/app-9.9.9.tgz">elsewhere</a>
+<a href="/other/app-2.0.0.tgz">other directory</a>
+</body>
+</html>`
+
+func TestHTTPIndexListerList(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/files/":
+			_, _ = w.Write([]byte(testIndex))
+		case "/protected/":
+			if _, _, ok := r.BasicAuth(); !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(testIndex))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	testCases := []struct {
+		name       string
+		path       string
+		creds      *Credentials
+		assertions func(*testing.T, []File, error)
+	}{
+		{
+			name: "index not found",
+			path: "/missing/",
+			assertions: func(t *testing.T, _ []File, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 404")
+			},
+		},
+		{
+			name: "unauthorized",
+			path: "/protected/",
+			assertions: func(t *testing.T, _ []File, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 401")
+			},
+		},
+		{
+			name:  "success with credentials",
+			path:  "/protected/",
+			creds: &Credentials{Username: "fake-user", Password: "fake-password"},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+			},
+		},
+		{
+			name: "success",
+			path: "/files/",
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]File{
+						{Name: "app-1.0.0.tgz", URL: srv.URL + "/files/app-1.0.0.tgz"},
+						{Name: "app-1.1.0.tgz", URL: srv.URL + "/files/app-1.1.0.tgz"},
+					},
+					files,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			indexURL, err := url.Parse(srv.URL + testCase.path)
+			require.NoError(t, err)
+			lister := newHTTPIndexLister(indexURL, testCase.creds, srv.Client())
+			files, err := lister.list(context.Background())
+			testCase.assertions(t, files, err)
+		})
+	}
+}
+
+func TestHTTPIndexListerStat(t *testing.T) {
+	lastModified := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodHead, r.Method)
+		switch r.URL.Path {
+		case "/files/app-1.0.0.tgz":
+			w.Header().Set("ETag", `W/"fake-etag"`)
+			w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	indexURL, err := url.Parse(srv.URL + "/files/")
+	require.NoError(t, err)
+	lister := newHTTPIndexLister(indexURL, nil, srv.Client())
+
+	f := &File{URL: srv.URL + "/files/app-1.0.0.tgz"}
+	require.NoError(t, lister.stat(context.Background(), f))
+	require.Equal(t, "fake-etag", f.ETag)
+	require.NotNil(t, f.LastModified)
+	require.True(t, lastModified.Equal(*f.LastModified))
+
+	err = lister.stat(context.Background(), &File{URL: srv.URL + "/files/missing.tgz"})
+	require.ErrorContains(t, err, "unexpected HTTP status 404")
+}
+
+func TestHTTPIndexListerChecksum(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Path {
+		case "/files/app-1.0.0.tgz.sha256":
+			_, _ = fmt.Fprintf(
+				w,
+				"%s  app-1.0.0.tgz\n",
+				strings.TrimPrefix(testChecksum("published"), checksumPrefix),
+			)
+		case "/files/app-1.1.0.tgz.sha256":
+			_, _ = w.Write([]byte("not a checksum\n"))
+		case "/files/app-1.0.0.tgz", "/files/app-1.1.0.tgz", "/files/app-1.2.0.tgz":
+			_, _ = w.Write([]byte(r.URL.Path))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	indexURL, err := url.Parse(srv.URL + "/files/")
+	require.NoError(t, err)
+	lister := newHTTPIndexLister(indexURL, nil, srv.Client())
+
+	testCases := []struct {
+		name       string
+		file       string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "file not found",
+			file: "missing.tgz",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 404")
+			},
+		},
+		{
+			name: "published checksum",
+			file: "app-1.0.0.tgz",
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("published"), sum)
+			},
+		},
+		{
+			name: "invalid published checksum",
+			file: "app-1.1.0.tgz",
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("/files/app-1.1.0.tgz"), sum)
+			},
+		},
+		{
+			name: "no published checksum",
+			file: "app-1.2.0.tgz",
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("/files/app-1.2.0.tgz"), sum)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sum, err := lister.checksum(
+				context.Background(),
+				&File{URL: srv.URL + "/files/" + testCase.file},
+			)
+			testCase.assertions(t, sum, err)
+		})
+	}
+}
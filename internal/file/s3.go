@@ -0,0 +1,288 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const defaultS3Region = "us-east-1"
+
+// emptyPayloadHash is the hex-encoded SHA-256 hash of an empty request body.
+// It is required for signing requests to S3.
+var emptyPayloadHash = func() string {
+	sum := sha256.Sum256(nil)
+	return hex.EncodeToString(sum[:])
+}()
+
+// s3Lister is an implementation of the lister interface that lists the objects
+// under a key prefix in a bucket of an S3-compatible object store using the
+// ListObjectsV2 API.
+type s3Lister struct {
+	bucket     string
+	prefix     string
+	region     string
+	endpoint   *url.URL
+	pathStyle  bool
+	creds      *Credentials
+	signer     *v4.Signer
+	httpClient *http.Client
+}
+
+func newS3Lister(
+	repoURL *url.URL,
+	opts *SelectorOptions,
+	httpClient *http.Client,
+) (*s3Lister, error) {
+	if repoURL.Host == "" {
+		return nil, errors.New("no bucket specified")
+	}
+	s := &s3Lister{
+		bucket:     repoURL.Host,
+		prefix:     normalizeS3Prefix(repoURL.Path),
+		region:     opts.Region,
+		creds:      opts.Creds,
+		signer:     v4.NewSigner(),
+		httpClient: httpClient,
+	}
+	if s.region == "" {
+		s.region = defaultS3Region
+	}
+	if opts.Endpoint != "" {
+		var err error
+		if s.endpoint, err = url.Parse(opts.Endpoint); err != nil {
+			return nil, fmt.Errorf("error parsing endpoint %q: %w", opts.Endpoint, err)
+		}
+		// S3-compatible object stores do not reliably support virtual-hosted-style
+		// requests, so path-style requests are used instead.
+		s.pathStyle = true
+	} else {
+		s.endpoint = &url.URL{
+			Scheme: "https",
+			Host:   fmt.Sprintf("%s.s3.%s.amazonaws.com", s.bucket, s.region),
+		}
+	}
+	return s, nil
+}
+
+// normalizeS3Prefix returns the key prefix under which objects are listed for
+// the provided URL path. Since only objects directly under the prefix are
+// listed, the prefix always denotes a "directory". I.e., a trailing slash is
+// added if missing, so that s3://bucket/app and s3://bucket/app/ are
+// equivalent.
+func normalizeS3Prefix(urlPath string) string {
+	prefix := strings.TrimPrefix(urlPath, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// listBucketResult is the subset of the ListObjectsV2 response that is of
+// interest to the s3Lister.
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+	} `xml:"Contents"`
+}
+
+// list implements the lister interface. Only objects directly under the key
+// prefix are listed. I.e., objects in "subdirectories" are ignored.
+func (s *s3Lister) list(ctx context.Context) ([]File, error) {
+	var files []File
+	var continuationToken string
+	for {
+		result, err := s.listObjects(ctx, continuationToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range result.Contents {
+			if strings.HasSuffix(obj.Key, "/") {
+				continue
+			}
+			lastModified := obj.LastModified
+			files = append(files, File{
+				Name:         path.Base(obj.Key),
+				URL:          fmt.Sprintf("s3://%s/%s", s.bucket, obj.Key),
+				ETag:         normalizeETag(obj.ETag),
+				LastModified: &lastModified,
+			})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return files, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+// stat implements the lister interface. It is a no-op because all metadata of
+// interest is already populated by list.
+func (s *s3Lister) stat(context.Context, *File) error {
+	return nil
+}
+
+// checksum implements the lister interface. The SHA-256 checksum the object
+// store recorded for the object when it was uploaded is used if there is one.
+// Objects uploaded without a checksum, and objects uploaded in multiple parts,
+// whose recorded checksum is a checksum of the checksums of their parts, are
+// downloaded and hashed instead.
+func (s *s3Lister) checksum(ctx context.Context, f *File) (string, error) {
+	objectURL := s.objectURL(strings.TrimPrefix(f.URL, fmt.Sprintf("s3://%s/", s.bucket)))
+	req, err := s.newRequest(ctx, http.MethodHead, objectURL)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Amz-Checksum-Mode", "ENABLED")
+	if err = s.signRequest(ctx, req); err != nil {
+		return "", err
+	}
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving metadata of %q: %w", f.URL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf(
+			"unexpected HTTP status %d retrieving metadata of %q",
+			res.StatusCode,
+			f.URL,
+		)
+	}
+	if res.Header.Get("X-Amz-Checksum-Type") != "COMPOSITE" {
+		if sum, err := base64.StdEncoding.DecodeString(
+			res.Header.Get("X-Amz-Checksum-Sha256"),
+		); err == nil && len(sum) == sha256.Size {
+			return formatChecksum(sum), nil
+		}
+	}
+
+	if req, err = s.newRequest(ctx, http.MethodGet, objectURL); err != nil {
+		return "", err
+	}
+	if err = s.signRequest(ctx, req); err != nil {
+		return "", err
+	}
+	return hashContent(ctx, s.httpClient, req)
+}
+
+// objectURL returns the URL of the object with the provided key.
+func (s *s3Lister) objectURL(key string) string {
+	objectURL := *s.endpoint
+	if s.pathStyle {
+		objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + s.bucket + "/" + key
+	} else {
+		objectURL.Path = "/" + key
+	}
+	return objectURL.String()
+}
+
+// newRequest returns a new request to the object store. The request must be
+// signed using signRequest once all of its headers have been set.
+func (s *s3Lister) newRequest(
+	ctx context.Context,
+	method string,
+	reqURL string,
+) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for bucket %q: %w", s.bucket, err)
+	}
+	return req, nil
+}
+
+// signRequest signs the provided request, which must not have a body, using
+// the lister's credentials, if any.
+func (s *s3Lister) signRequest(ctx context.Context, req *http.Request) error {
+	if s.creds == nil {
+		return nil
+	}
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if err := s.signer.SignHTTP(
+		ctx,
+		aws.Credentials{
+			AccessKeyID:     s.creds.Username,
+			SecretAccessKey: s.creds.Password,
+		},
+		req,
+		emptyPayloadHash,
+		"s3",
+		s.region,
+		time.Now(),
+		func(o *v4.SignerOptions) {
+			o.DisableURIPathEscaping = true
+		},
+	); err != nil {
+		return fmt.Errorf("error signing request for bucket %q: %w", s.bucket, err)
+	}
+	return nil
+}
+
+func (s *s3Lister) listObjects(
+	ctx context.Context,
+	continuationToken string,
+) (*listBucketResult, error) {
+	reqURL := *s.endpoint
+	if s.pathStyle {
+		reqURL.Path = strings.TrimSuffix(reqURL.Path, "/") + "/" + s.bucket
+	} else {
+		reqURL.Path = "/"
+	}
+	query := url.Values{
+		"list-type": []string{"2"},
+		"delimiter": []string{"/"},
+	}
+	if s.prefix != "" {
+		query.Set("prefix", s.prefix)
+	}
+	if continuationToken != "" {
+		query.Set("continuation-token", continuationToken)
+	}
+	reqURL.RawQuery = query.Encode()
+
+	req, err := s.newRequest(ctx, http.MethodGet, reqURL.String())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.signRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error listing objects in bucket %q: %w", s.bucket, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"unexpected HTTP status %d listing objects in bucket %q",
+			res.StatusCode,
+			s.bucket,
+		)
+	}
+
+	result := &listBucketResult{}
+	if err = xml.NewDecoder(res.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf(
+			"error decoding object listing for bucket %q: %w",
+			s.bucket,
+			err,
+		)
+	}
+	return result, nil
+}
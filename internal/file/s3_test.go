@@ -0,0 +1,306 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestS3Server returns a minimal stand-in for an S3-compatible object store
+// (such as MinIO) that serves path-style ListObjectsV2 requests for a single
+// bucket. Listings are served one object per page to exercise pagination. The
+// content of each object is served as well, and is the object's key. A
+// checksum is recorded for objects whose key contains "1.0.0". A composite
+// checksum, as recorded for objects uploaded in multiple parts, is recorded
+// for objects whose key contains "1.1.0".
+func newTestS3Server(t *testing.T, bucket string, keys []string, requireAuth bool) *httptest.Server {
+	lastModified := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requireAuth && !strings.HasPrefix(
+			r.Header.Get("Authorization"),
+			"AWS4-HMAC-SHA256 Credential=fake-access-key/",
+		) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if key, ok := strings.CutPrefix(r.URL.Path, "/"+bucket+"/"); ok && slices.Contains(keys, key) {
+			switch {
+			case r.Method == http.MethodGet:
+				_, _ = w.Write([]byte(key))
+			case r.Header.Get("X-Amz-Checksum-Mode") != "ENABLED":
+			case strings.Contains(key, "1.0.0"):
+				sum := sha256.Sum256([]byte(key))
+				w.Header().Set("X-Amz-Checksum-Sha256", base64.StdEncoding.EncodeToString(sum[:]))
+				w.Header().Set("X-Amz-Checksum-Type", "FULL_OBJECT")
+			case strings.Contains(key, "1.1.0"):
+				w.Header().Set("X-Amz-Checksum-Sha256", "bm90IGEgY29udGVudCBjaGVja3N1bQ==-2")
+				w.Header().Set("X-Amz-Checksum-Type", "COMPOSITE")
+			}
+			return
+		}
+		if r.URL.Path != "/"+bucket {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		require.Equal(t, "2", query.Get("list-type"))
+		require.Equal(t, "/", query.Get("delimiter"))
+
+		var matched []string
+		for _, key := range keys {
+			if !strings.HasPrefix(key, query.Get("prefix")) {
+				continue
+			}
+			if strings.Contains(strings.TrimPrefix(key, query.Get("prefix")), "/") {
+				continue
+			}
+			matched = append(matched, key)
+		}
+		var start int
+		if token := query.Get("continuation-token"); token != "" {
+			_, err := fmt.Sscanf(token, "page-%d", &start)
+			require.NoError(t, err)
+		}
+
+		var sb strings.Builder
+		sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>`)
+		sb.WriteString(`<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`)
+		if start < len(matched) {
+			fmt.Fprintf(
+				&sb,
+				`<Contents><Key>%s</Key><LastModified>%s</LastModified><ETag>"etag-%d"</ETag></Contents>`,
+				matched[start],
+				lastModified.Add(time.Duration(start)*time.Hour).Format(time.RFC3339),
+				start,
+			)
+		}
+		if start+1 < len(matched) {
+			fmt.Fprintf(
+				&sb,
+				`<IsTruncated>true</IsTruncated><NextContinuationToken>page-%d</NextContinuationToken>`,
+				start+1,
+			)
+		} else {
+			sb.WriteString(`<IsTruncated>false</IsTruncated>`)
+		}
+		sb.WriteString(`</ListBucketResult>`)
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(sb.String()))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestS3ListerList(t *testing.T) {
+	keys := []string{
+		"app/app-1.0.0.tgz",
+		"app/app-1.1.0.tgz",
+		"app/nested/app-2.0.0.tgz",
+		"other/other-1.0.0.tgz",
+	}
+	publicSrv := newTestS3Server(t, "fake-bucket", keys, false)
+	privateSrv := newTestS3Server(t, "fake-bucket", keys, true)
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		opts       SelectorOptions
+		assertions func(*testing.T, []File, error)
+	}{
+		{
+			name:    "bucket not found",
+			repoURL: "s3://missing-bucket/app/",
+			opts:    SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, _ []File, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 404")
+			},
+		},
+		{
+			name:    "missing credentials",
+			repoURL: "s3://fake-bucket/app/",
+			opts:    SelectorOptions{Endpoint: privateSrv.URL},
+			assertions: func(t *testing.T, _ []File, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 403")
+			},
+		},
+		{
+			name:    "success with credentials",
+			repoURL: "s3://fake-bucket/app/",
+			opts: SelectorOptions{
+				Endpoint: privateSrv.URL,
+				Creds: &Credentials{
+					Username: "fake-access-key",
+					Password: "fake-secret-key",
+				},
+			},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+			},
+		},
+		{
+			name:    "prefix without trailing slash",
+			repoURL: "s3://fake-bucket/app",
+			opts:    SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+				require.Equal(t, "s3://fake-bucket/app/app-1.0.0.tgz", files[0].URL)
+			},
+		},
+		{
+			name:    "success",
+			repoURL: "s3://fake-bucket/app/",
+			opts:    SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+				require.Equal(t, "app-1.0.0.tgz", files[0].Name)
+				require.Equal(t, "s3://fake-bucket/app/app-1.0.0.tgz", files[0].URL)
+				require.Equal(t, "etag-0", files[0].ETag)
+				require.NotNil(t, files[0].LastModified)
+				require.Equal(t, "app-1.1.0.tgz", files[1].Name)
+				require.Equal(t, "etag-1", files[1].ETag)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repoURL, err := url.Parse(testCase.repoURL)
+			require.NoError(t, err)
+			lister, err := newS3Lister(repoURL, &testCase.opts, http.DefaultClient)
+			require.NoError(t, err)
+			require.True(t, lister.pathStyle)
+			files, err := lister.list(context.Background())
+			testCase.assertions(t, files, err)
+		})
+	}
+}
+
+func TestSelectorSelectFromS3(t *testing.T) {
+	srv := newTestS3Server(
+		t,
+		"fake-bucket",
+		[]string{
+			"app/app-1.0.0.tgz",
+			"app/app-1.2.0.tgz",
+			"app/app-1.1.0.tgz",
+		},
+		false,
+	)
+	s, err := NewSelector(
+		"s3://fake-bucket/app/",
+		SelectionStrategySemVer,
+		&SelectorOptions{
+			Endpoint:       srv.URL,
+			DiscoveryLimit: 2,
+		},
+	)
+	require.NoError(t, err)
+	files, err := s.Select(context.Background())
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Equal(t, "s3://fake-bucket/app/app-1.2.0.tgz", files[0].URL)
+	require.Equal(t, "1.2.0", files[0].Version)
+	require.Equal(t, "etag-1", files[0].ETag)
+	require.Equal(t, testChecksum("app/app-1.2.0.tgz"), files[0].Checksum)
+	require.Equal(t, "s3://fake-bucket/app/app-1.1.0.tgz", files[1].URL)
+	require.Equal(t, testChecksum("app/app-1.1.0.tgz"), files[1].Checksum)
+}
+
+func TestS3ListerChecksum(t *testing.T) {
+	keys := []string{
+		"app/app-1.0.0.tgz",
+		"app/app-1.1.0.tgz",
+		"app/app-1.2.0.tgz",
+	}
+	publicSrv := newTestS3Server(t, "fake-bucket", keys, false)
+	privateSrv := newTestS3Server(t, "fake-bucket", keys, true)
+
+	testCases := []struct {
+		name       string
+		key        string
+		opts       SelectorOptions
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "object not found",
+			key:  "app/missing.tgz",
+			opts: SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 404")
+			},
+		},
+		{
+			name: "recorded checksum",
+			key:  "app/app-1.0.0.tgz",
+			opts: SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("app/app-1.0.0.tgz"), sum)
+			},
+		},
+		{
+			name: "composite checksum",
+			key:  "app/app-1.1.0.tgz",
+			opts: SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("app/app-1.1.0.tgz"), sum)
+			},
+		},
+		{
+			name: "no recorded checksum",
+			key:  "app/app-1.2.0.tgz",
+			opts: SelectorOptions{Endpoint: publicSrv.URL},
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("app/app-1.2.0.tgz"), sum)
+			},
+		},
+		{
+			name: "no recorded checksum with credentials",
+			key:  "app/app-1.2.0.tgz",
+			opts: SelectorOptions{
+				Endpoint: privateSrv.URL,
+				Creds: &Credentials{
+					Username: "fake-access-key",
+					Password: "fake-secret-key",
+				},
+			},
+			assertions: func(t *testing.T, sum string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testChecksum("app/app-1.2.0.tgz"), sum)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repoURL, err := url.Parse("s3://fake-bucket/app/")
+			require.NoError(t, err)
+			lister, err := newS3Lister(repoURL, &testCase.opts, http.DefaultClient)
+			require.NoError(t, err)
+			sum, err := lister.checksum(
+				context.Background(),
+				&File{URL: "s3://fake-bucket/" + testCase.key},
+			)
+			testCase.assertions(t, sum, err)
+		})
+	}
+}
+
+// testChecksum returns the checksum of the provided content.
+func testChecksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return formatChecksum(sum[:])
+}
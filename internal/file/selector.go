@@ -0,0 +1,394 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/patrickmn/go-cache"
+
+	libSemver "github.com/akuity/kargo/internal/controller/semver"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// SelectionStrategy represents a strategy for selecting files from a listing.
+type SelectionStrategy string
+
+const (
+	// SelectionStrategyLexical represents a file selection strategy that is
+	// useful for finding the file whose name is lexically last among those
+	// matched by a regular expression and not explicitly ignored. This strategy
+	// is useful for finding the latest in a series of files whose names are
+	// suffixed with a predictably formatted timestamp.
+	SelectionStrategyLexical SelectionStrategy = "Lexical"
+	// SelectionStrategyNewestModified represents a file selection strategy that
+	// is useful for finding the file that was most recently modified. For files
+	// listed in an HTTP/S index, this can require a request per file to obtain
+	// its last modification time. It is best to use this strategy with caution
+	// and constrain the eligible files as much as possible using a regular
+	// expression.
+	SelectionStrategyNewestModified SelectionStrategy = "NewestModified"
+	// SelectionStrategySemVer represents a file selection strategy that is
+	// useful for finding the file whose version is the highest among files
+	// whose versions are valid semantic versions. An optional constraint can
+	// limit the eligible range of semantic versions.
+	SelectionStrategySemVer SelectionStrategy = "SemVer"
+)
+
+// defaultVersionRegex is used to extract a version from a file's name when
+// no other regular expression has been specified. It matches the first
+// semantic version (with optional "v" prefix, optional minor and patch
+// components, and an optional pre-release) that is not immediately preceded
+// by an alphanumeric character. Pre-release identifiers following the first
+// one must be numeric, which prevents file extensions (e.g. ".tar.gz") from
+// being mistaken for part of the version.
+var defaultVersionRegex = regexp.MustCompile(
+	`(?:^|[^0-9A-Za-z])(v?[0-9]+(?:\.[0-9]+){0,2}(?:-[0-9A-Za-z-]+(?:\.[0-9]+)*)?)`,
+)
+
+// File is a representation of a file discovered in a listing.
+type File struct {
+	// Name is the name of the file.
+	Name string
+	// URL is the URL from which the file can be retrieved.
+	URL string
+	// Version is the version extracted from the file's name or, if no version
+	// could be extracted, the file's name.
+	Version string
+	// ETag is an opaque identifier of the file's content as reported by the
+	// server. It is not a checksum of the content.
+	ETag string
+	// Checksum is the SHA-256 checksum of the file's content, formatted as
+	// "sha256:<hex>".
+	Checksum string
+	// LastModified is the time the file was last modified, if known.
+	LastModified *time.Time
+	semVer       *semver.Version
+}
+
+// Selector is an interface for selecting files from a listing.
+type Selector interface {
+	// Select selects files from a listing.
+	Select(context.Context) ([]File, error)
+}
+
+// SelectorOptions represents options for creating a Selector.
+type SelectorOptions struct {
+	// StrictSemvers, when set to true, will cause only versions containing ALL
+	// of the major, minor, and patch version components to be counted as valid
+	// semantic versions when using SelectionStrategySemVer.
+	StrictSemvers bool
+	// Constraint is an optional semantic version constraint. It only has any
+	// effect when using SelectionStrategySemVer.
+	Constraint string
+	// VersionRegex is an optional regular expression used to extract a version
+	// from a file's name. If the expression contains a capture group, the
+	// version is the value captured by the first group. Otherwise, the version
+	// is the entire match.
+	VersionRegex string
+	versionRegex *regexp.Regexp
+	// AllowRegex is an optional regular expression that can be used to
+	// constrain file selection based on eligible file names.
+	AllowRegex string
+	allowRegex *regexp.Regexp
+	// Ignore is an optional list of file names that should explicitly be
+	// ignored when selecting files.
+	Ignore []string
+	// Endpoint is the optional URL of an S3-compatible object store. If not
+	// specified, AWS S3 is assumed. This only has any effect on s3:// URLs.
+	Endpoint string
+	// Region is the optional region of an S3 bucket. If not specified,
+	// "us-east-1" is assumed. This only has any effect on s3:// URLs.
+	Region string
+	// Creds holds optional credentials for authenticating to the server.
+	Creds *Credentials
+	// InsecureSkipTLSVerify is an optional flag, that if set to true, will
+	// disable verification of the server's TLS certificate.
+	InsecureSkipTLSVerify bool
+	// DiscoveryLimit is an optional limit on the number of files that can be
+	// discovered by the Selector. The limit is applied after filtering files
+	// based on all other options. If the limit is zero, all discovered files
+	// will be returned.
+	DiscoveryLimit int
+}
+
+// lister is an interface for listing files in some location.
+type lister interface {
+	// list returns all files found in the location. At minimum, the Name and
+	// URL fields of each File are populated.
+	list(context.Context) ([]File, error)
+	// stat populates the ETag and LastModified fields of the provided File
+	// to the extent they could not be populated by list.
+	stat(context.Context, *File) error
+	// checksum returns the SHA-256 checksum of the provided File's content,
+	// formatted as "sha256:<hex>". This may require downloading the content.
+	checksum(context.Context, *File) (string, error)
+}
+
+// selector implements the Selector interface.
+type selector struct {
+	lister     lister
+	strategy   SelectionStrategy
+	opts       SelectorOptions
+	constraint *semver.Constraints
+}
+
+// NewSelector returns an implementation of the Selector interface that
+// selects files listed in a bucket of an S3-compatible object store (for
+// s3:// URLs) or in an HTML index (for http:// and https:// URLs) based on a
+// selection strategy and a set of optional constraints.
+func NewSelector(
+	repoURL string,
+	strategy SelectionStrategy,
+	opts *SelectorOptions,
+) (Selector, error) {
+	if opts == nil {
+		opts = &SelectorOptions{}
+	}
+
+	s := &selector{strategy: strategy}
+
+	switch strategy {
+	case SelectionStrategyLexical, SelectionStrategyNewestModified:
+	case SelectionStrategySemVer, "":
+		s.strategy = SelectionStrategySemVer
+		if opts.Constraint != "" {
+			var err error
+			if s.constraint, err = semver.NewConstraint(opts.Constraint); err != nil {
+				return nil, fmt.Errorf(
+					"error parsing semver constraint %q: %w",
+					opts.Constraint,
+					err,
+				)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid file selection strategy %q", strategy)
+	}
+
+	if opts.VersionRegex != "" {
+		var err error
+		if opts.versionRegex, err = regexp.Compile(opts.VersionRegex); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				opts.VersionRegex,
+				err,
+			)
+		}
+	}
+
+	if opts.AllowRegex != "" {
+		var err error
+		if opts.allowRegex, err = regexp.Compile(opts.AllowRegex); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				opts.AllowRegex,
+				err,
+			)
+		}
+	}
+
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing URL %q: %w", repoURL, err)
+	}
+	httpClient := newHTTPClient(opts.InsecureSkipTLSVerify)
+	switch u.Scheme {
+	case "s3":
+		if s.lister, err = newS3Lister(u, opts, httpClient); err != nil {
+			return nil, fmt.Errorf("error creating S3 lister for %q: %w", repoURL, err)
+		}
+	case "http", "https":
+		s.lister = newHTTPIndexLister(u, opts.Creds, httpClient)
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+
+	s.opts = *opts
+	return s, nil
+}
+
+// Select implements the Selector interface.
+func (s *selector) Select(ctx context.Context) ([]File, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"selectionStrategy", s.strategy,
+		"discoveryLimit", s.opts.DiscoveryLimit,
+	)
+	logger.Trace("discovering files")
+
+	files, err := s.lister.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing files: %w", err)
+	}
+	if len(files) == 0 {
+		logger.Trace("found no files")
+		return nil, nil
+	}
+	logger.Trace("listed all files")
+
+	matchedFiles := make([]File, 0, len(files))
+	for _, f := range files {
+		if !s.allowsFile(f.Name) || s.ignoresFile(f.Name) {
+			continue
+		}
+		f.Version = s.versionOf(f.Name)
+		if s.strategy == SelectionStrategySemVer {
+			if f.semVer = libSemver.Parse(f.Version, s.opts.StrictSemvers); f.semVer == nil {
+				continue
+			}
+			if s.constraint != nil && !s.constraint.Check(f.semVer) {
+				continue
+			}
+		}
+		matchedFiles = append(matchedFiles, f)
+	}
+	if len(matchedFiles) == 0 {
+		logger.Trace("no files matched criteria")
+		return nil, nil
+	}
+	logger.Trace(
+		"files matched criteria",
+		"count", len(matchedFiles),
+	)
+
+	switch s.strategy {
+	case SelectionStrategySemVer:
+		logger.Trace("sorting files by semantic version")
+		sortFilesBySemVer(matchedFiles)
+	case SelectionStrategyLexical:
+		logger.Trace("sorting files lexically")
+		sortFilesLexically(matchedFiles)
+	case SelectionStrategyNewestModified:
+		for i := range matchedFiles {
+			if err = s.statFile(ctx, &matchedFiles[i]); err != nil {
+				return nil, err
+			}
+		}
+		logger.Trace("sorting files by last modification time")
+		sortFilesByLastModified(matchedFiles)
+	}
+
+	if limit := s.opts.DiscoveryLimit; limit > 0 && limit < len(matchedFiles) {
+		matchedFiles = matchedFiles[:limit]
+	}
+	for i := range matchedFiles {
+		if err = s.statFile(ctx, &matchedFiles[i]); err != nil {
+			return nil, err
+		}
+		if err = s.checksumFile(ctx, &matchedFiles[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Trace(
+		"discovered files",
+		"count", len(matchedFiles),
+	)
+	return matchedFiles, nil
+}
+
+// statFile populates the ETag and LastModified fields of the provided File
+// if either is not already populated.
+func (s *selector) statFile(ctx context.Context, f *File) error {
+	if f.ETag != "" && f.LastModified != nil {
+		return nil
+	}
+	if err := s.lister.stat(ctx, f); err != nil {
+		return fmt.Errorf("error retrieving metadata for file %q: %w", f.URL, err)
+	}
+	return nil
+}
+
+// checksumFile populates the Checksum field of the provided File. Checksums
+// are cached by URL and ETag, so the content of a file with an ETag only has to
+// be downloaded, if at all, when its ETag changes.
+func (s *selector) checksumFile(ctx context.Context, f *File) error {
+	var cacheKey string
+	if f.ETag != "" {
+		cacheKey = f.URL + "\x00" + f.ETag
+		if sum, ok := checksumCache.Get(cacheKey); ok {
+			f.Checksum = sum.(string) // nolint: forcetypeassert
+			return nil
+		}
+	}
+	sum, err := s.lister.checksum(ctx, f)
+	if err != nil {
+		return fmt.Errorf("error computing checksum of file %q: %w", f.URL, err)
+	}
+	f.Checksum = sum
+	if cacheKey != "" {
+		checksumCache.Set(cacheKey, sum, cache.DefaultExpiration)
+	}
+	return nil
+}
+
+// allowsFile returns true if the given file name matches the selector's
+// regular expression or if the selector has no regular expression. It returns
+// false otherwise.
+func (s *selector) allowsFile(name string) bool {
+	if s.opts.allowRegex == nil {
+		return true
+	}
+	return s.opts.allowRegex.MatchString(name)
+}
+
+// ignoresFile returns true if the given file name is in the selector's list
+// of ignored file names. It returns false otherwise.
+func (s *selector) ignoresFile(name string) bool {
+	return slices.Contains(s.opts.Ignore, name)
+}
+
+// versionOf extracts a version from the given file name. If no version can be
+// extracted, the file name itself is returned.
+func (s *selector) versionOf(name string) string {
+	versionRegex := s.opts.versionRegex
+	if versionRegex == nil {
+		versionRegex = defaultVersionRegex
+	}
+	matches := versionRegex.FindStringSubmatch(name)
+	if matches == nil {
+		return name
+	}
+	if len(matches) > 1 && matches[1] != "" {
+		return matches[1]
+	}
+	return matches[0]
+}
+
+func sortFilesBySemVer(files []File) {
+	slices.SortFunc(files, func(lhs, rhs File) int {
+		if comp := rhs.semVer.Compare(lhs.semVer); comp != 0 {
+			return comp
+		}
+		// If the semvers tie, break the tie lexically using the file names. This
+		// ensures a deterministic ordering of files with equivalent versions.
+		return strings.Compare(rhs.Name, lhs.Name)
+	})
+}
+
+func sortFilesLexically(files []File) {
+	slices.SortFunc(files, func(lhs, rhs File) int {
+		return strings.Compare(rhs.Name, lhs.Name)
+	})
+}
+
+func sortFilesByLastModified(files []File) {
+	slices.SortFunc(files, func(lhs, rhs File) int {
+		var lhsTime, rhsTime time.Time
+		if lhs.LastModified != nil {
+			lhsTime = *lhs.LastModified
+		}
+		if rhs.LastModified != nil {
+			rhsTime = *rhs.LastModified
+		}
+		if comp := rhsTime.Compare(lhsTime); comp != 0 {
+			return comp
+		}
+		return strings.Compare(rhs.Name, lhs.Name)
+	})
+}
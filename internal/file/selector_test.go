@@ -0,0 +1,398 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLister struct {
+	files      []File
+	listFn     func(context.Context) ([]File, error)
+	statFn     func(context.Context, *File) error
+	checksumFn func(context.Context, *File) (string, error)
+}
+
+func (f *fakeLister) list(ctx context.Context) ([]File, error) {
+	if f.listFn != nil {
+		return f.listFn(ctx)
+	}
+	return f.files, nil
+}
+
+func (f *fakeLister) stat(ctx context.Context, file *File) error {
+	if f.statFn != nil {
+		return f.statFn(ctx, file)
+	}
+	return nil
+}
+
+func (f *fakeLister) checksum(ctx context.Context, file *File) (string, error) {
+	if f.checksumFn != nil {
+		return f.checksumFn(ctx, file)
+	}
+	return "", nil
+}
+
+func TestNewSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		repoURL    string
+		strategy   SelectionStrategy
+		opts       *SelectorOptions
+		assertions func(*testing.T, Selector, error)
+	}{
+		{
+			name:     "invalid semver constraint",
+			repoURL:  "s3://fake-bucket/",
+			strategy: SelectionStrategySemVer,
+			opts:     &SelectorOptions{Constraint: "invalid"},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name:     "invalid version regex",
+			repoURL:  "s3://fake-bucket/",
+			strategy: SelectionStrategyLexical,
+			opts:     &SelectorOptions{VersionRegex: "("},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name:     "invalid allow regex",
+			repoURL:  "s3://fake-bucket/",
+			strategy: SelectionStrategyLexical,
+			opts:     &SelectorOptions{AllowRegex: "("},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name:     "invalid strategy",
+			repoURL:  "s3://fake-bucket/",
+			strategy: "bogus",
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "invalid file selection strategy")
+			},
+		},
+		{
+			name:    "unsupported URL scheme",
+			repoURL: "ftp://example.com/files/",
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "unsupported URL scheme")
+			},
+		},
+		{
+			name:    "S3 URL without bucket",
+			repoURL: "s3:///",
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "no bucket specified")
+			},
+		},
+		{
+			name:    "S3 URL with default strategy",
+			repoURL: "s3://fake-bucket/fake-prefix/",
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				sel, ok := s.(*selector)
+				require.True(t, ok)
+				require.Equal(t, SelectionStrategySemVer, sel.strategy)
+				lister, ok := sel.lister.(*s3Lister)
+				require.True(t, ok)
+				require.Equal(t, "fake-bucket", lister.bucket)
+				require.Equal(t, "fake-prefix/", lister.prefix)
+				require.Equal(t, defaultS3Region, lister.region)
+				require.False(t, lister.pathStyle)
+				require.Equal(t, "fake-bucket.s3.us-east-1.amazonaws.com", lister.endpoint.Host)
+			},
+		},
+		{
+			name:     "HTTP URL",
+			repoURL:  "https://example.com/files/",
+			strategy: SelectionStrategyNewestModified,
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				sel, ok := s.(*selector)
+				require.True(t, ok)
+				_, ok = sel.lister.(*httpIndexLister)
+				require.True(t, ok)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewSelector(testCase.repoURL, testCase.strategy, testCase.opts)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestSelectorVersionOf(t *testing.T) {
+	testCases := []struct {
+		name         string
+		versionRegex string
+		fileName     string
+		expected     string
+	}{
+		{
+			name:     "semver with extension",
+			fileName: "app-1.2.3.tar.gz",
+			expected: "1.2.3",
+		},
+		{
+			name:     "semver with v prefix and pre-release",
+			fileName: "app-v1.2.3-rc.1.tar.gz",
+			expected: "v1.2.3-rc.1",
+		},
+		{
+			name:     "digits in name are not mistaken for version",
+			fileName: "app2_1.0.0.json",
+			expected: "1.0.0",
+		},
+		{
+			name:     "no version",
+			fileName: "manifest.json",
+			expected: "manifest.json",
+		},
+		{
+			name:         "custom regex with capture group",
+			versionRegex: `^build-(\d+)\.zip$`,
+			fileName:     "build-20240101.zip",
+			expected:     "20240101",
+		},
+		{
+			name:         "custom regex without capture group",
+			versionRegex: `\d{8}`,
+			fileName:     "build-20240101.zip",
+			expected:     "20240101",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewSelector(
+				"s3://fake-bucket/",
+				SelectionStrategyLexical,
+				&SelectorOptions{VersionRegex: testCase.versionRegex},
+			)
+			require.NoError(t, err)
+			sel, ok := s.(*selector)
+			require.True(t, ok)
+			require.Equal(t, testCase.expected, sel.versionOf(testCase.fileName))
+		})
+	}
+}
+
+func TestSelectorSelect(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	newest := newer.Add(time.Hour)
+
+	testFiles := []File{
+		{Name: "app-1.0.0.tgz", URL: "s3://bucket/app-1.0.0.tgz", ETag: "a", LastModified: &newest},
+		{Name: "app-1.2.0.tgz", URL: "s3://bucket/app-1.2.0.tgz", ETag: "b", LastModified: &older},
+		{Name: "app-1.1.0.tgz", URL: "s3://bucket/app-1.1.0.tgz", ETag: "c", LastModified: &newer},
+		{Name: "README.md", URL: "s3://bucket/README.md", ETag: "d", LastModified: &older},
+	}
+
+	testCases := []struct {
+		name       string
+		lister     *fakeLister
+		strategy   SelectionStrategy
+		opts       SelectorOptions
+		assertions func(*testing.T, []File, error)
+	}{
+		{
+			name: "error listing files",
+			lister: &fakeLister{
+				listFn: func(context.Context) ([]File, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			strategy: SelectionStrategySemVer,
+			assertions: func(t *testing.T, files []File, err error) {
+				require.ErrorContains(t, err, "error listing files")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Nil(t, files)
+			},
+		},
+		{
+			name:     "no files",
+			lister:   &fakeLister{},
+			strategy: SelectionStrategySemVer,
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Nil(t, files)
+			},
+		},
+		{
+			name:     "semver",
+			lister:   &fakeLister{files: testFiles},
+			strategy: SelectionStrategySemVer,
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 3)
+				require.Equal(t, "1.2.0", files[0].Version)
+				require.Equal(t, "b", files[0].ETag)
+				require.Equal(t, "1.1.0", files[1].Version)
+				require.Equal(t, "1.0.0", files[2].Version)
+			},
+		},
+		{
+			name:     "semver with constraint and discovery limit",
+			lister:   &fakeLister{files: testFiles},
+			strategy: SelectionStrategySemVer,
+			opts: SelectorOptions{
+				Constraint:     "<1.2.0",
+				DiscoveryLimit: 1,
+			},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 1)
+				require.Equal(t, "1.1.0", files[0].Version)
+			},
+		},
+		{
+			name:     "lexical with allow regex and ignore",
+			lister:   &fakeLister{files: testFiles},
+			strategy: SelectionStrategyLexical,
+			opts: SelectorOptions{
+				AllowRegex: `\.tgz$`,
+				Ignore:     []string{"app-1.2.0.tgz"},
+			},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+				require.Equal(t, "app-1.1.0.tgz", files[0].Name)
+				require.Equal(t, "app-1.0.0.tgz", files[1].Name)
+			},
+		},
+		{
+			name:     "newest modified",
+			lister:   &fakeLister{files: testFiles},
+			strategy: SelectionStrategyNewestModified,
+			opts: SelectorOptions{
+				DiscoveryLimit: 2,
+			},
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+				require.Equal(t, "app-1.0.0.tgz", files[0].Name)
+				require.Equal(t, "app-1.1.0.tgz", files[1].Name)
+			},
+		},
+		{
+			name: "files are stated when metadata is missing",
+			lister: &fakeLister{
+				files: []File{
+					{Name: "app-1.0.0.tgz", URL: "https://example.com/app-1.0.0.tgz"},
+					{Name: "app-1.1.0.tgz", URL: "https://example.com/app-1.1.0.tgz"},
+				},
+				statFn: func(_ context.Context, f *File) error {
+					f.ETag = "etag-of-" + f.Name
+					switch f.Name {
+					case "app-1.0.0.tgz":
+						f.LastModified = &newer
+					default:
+						f.LastModified = &older
+					}
+					return nil
+				},
+			},
+			strategy: SelectionStrategyNewestModified,
+			assertions: func(t *testing.T, files []File, err error) {
+				require.NoError(t, err)
+				require.Len(t, files, 2)
+				require.Equal(t, "app-1.0.0.tgz", files[0].Name)
+				require.Equal(t, "etag-of-app-1.0.0.tgz", files[0].ETag)
+				require.Equal(t, "app-1.1.0.tgz", files[1].Name)
+			},
+		},
+		{
+			name: "error computing checksum",
+			lister: &fakeLister{
+				files: []File{
+					{Name: "app-1.0.0.tgz", URL: "https://example.com/app-1.0.0.tgz"},
+				},
+				checksumFn: func(context.Context, *File) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			strategy: SelectionStrategySemVer,
+			assertions: func(t *testing.T, files []File, err error) {
+				require.ErrorContains(t, err, "error computing checksum of file")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Nil(t, files)
+			},
+		},
+		{
+			name: "error stating file",
+			lister: &fakeLister{
+				files: []File{
+					{Name: "app-1.0.0.tgz", URL: "https://example.com/app-1.0.0.tgz"},
+				},
+				statFn: func(context.Context, *File) error {
+					return errors.New("something went wrong")
+				},
+			},
+			strategy: SelectionStrategySemVer,
+			assertions: func(t *testing.T, files []File, err error) {
+				require.ErrorContains(t, err, "error retrieving metadata for file")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Nil(t, files)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewSelector("s3://fake-bucket/", testCase.strategy, &testCase.opts)
+			require.NoError(t, err)
+			sel, ok := s.(*selector)
+			require.True(t, ok)
+			sel.lister = testCase.lister
+			files, err := sel.Select(context.Background())
+			testCase.assertions(t, files, err)
+		})
+	}
+}
+
+func TestSelectorChecksumsAreCached(t *testing.T) {
+	var calls int
+	lister := &fakeLister{
+		files: []File{
+			{
+				Name: "app-1.0.0.tgz",
+				URL:  "https://example.com/cached/app-1.0.0.tgz",
+				ETag: "etag-1",
+			},
+		},
+		checksumFn: func(_ context.Context, f *File) (string, error) {
+			calls++
+			return "sha256:" + f.ETag, nil
+		},
+	}
+	s, err := NewSelector("s3://fake-bucket/", SelectionStrategySemVer, nil)
+	require.NoError(t, err)
+	sel, ok := s.(*selector)
+	require.True(t, ok)
+	sel.lister = lister
+
+	for range 2 {
+		files, err := sel.Select(context.Background())
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		require.Equal(t, "sha256:etag-1", files[0].Checksum)
+	}
+	require.Equal(t, 1, calls)
+
+	// A change of ETag means the content changed and must be hashed again
+	lister.files[0].ETag = "etag-2"
+	files, err := sel.Select(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "sha256:etag-2", files[0].Checksum)
+	require.Equal(t, 2, calls)
+}
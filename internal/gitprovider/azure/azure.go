@@ -0,0 +1,478 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+const (
+	GitProviderServiceName = "azure"
+
+	// apiVersion is the version of the Azure DevOps REST API that is requested.
+	// 6.0 is the most recent version supported by Azure DevOps Server 2020 and
+	// is still supported by Azure DevOps Services.
+	apiVersion = "6.0"
+
+	// pageSize is the number of pull requests requested per page when listing
+	// pull requests.
+	pageSize = 100
+
+	// maxErrorBodySize is the maximum number of bytes of an error response's
+	// body that are included in the error returned to the caller.
+	maxErrorBodySize = 1024
+
+	azureStatusActive    = "active"
+	azureStatusAll       = "all"
+	azureStatusCompleted = "completed"
+
+	refsHeadsPrefix = "refs/heads/"
+)
+
+var (
+	registration = gitprovider.ProviderRegistration{
+		Predicate: func(repoURL string) bool {
+			u, err := url.Parse(git.NormalizeURL(repoURL))
+			if err != nil {
+				return false
+			}
+			// Azure DevOps Server is self-hosted under arbitrary hostnames, so only
+			// Azure DevOps Services is recognized here. Repositories hosted by
+			// Azure DevOps Server require the provider to be selected explicitly by
+			// name.
+			host := u.Hostname()
+			return host == "dev.azure.com" ||
+				host == "ssh.dev.azure.com" ||
+				strings.HasSuffix(host, ".visualstudio.com")
+		},
+		NewService: func(
+			repoURL string,
+			opts *gitprovider.GitProviderOptions,
+		) (gitprovider.GitProviderService, error) {
+			return NewAzureProvider(repoURL, opts)
+		},
+	}
+)
+
+func init() {
+	gitprovider.RegisterProvider(GitProviderServiceName, registration)
+}
+
+// azureProvider is a gitprovider.GitProviderService backed by the REST API of
+// Azure DevOps Services or Azure DevOps Server.
+type azureProvider struct {
+	// baseURL is the URL of the organization (Azure DevOps Services) or project
+	// collection (Azure DevOps Server) the repository belongs to.
+	baseURL    string
+	project    string
+	repo       string
+	token      string
+	httpClient *http.Client
+}
+
+// NewAzureProvider returns a gitprovider.GitProviderService for the Azure
+// Repos repository with the provided URL. The provided token is expected to be
+// a personal access token.
+func NewAzureProvider(
+	repoURL string,
+	opts *gitprovider.GitProviderOptions,
+) (gitprovider.GitProviderService, error) {
+	if opts == nil {
+		opts = &gitprovider.GitProviderOptions{}
+	}
+	baseURL, project, repo, err := parseAzureURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	return &azureProvider{
+		baseURL: baseURL,
+		project: project,
+		repo:    repo,
+		token:   opts.Token,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: opts.InsecureSkipTLSVerify, // nolint: gosec
+				},
+			},
+		},
+	}, nil
+}
+
+type azurePullRequest struct {
+	PullRequestID         int64            `json:"pullRequestId"`
+	Status                string           `json:"status"`
+	Title                 string           `json:"title"`
+	SourceRefName         string           `json:"sourceRefName"`
+	TargetRefName         string           `json:"targetRefName"`
+	LastMergeSourceCommit *azureCommit     `json:"lastMergeSourceCommit"`
+	LastMergeCommit       *azureCommit     `json:"lastMergeCommit"`
+	ForkSource            *json.RawMessage `json:"forkSource"`
+	Labels                []azureLabel     `json:"labels"`
+	CreationDate          *time.Time       `json:"creationDate"`
+	ClosedDate            *time.Time       `json:"closedDate"`
+}
+
+type azureCommit struct {
+	CommitID string `json:"commitId"`
+}
+
+type azureLabel struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active,omitempty"`
+}
+
+type azurePullRequestList struct {
+	Value []azurePullRequest `json:"value"`
+}
+
+func (a *azureProvider) CreatePullRequest(
+	ctx context.Context,
+	opts gitprovider.CreatePullRequestOpts,
+) (*gitprovider.PullRequest, error) {
+	req := map[string]any{
+		"sourceRefName": refsHeadsPrefix + opts.Head,
+		"targetRefName": refsHeadsPrefix + opts.Base,
+		"title":         opts.Title,
+		"description":   opts.Description,
+	}
+	// Assignees, reviewers, and auto-completion can only be specified by
+	// identity ID, so only labels are supported.
+	if len(opts.Labels) > 0 {
+		labels := make([]azureLabel, 0, len(opts.Labels))
+		for _, label := range opts.Labels {
+			labels = append(labels, azureLabel{Name: label})
+		}
+		req["labels"] = labels
+	}
+	azPR := &azurePullRequest{}
+	if err := a.do(ctx, http.MethodPost, a.url(nil, "pullrequests"), req, azPR); err != nil {
+		return nil, err
+	}
+	return a.convertPR(azPR), nil
+}
+
+func (a *azureProvider) GetPullRequest(
+	ctx context.Context,
+	id int64,
+) (*gitprovider.PullRequest, error) {
+	azPR, err := a.getPullRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return a.convertPR(azPR), nil
+}
+
+func (a *azureProvider) ListPullRequests(
+	ctx context.Context,
+	opts gitprovider.ListPullRequestOpts,
+) ([]*gitprovider.PullRequest, error) {
+	query := url.Values{"$top": []string{strconv.Itoa(pageSize)}}
+	switch opts.State {
+	case "", gitprovider.PullRequestStateOpen:
+		query.Set("searchCriteria.status", azureStatusActive)
+	case gitprovider.PullRequestStateClosed:
+		// Closed pull requests may be either completed or abandoned, so we
+		// request all of them and filter out those that are still active below.
+		query.Set("searchCriteria.status", azureStatusAll)
+	}
+	if opts.Head != "" {
+		query.Set("searchCriteria.sourceRefName", refsHeadsPrefix+opts.Head)
+	}
+	if opts.Base != "" {
+		query.Set("searchCriteria.targetRefName", refsHeadsPrefix+opts.Base)
+	}
+	var prs []*gitprovider.PullRequest
+	for skip := 0; ; skip += pageSize {
+		query.Set("$skip", strconv.Itoa(skip))
+		list := &azurePullRequestList{}
+		if err := a.do(ctx, http.MethodGet, a.url(query, "pullrequests"), nil, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Value {
+			pr := a.convertPR(&list.Value[i])
+			if (opts.State == gitprovider.PullRequestStateClosed && pr.IsOpen()) ||
+				!hasLabels(pr, opts.Labels) {
+				continue
+			}
+			prs = append(prs, pr)
+		}
+		if len(list.Value) < pageSize {
+			break
+		}
+	}
+	return prs, nil
+}
+
+func (a *azureProvider) IsPullRequestMerged(ctx context.Context, id int64) (bool, error) {
+	azPR, err := a.getPullRequest(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return azPR.Status == azureStatusCompleted, nil
+}
+
+func (a *azureProvider) CommentOnPullRequest(
+	ctx context.Context,
+	id int64,
+	body string,
+) error {
+	// Comments on a pull request always belong to a thread, so a new thread
+	// is started for each comment.
+	return a.do(
+		ctx,
+		http.MethodPost,
+		a.url(nil, "pullrequests", strconv.FormatInt(id, 10), "threads"),
+		map[string]any{
+			"comments": []map[string]any{{
+				"content":     body,
+				"commentType": "text",
+			}},
+		},
+		nil,
+	)
+}
+
+// ClosePullRequest abandons the pull request, which is how Azure DevOps closes
+// a pull request without merging it.
+func (a *azureProvider) ClosePullRequest(ctx context.Context, id int64) error {
+	return a.do(
+		ctx,
+		http.MethodPatch,
+		a.url(nil, "pullrequests", strconv.FormatInt(id, 10)),
+		map[string]string{"status": "abandoned"},
+		nil,
+	)
+}
+
+func (a *azureProvider) SetCommitStatus(
+	ctx context.Context,
+	sha string,
+	status gitprovider.CommitStatus,
+) error {
+	state := "pending"
+	switch status.State {
+	case gitprovider.CommitStatusStateSuccess:
+		state = "succeeded"
+	case gitprovider.CommitStatusStateFailure:
+		state = "failed"
+	case gitprovider.CommitStatusStateError:
+		state = "error"
+	}
+	req := map[string]any{
+		"state":   state,
+		"context": map[string]string{"name": status.Context},
+	}
+	if status.Description != "" {
+		req["description"] = status.Description
+	}
+	if status.TargetURL != "" {
+		req["targetUrl"] = status.TargetURL
+	}
+	return a.do(ctx, http.MethodPost, a.url(nil, "commits", sha, "statuses"), req, nil)
+}
+
+func (a *azureProvider) getPullRequest(ctx context.Context, id int64) (*azurePullRequest, error) {
+	azPR := &azurePullRequest{}
+	if err := a.do(
+		ctx,
+		http.MethodGet,
+		a.url(nil, "pullrequests", strconv.FormatInt(id, 10)),
+		nil,
+		azPR,
+	); err != nil {
+		return nil, err
+	}
+	return azPR, nil
+}
+
+// url returns the absolute URL of the API endpoint for the provider's
+// repository at the provided path, with the provided query parameters. The API
+// version is always included in the query.
+func (a *azureProvider) url(query url.Values, pathSegments ...string) string {
+	segments := append(
+		[]string{a.project, "_apis", "git", "repositories", a.repo},
+		pathSegments...,
+	)
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("api-version", apiVersion)
+	return a.baseURL + "/" + strings.Join(segments, "/") + "?" + q.Encode()
+}
+
+// webURL returns the URL of the web page of the pull request with the
+// provided ID.
+func (a *azureProvider) webURL(id int64) string {
+	return fmt.Sprintf(
+		"%s/%s/_git/%s/pullrequest/%d",
+		a.baseURL,
+		url.PathEscape(a.project),
+		url.PathEscape(a.repo),
+		id,
+	)
+}
+
+// do sends a request with the provided method to the provided absolute URL.
+// If reqBody is non-nil, it is marshaled to JSON and sent as the body of the
+// request. If resBody is non-nil, the body of a successful response is
+// unmarshaled into it. A response with a non-2xx status is returned as an
+// error.
+func (a *azureProvider) do(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	reqBody any,
+	resBody any,
+) error {
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if a.token != "" {
+		// Personal access tokens are sent as the password of basic auth with an
+		// empty username.
+		req.SetBasicAuth("", a.token)
+	}
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending %s request to %q: %w", method, reqURL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		return fmt.Errorf(
+			"unexpected HTTP status %d for %s request to %q: %s",
+			res.StatusCode,
+			method,
+			reqURL,
+			strings.TrimSpace(string(msg)),
+		)
+	}
+	if resBody == nil {
+		return nil
+	}
+	if err = json.NewDecoder(res.Body).Decode(resBody); err != nil {
+		return fmt.Errorf("error decoding response from %q: %w", reqURL, err)
+	}
+	return nil
+}
+
+// hasLabels returns true if the given pull request has all the given labels.
+func hasLabels(pr *gitprovider.PullRequest, labels []string) bool {
+	for _, label := range labels {
+		if !slices.Contains(pr.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *azureProvider) convertPR(azPR *azurePullRequest) *gitprovider.PullRequest {
+	pr := &gitprovider.PullRequest{
+		Number:   azPR.PullRequestID,
+		URL:      a.webURL(azPR.PullRequestID),
+		State:    gitprovider.PullRequestStateClosed,
+		Object:   azPR,
+		HeadRef:  strings.TrimPrefix(azPR.SourceRefName, refsHeadsPrefix),
+		FromFork: azPR.ForkSource != nil,
+		Title:    azPR.Title,
+	}
+	if azPR.Status == azureStatusActive {
+		pr.State = gitprovider.PullRequestStateOpen
+	}
+	if azPR.LastMergeSourceCommit != nil {
+		pr.HeadSHA = azPR.LastMergeSourceCommit.CommitID
+	}
+	if azPR.Status == azureStatusCompleted && azPR.LastMergeCommit != nil {
+		pr.MergeCommitSHA = azPR.LastMergeCommit.CommitID
+	}
+	for _, label := range azPR.Labels {
+		if label.Active {
+			pr.Labels = append(pr.Labels, label.Name)
+		}
+	}
+	// Azure DevOps does not record when a pull request was last updated, so the
+	// time it was closed or, failing that, created is used instead.
+	pr.UpdatedAt = azPR.ClosedDate
+	if pr.UpdatedAt == nil {
+		pr.UpdatedAt = azPR.CreationDate
+	}
+	return pr
+}
+
+// parseAzureURL returns the URL of the organization or project collection
+// hosting the repository with the provided URL, along with the names of the
+// repository's project and of the repository itself. Supported are HTTPS URLs
+// of the form [base]/{project}/_git/{repo}, where the base may include an
+// organization or project collection, and Azure DevOps Services SSH URLs of
+// the form ssh.dev.azure.com:v3/{organization}/{project}/{repo}.
+func parseAzureURL(repoURL string) (string, string, string, error) {
+	u, err := url.Parse(git.NormalizeURL(repoURL))
+	if err != nil {
+		return "", "", "", fmt.Errorf("error parsing azure repository URL %q: %w", repoURL, err)
+	}
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	host := u.Hostname()
+	if host == "ssh.dev.azure.com" || host == "vs-ssh.visualstudio.com" {
+		if len(parts) != 4 || parts[0] != "v3" ||
+			parts[1] == "" || parts[2] == "" || parts[3] == "" {
+			return "", "", "", fmt.Errorf(
+				"could not extract organization, project and repository name from URL %q",
+				repoURL,
+			)
+		}
+		return "https://dev.azure.com/" + parts[1], parts[2], parts[3], nil
+	}
+	gitIdx := slices.Index(parts, "_git")
+	if gitIdx < 1 || gitIdx != len(parts)-2 ||
+		parts[gitIdx-1] == "" || parts[gitIdx+1] == "" {
+		return "", "", "", fmt.Errorf(
+			"could not extract project and repository name from URL %q",
+			repoURL,
+		)
+	}
+	if host == "dev.azure.com" && gitIdx < 2 {
+		return "", "", "", fmt.Errorf("could not extract organization from URL %q", repoURL)
+	}
+	base := url.URL{Scheme: "https", Host: u.Host}
+	switch u.Scheme {
+	case "http":
+		base.Scheme = "http"
+	case "ssh":
+		// The API is not served on the port that SSH is served on
+		base.Host = host
+	}
+	if gitIdx > 1 {
+		base.Path = "/" + strings.Join(parts[:gitIdx-1], "/")
+	}
+	return base.String(), parts[gitIdx-1], parts[gitIdx+1], nil
+}
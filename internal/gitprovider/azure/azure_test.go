@@ -0,0 +1,382 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestParseAzureURL(t *testing.T) {
+	testCases := []struct {
+		repoURL         string
+		expectedBaseURL string
+		expectedProject string
+		expectedRepo    string
+		expectedErr     string
+	}{
+		{
+			repoURL:         "https://dev.azure.com/org/project/_git/repo",
+			expectedBaseURL: "https://dev.azure.com/org",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "https://user@dev.azure.com/Org/Project/_git/Repo",
+			expectedBaseURL: "https://dev.azure.com/org",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "https://org.visualstudio.com/project/_git/repo",
+			expectedBaseURL: "https://org.visualstudio.com",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "https://org.visualstudio.com/DefaultCollection/project/_git/repo",
+			expectedBaseURL: "https://org.visualstudio.com/defaultcollection",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "git@ssh.dev.azure.com:v3/org/project/repo",
+			expectedBaseURL: "https://dev.azure.com/org",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "http://tfs.example.com:8080/tfs/collection/project/_git/repo",
+			expectedBaseURL: "http://tfs.example.com:8080/tfs/collection",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "ssh://tfs.example.com:22/collection/project/_git/repo",
+			expectedBaseURL: "https://tfs.example.com/collection",
+			expectedProject: "project",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:     "git@ssh.dev.azure.com:v3/org/repo",
+			expectedErr: "could not extract organization, project and repository name",
+		},
+		{
+			repoURL:     "https://dev.azure.com/project/_git/repo",
+			expectedErr: "could not extract organization",
+		},
+		{
+			repoURL:     "https://tfs.example.com/collection/project/repo",
+			expectedErr: "could not extract project and repository name",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			baseURL, project, repo, err := parseAzureURL(testCase.repoURL)
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedBaseURL, baseURL)
+			require.Equal(t, testCase.expectedProject, project)
+			require.Equal(t, testCase.expectedRepo, repo)
+		})
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	require.True(t, registration.Predicate("https://dev.azure.com/org/project/_git/repo"))
+	require.True(t, registration.Predicate("git@ssh.dev.azure.com:v3/org/project/repo"))
+	require.True(t, registration.Predicate("https://org.visualstudio.com/project/_git/repo"))
+	require.False(t, registration.Predicate("https://tfs.example.com/collection/project/_git/repo"))
+}
+
+const (
+	testHeadSHA  = "1111111111111111111111111111111111111111"
+	testMergeSHA = "2222222222222222222222222222222222222222"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	pr := func(id int64, status string, labels ...string) map[string]any {
+		pr := map[string]any{
+			"pullRequestId":         id,
+			"status":                status,
+			"title":                 "fake-title",
+			"sourceRefName":         "refs/heads/head",
+			"targetRefName":         "refs/heads/base",
+			"lastMergeSourceCommit": map[string]any{"commitId": testHeadSHA},
+			"lastMergeCommit":       map[string]any{"commitId": testMergeSHA},
+			"creationDate":          "2024-01-01T00:00:00Z",
+		}
+		if status != azureStatusActive {
+			pr["closedDate"] = "2024-01-02T00:00:00Z"
+		}
+		var prLabels []any
+		for _, label := range labels {
+			prLabels = append(prLabels, map[string]any{"name": label, "active": true})
+		}
+		pr["labels"] = prLabels
+		return pr
+	}
+
+	const prsPath = "/org/project/_apis/git/repositories/repo/pullrequests"
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Empty(t, username)
+		require.Equal(t, "fake-token", password)
+		require.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+		var res any
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == prsPath:
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"sourceRefName": "refs/heads/head",
+				"targetRefName": "refs/heads/base",
+				"title":         "fake-title",
+				"description":   "fake-description",
+				"labels":        []any{map[string]any{"name": "preview"}},
+			}, req)
+			res = pr(1, azureStatusActive)
+		case r.Method == http.MethodPost && r.URL.Path == prsPath+"/2/threads":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"comments": []any{map[string]any{
+					"content":     "comment",
+					"commentType": "text",
+				}},
+			}, req)
+			res = map[string]any{}
+		case r.Method == http.MethodPatch && r.URL.Path == prsPath+"/2":
+			req := map[string]string{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]string{"status": "abandoned"}, req)
+			res = pr(2, "abandoned")
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/org/project/_apis/git/repositories/repo/commits/"+testHeadSHA+"/statuses":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"state":     "succeeded",
+				"context":   map[string]any{"name": "kargo/test"},
+				"targetUrl": "https://kargo.example.com",
+			}, req)
+			res = map[string]any{}
+		case r.URL.Path == prsPath+"/1":
+			res = pr(1, azureStatusCompleted)
+		case r.URL.Path == prsPath+"/2":
+			res = pr(2, "abandoned")
+		case r.URL.Path == prsPath:
+			query := r.URL.Query()
+			require.Equal(t, strconv.Itoa(pageSize), query.Get("$top"))
+			if query.Get("searchCriteria.status") == azureStatusAll {
+				res = map[string]any{
+					"value": []any{
+						pr(1, azureStatusCompleted),
+						pr(2, azureStatusActive),
+					},
+				}
+				break
+			}
+			require.Equal(t, azureStatusActive, query.Get("searchCriteria.status"))
+			require.Equal(t, "refs/heads/head", query.Get("searchCriteria.sourceRefName"))
+			require.Equal(t, "refs/heads/base", query.Get("searchCriteria.targetRefName"))
+			if query.Get("$skip") == strconv.Itoa(pageSize) {
+				res = map[string]any{
+					"value": []any{pr(pageSize+1, azureStatusActive, "preview")},
+				}
+				break
+			}
+			prs := make([]any, 0, pageSize)
+			for i := 1; i <= pageSize; i++ {
+				prs = append(prs, pr(int64(i), azureStatusActive))
+			}
+			res = map[string]any{"value": prs}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+func newTestProvider(t *testing.T, srv *httptest.Server) gitprovider.GitProviderService {
+	p, err := NewAzureProvider(
+		srv.URL+"/org/project/_git/repo",
+		&gitprovider.GitProviderOptions{Token: "fake-token"},
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	pr, err := newTestProvider(t, srv).CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:        "head",
+			Base:        "base",
+			Title:       "fake-title",
+			Description: "fake-description",
+			Labels:      []string{"preview"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pr.Number)
+	require.Equal(t, srv.URL+"/org/project/_git/repo/pullrequest/1", pr.URL)
+	require.Equal(t, gitprovider.PullRequestStateOpen, pr.State)
+	require.Equal(t, testHeadSHA, pr.HeadSHA)
+	require.Equal(t, "head", pr.HeadRef)
+	// The last merge commit of an active pull request is only a preview of the
+	// result of merging it, not an actual merge commit.
+	require.Empty(t, pr.MergeCommitSHA)
+	require.False(t, pr.FromFork)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *pr.UpdatedAt)
+}
+
+func TestGetPullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	pr, err := p.GetPullRequest(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	require.Equal(t, testMergeSHA, pr.MergeCommitSHA)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), *pr.UpdatedAt)
+
+	_, err = p.GetPullRequest(context.Background(), 42)
+	require.ErrorContains(t, err, "unexpected HTTP status 404")
+}
+
+func TestListPullRequests(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	prs, err := p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			Head: "head",
+			Base: "base",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, pageSize+1)
+
+	prs, err = p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			Head:   "head",
+			Base:   "base",
+			Labels: []string{"preview"},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	require.Equal(t, int64(pageSize+1), prs[0].Number)
+	require.Equal(t, []string{"preview"}, prs[0].Labels)
+
+	prs, err = p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{State: gitprovider.PullRequestStateClosed},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	require.Equal(t, int64(1), prs[0].Number)
+}
+
+func TestIsPullRequestMerged(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	merged, err := p.IsPullRequestMerged(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, merged)
+
+	merged, err = p.IsPullRequestMerged(context.Background(), 2)
+	require.NoError(t, err)
+	require.False(t, merged)
+}
+
+func TestConvertPR(t *testing.T) {
+	p := &azureProvider{
+		baseURL: "https://dev.azure.com/org",
+		project: "my project",
+		repo:    "repo",
+	}
+	forkSource := json.RawMessage(`{"name":"refs/heads/head"}`)
+	pr := p.convertPR(&azurePullRequest{
+		PullRequestID: 1,
+		Status:        "abandoned",
+		SourceRefName: "refs/heads/head",
+		ForkSource:    &forkSource,
+		Labels: []azureLabel{
+			{Name: "active", Active: true},
+			{Name: "inactive"},
+		},
+	})
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	require.Equal(t, "https://dev.azure.com/org/my%20project/_git/repo/pullrequest/1", pr.URL)
+	require.True(t, pr.FromFork)
+	require.Equal(t, []string{"active"}, pr.Labels)
+	require.Empty(t, pr.HeadSHA)
+	require.Nil(t, pr.UpdatedAt)
+}
+
+func TestCommentOnPullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	require.NoError(t, p.CommentOnPullRequest(context.Background(), 2, "comment"))
+	require.ErrorContains(
+		t,
+		p.CommentOnPullRequest(context.Background(), 42, "comment"),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestClosePullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	require.NoError(t, p.ClosePullRequest(context.Background(), 2))
+	require.ErrorContains(
+		t,
+		p.ClosePullRequest(context.Background(), 42),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	status := gitprovider.CommitStatus{
+		State:     gitprovider.CommitStatusStateSuccess,
+		Context:   "kargo/test",
+		TargetURL: "https://kargo.example.com",
+	}
+	require.NoError(t, p.SetCommitStatus(context.Background(), testHeadSHA, status))
+	require.ErrorContains(
+		t,
+		p.SetCommitStatus(context.Background(), testMergeSHA, status),
+		"unexpected HTTP status 404",
+	)
+}
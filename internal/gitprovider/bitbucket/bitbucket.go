@@ -0,0 +1,327 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" // nolint: gosec
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+const (
+	GitProviderServiceName = "bitbucket"
+
+	// cloudHost is the host of Bitbucket Cloud. Repositories hosted on any
+	// other host are assumed to be hosted by Bitbucket Data Center (formerly
+	// known as Bitbucket Server).
+	cloudHost = "bitbucket.org"
+	// cloudAPIBaseURL is the base URL of the Bitbucket Cloud REST API.
+	cloudAPIBaseURL = "https://api.bitbucket.org/2.0"
+
+	// maxErrorBodySize is the maximum number of bytes of an error response's
+	// body that are included in the error returned to the caller.
+	maxErrorBodySize = 1024
+)
+
+var (
+	// errLabelsNotSupported is returned when pull requests are to be created or
+	// listed by label, as Bitbucket has no notion of labels.
+	errLabelsNotSupported = errors.New("labels are not supported by Bitbucket")
+
+	registration = gitprovider.ProviderRegistration{
+		Predicate: func(repoURL string) bool {
+			u, err := parseRepoURL(repoURL)
+			if err != nil {
+				return false
+			}
+			// We assume that any hostname with the word "bitbucket" in the
+			// hostname, can use this provider. NOTE: we will miss cases where the
+			// host is Bitbucket Data Center but doesn't incorporate the word
+			// "bitbucket" in the hostname. e.g. 'git.mycompany.com'
+			return strings.Contains(u.Host, GitProviderServiceName)
+		},
+		NewService: func(
+			repoURL string,
+			opts *gitprovider.GitProviderOptions,
+		) (gitprovider.GitProviderService, error) {
+			return NewBitbucketProvider(repoURL, opts)
+		},
+	}
+)
+
+func init() {
+	gitprovider.RegisterProvider(GitProviderServiceName, registration)
+}
+
+// NewBitbucketProvider returns a gitprovider.GitProviderService for the
+// repository with the provided URL. Repositories hosted on bitbucket.org are
+// accessed using the Bitbucket Cloud REST API. Repositories hosted anywhere
+// else are accessed using the Bitbucket Data Center REST API, in which case the
+// repository URL may be an HTTP/S clone URL (e.g.
+// https://bitbucket.example.com/scm/PROJ/repo.git), an SSH clone URL (e.g.
+// ssh://git@bitbucket.example.com:7999/PROJ/repo.git), or the URL of the
+// repository's page in the web interface (e.g.
+// https://bitbucket.example.com/projects/PROJ/repos/repo). In either case, the
+// token from the provided options, if any, is sent as a bearer token, which
+// means it must be a repository, project, or workspace access token or, for
+// Data Center, an HTTP access token.
+func NewBitbucketProvider(
+	repoURL string,
+	opts *gitprovider.GitProviderOptions,
+) (gitprovider.GitProviderService, error) {
+	if opts == nil {
+		opts = &gitprovider.GitProviderOptions{}
+	}
+	u, err := parseRepoURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: opts.InsecureSkipTLSVerify, // nolint: gosec
+			},
+		},
+	}
+	if strings.EqualFold(u.Hostname(), cloudHost) {
+		workspace, repo, err := parseCloudRepoPath(u.Path)
+		if err != nil {
+			return nil, err
+		}
+		return newCloudProvider(
+			&client{
+				baseURL:    cloudAPIBaseURL,
+				token:      opts.Token,
+				httpClient: httpClient,
+			},
+			workspace,
+			repo,
+		), nil
+	}
+	baseURL, project, repo, err := parseDataCenterRepoURL(u)
+	if err != nil {
+		return nil, err
+	}
+	return newDataCenterProvider(
+		&client{
+			baseURL:    baseURL,
+			token:      opts.Token,
+			httpClient: httpClient,
+		},
+		project,
+		repo,
+	), nil
+}
+
+// parseRepoURL parses the provided repository URL, which may use the SCP-like
+// syntax supported by Git (e.g. git@bitbucket.org:workspace/repo.git), and
+// strips any trailing slash or ".git" suffix from its path. Unlike
+// git.NormalizeURL, it preserves the case of the URL's path, so that project
+// keys and repository slugs are passed to the API exactly as specified.
+func parseRepoURL(repoURL string) (*url.URL, error) {
+	raw := repoURL
+	if !strings.Contains(raw, "://") {
+		if i := strings.Index(raw, ":"); i > 0 {
+			raw = "ssh://" + raw[:i] + "/" + strings.TrimPrefix(raw[i+1:], "/")
+		}
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing bitbucket repository URL %q: %w", repoURL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("bitbucket repository URL %q has no host", repoURL)
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), ".git")
+	return u, nil
+}
+
+// parseCloudRepoPath extracts the workspace and repository slug from the path
+// of a Bitbucket Cloud repository URL.
+func parseCloudRepoPath(path string) (string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf(
+			"could not extract workspace and repository name from path %q", path,
+		)
+	}
+	return parts[0], parts[1], nil
+}
+
+// parseDataCenterRepoURL extracts the base URL of the Bitbucket Data Center
+// instance, the project key, and the repository slug from the provided
+// repository URL. Repositories in personal projects are identified by a
+// project key of the form "~username".
+func parseDataCenterRepoURL(u *url.URL) (string, string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	var contextPath []string
+	var project, repo string
+	for i := 0; i < len(parts) && project == ""; i++ {
+		switch {
+		case parts[i] == "scm" && len(parts) == i+3:
+			// HTTP/S clone URL: [/context]/scm/PROJ/repo
+			contextPath, project, repo = parts[:i], parts[i+1], parts[i+2]
+		case parts[i] == "projects" && len(parts) >= i+4 && parts[i+2] == "repos":
+			// Web interface URL: [/context]/projects/PROJ/repos/repo[/...]
+			contextPath, project, repo = parts[:i], parts[i+1], parts[i+3]
+		case parts[i] == "users" && len(parts) >= i+4 && parts[i+2] == "repos":
+			// Web interface URL: [/context]/users/username/repos/repo[/...]
+			contextPath, project, repo = parts[:i], "~"+parts[i+1], parts[i+3]
+		}
+	}
+	if project == "" && u.Scheme == "ssh" && len(parts) == 2 {
+		// SSH clone URL: ssh://git@host[:port]/PROJ/repo
+		project, repo = parts[0], parts[1]
+	}
+	if project == "" || repo == "" {
+		return "", "", "", fmt.Errorf(
+			"could not extract project key and repository name from URL %q", u,
+		)
+	}
+	base := url.URL{Scheme: "https", Host: u.Host}
+	switch u.Scheme {
+	case "http":
+		base.Scheme = "http"
+	case "ssh":
+		// The API is not served on the port that SSH is served on
+		base.Host = u.Hostname()
+	}
+	base.Path = "/" + strings.Join(append(contextPath, "rest", "api", "1.0"), "/")
+	return base.String(), project, repo, nil
+}
+
+// link is a hyperlink to a resource, as represented by both the Bitbucket Cloud
+// and Data Center REST APIs.
+type link struct {
+	Href string `json:"href"`
+}
+
+// buildStatus is the status of a build of a commit, as represented by both the
+// Bitbucket Cloud and Data Center REST APIs, which model all commit statuses as
+// build statuses.
+type buildStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
+// newBuildStatus returns the build status corresponding to the provided commit
+// status. The context of the commit status is used as the key of the build
+// status, unless it is longer than maxKeyLength, in which case its hex-encoded
+// SHA-1 hash is used instead.
+func newBuildStatus(
+	status gitprovider.CommitStatus,
+	maxKeyLength int,
+) (*buildStatus, error) {
+	// Bitbucket requires every build status to link to the build it represents
+	if status.TargetURL == "" {
+		return nil, errors.New("commit statuses must have a target URL on Bitbucket")
+	}
+	bs := &buildStatus{
+		Key:         status.Context,
+		State:       "INPROGRESS",
+		Name:        status.Context,
+		Description: status.Description,
+		URL:         status.TargetURL,
+	}
+	if len(bs.Key) > maxKeyLength {
+		sum := sha1.Sum([]byte(status.Context)) // nolint: gosec
+		bs.Key = hex.EncodeToString(sum[:])
+	}
+	switch status.State {
+	case gitprovider.CommitStatusStateSuccess:
+		bs.State = "SUCCESSFUL"
+	case gitprovider.CommitStatusStateFailure, gitprovider.CommitStatusStateError:
+		// Bitbucket has no distinct state for errors
+		bs.State = "FAILED"
+	}
+	return bs, nil
+}
+
+// client is a minimal client for the Bitbucket Cloud and Data Center REST
+// APIs, which are both JSON-based.
+type client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// url returns the absolute URL of the API endpoint at the provided path,
+// relative to the client's base URL, with the provided query parameters.
+func (c *client) url(query url.Values, pathSegments ...string) string {
+	escaped := make([]string, len(pathSegments))
+	for i, segment := range pathSegments {
+		escaped[i] = url.PathEscape(segment)
+	}
+	u := c.baseURL + "/" + strings.Join(escaped, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// do sends a request with the provided method to the provided absolute URL.
+// If reqBody is non-nil, it is marshaled to JSON and sent as the body of the
+// request. If resBody is non-nil, the body of a successful response is
+// unmarshaled into it. A response with a non-2xx status is returned as an
+// error.
+func (c *client) do(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	reqBody any,
+	resBody any,
+) error {
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending %s request to %q: %w", method, reqURL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		return fmt.Errorf(
+			"unexpected HTTP status %d for %s request to %q: %s",
+			res.StatusCode,
+			method,
+			reqURL,
+			strings.TrimSpace(string(msg)),
+		)
+	}
+	if resBody == nil {
+		return nil
+	}
+	if err = json.NewDecoder(res.Body).Decode(resBody); err != nil {
+		return fmt.Errorf("error decoding response from %q: %w", reqURL, err)
+	}
+	return nil
+}
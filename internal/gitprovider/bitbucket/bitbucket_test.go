@@ -0,0 +1,230 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestRegistration(t *testing.T) {
+	testCases := []struct {
+		repoURL  string
+		expected bool
+	}{
+		{"https://bitbucket.org/workspace/repo.git", true},
+		{"git@bitbucket.org:workspace/repo.git", true},
+		{"https://bitbucket.example.com/scm/proj/repo.git", true},
+		{"ssh://git@bitbucket.example.com:7999/proj/repo.git", true},
+		{"https://github.com/owner/repo.git", false},
+		{"not a url", false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			require.Equal(t, testCase.expected, registration.Predicate(testCase.repoURL))
+		})
+	}
+}
+
+func TestNewBitbucketProvider(t *testing.T) {
+	testCases := []struct {
+		name       string
+		repoURL    string
+		assertions func(*testing.T, gitprovider.GitProviderService, error)
+	}{
+		{
+			name:    "cloud HTTPS URL",
+			repoURL: "https://bitbucket.org/Workspace/Repo.git",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*cloudProvider)
+				require.True(t, ok)
+				require.Equal(t, cloudAPIBaseURL, p.client.baseURL)
+				require.Equal(t, "Workspace", p.workspace)
+				require.Equal(t, "Repo", p.repo)
+				require.Equal(t, "fake-token", p.client.token)
+			},
+		},
+		{
+			name:    "cloud SCP-like URL",
+			repoURL: "git@bitbucket.org:workspace/repo.git",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*cloudProvider)
+				require.True(t, ok)
+				require.Equal(t, "workspace", p.workspace)
+				require.Equal(t, "repo", p.repo)
+			},
+		},
+		{
+			name:    "cloud URL without repository",
+			repoURL: "https://bitbucket.org/workspace",
+			assertions: func(t *testing.T, _ gitprovider.GitProviderService, err error) {
+				require.ErrorContains(t, err, "could not extract workspace and repository name")
+			},
+		},
+		{
+			name:    "data center HTTPS clone URL",
+			repoURL: "https://bitbucket.example.com/scm/PROJ/repo.git",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*dataCenterProvider)
+				require.True(t, ok)
+				require.Equal(t, "https://bitbucket.example.com/rest/api/1.0", p.client.baseURL)
+				require.Equal(t, "PROJ", p.project)
+				require.Equal(t, "repo", p.repo)
+			},
+		},
+		{
+			name:    "data center HTTP clone URL with context path",
+			repoURL: "http://example.com:7990/bitbucket/scm/PROJ/repo.git",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*dataCenterProvider)
+				require.True(t, ok)
+				require.Equal(t, "http://example.com:7990/bitbucket/rest/api/1.0", p.client.baseURL)
+				require.Equal(t, "PROJ", p.project)
+				require.Equal(t, "repo", p.repo)
+			},
+		},
+		{
+			name:    "data center SSH clone URL",
+			repoURL: "ssh://git@bitbucket.example.com:7999/PROJ/repo.git",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*dataCenterProvider)
+				require.True(t, ok)
+				require.Equal(t, "https://bitbucket.example.com/rest/api/1.0", p.client.baseURL)
+				require.Equal(t, "PROJ", p.project)
+				require.Equal(t, "repo", p.repo)
+			},
+		},
+		{
+			name:    "data center web interface URL",
+			repoURL: "https://bitbucket.example.com/projects/PROJ/repos/repo/browse",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*dataCenterProvider)
+				require.True(t, ok)
+				require.Equal(t, "PROJ", p.project)
+				require.Equal(t, "repo", p.repo)
+			},
+		},
+		{
+			name:    "data center personal repository URL",
+			repoURL: "https://bitbucket.example.com/users/jdoe/repos/repo",
+			assertions: func(t *testing.T, svc gitprovider.GitProviderService, err error) {
+				require.NoError(t, err)
+				p, ok := svc.(*dataCenterProvider)
+				require.True(t, ok)
+				require.Equal(t, "~jdoe", p.project)
+				require.Equal(t, "repo", p.repo)
+			},
+		},
+		{
+			name:    "data center URL without project",
+			repoURL: "https://bitbucket.example.com/repo.git",
+			assertions: func(t *testing.T, _ gitprovider.GitProviderService, err error) {
+				require.ErrorContains(t, err, "could not extract project key and repository name")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			svc, err := NewBitbucketProvider(
+				testCase.repoURL,
+				&gitprovider.GitProviderOptions{Token: "fake-token"},
+			)
+			testCase.assertions(t, svc, err)
+		})
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"hash":"abc"}`))
+		case "/invalid":
+			_, _ = w.Write([]byte(`{`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"not found"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := &client{
+		baseURL:    srv.URL,
+		token:      "fake-token",
+		httpClient: srv.Client(),
+	}
+
+	commit := &cloudCommit{}
+	require.NoError(t, c.do(context.Background(), http.MethodGet, c.url(nil, "ok"), nil, commit))
+	require.Equal(t, "abc", commit.Hash)
+
+	err := c.do(context.Background(), http.MethodGet, c.url(nil, "invalid"), nil, commit)
+	require.ErrorContains(t, err, "error decoding response")
+
+	err = c.do(context.Background(), http.MethodGet, c.url(nil, "missing"), nil, commit)
+	require.ErrorContains(t, err, "unexpected HTTP status 404")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestNewBuildStatus(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     gitprovider.CommitStatus
+		assertions func(*testing.T, *buildStatus, error)
+	}{
+		{
+			name: "no target URL",
+			status: gitprovider.CommitStatus{
+				State:   gitprovider.CommitStatusStatePending,
+				Context: "kargo/test",
+			},
+			assertions: func(t *testing.T, _ *buildStatus, err error) {
+				require.ErrorContains(t, err, "must have a target URL")
+			},
+		},
+		{
+			name: "pending",
+			status: gitprovider.CommitStatus{
+				State:     gitprovider.CommitStatusStatePending,
+				Context:   "kargo/test",
+				TargetURL: "https://kargo.example.com",
+			},
+			assertions: func(t *testing.T, bs *buildStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "kargo/test", bs.Key)
+				require.Equal(t, "INPROGRESS", bs.State)
+			},
+		},
+		{
+			name: "long context",
+			status: gitprovider.CommitStatus{
+				State:     gitprovider.CommitStatusStateSuccess,
+				Context:   "kargo/a-very-long-project-name/a-very-long-stage-name",
+				TargetURL: "https://kargo.example.com",
+			},
+			assertions: func(t *testing.T, bs *buildStatus, err error) {
+				require.NoError(t, err)
+				require.Len(t, bs.Key, cloudMaxBuildStatusKeyLength)
+				require.Equal(t, "kargo/a-very-long-project-name/a-very-long-stage-name", bs.Name)
+				require.Equal(t, "SUCCESSFUL", bs.State)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bs, err := newBuildStatus(testCase.status, cloudMaxBuildStatusKeyLength)
+			testCase.assertions(t, bs, err)
+		})
+	}
+}
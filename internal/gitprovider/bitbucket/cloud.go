@@ -0,0 +1,323 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+const (
+	cloudStateOpen       = "OPEN"
+	cloudStateMerged     = "MERGED"
+	cloudStateDeclined   = "DECLINED"
+	cloudStateSuperseded = "SUPERSEDED"
+
+	// fullCommitHashLength is the length of a full, hex-encoded SHA-1 commit
+	// hash. Bitbucket Cloud abbreviates the commit hashes it includes in pull
+	// requests.
+	fullCommitHashLength = 40
+
+	// cloudMaxBuildStatusKeyLength is the maximum length of the key of a build
+	// status accepted by Bitbucket Cloud.
+	cloudMaxBuildStatusKeyLength = 40
+)
+
+// cloudProvider is a gitprovider.GitProviderService backed by the Bitbucket
+// Cloud REST API.
+type cloudProvider struct {
+	client    *client
+	workspace string
+	repo      string
+}
+
+func newCloudProvider(c *client, workspace, repo string) *cloudProvider {
+	return &cloudProvider{
+		client:    c,
+		workspace: workspace,
+		repo:      repo,
+	}
+}
+
+type cloudPullRequest struct {
+	ID          int64         `json:"id"`
+	Title       string        `json:"title"`
+	State       string        `json:"state"`
+	Source      cloudEndpoint `json:"source"`
+	Destination cloudEndpoint `json:"destination"`
+	MergeCommit *cloudCommit  `json:"merge_commit,omitempty"`
+	UpdatedOn   *time.Time    `json:"updated_on,omitempty"`
+	Links       struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+}
+
+type cloudCreatePullRequest struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description,omitempty"`
+	Source      cloudEndpoint `json:"source"`
+	Destination cloudEndpoint `json:"destination"`
+}
+
+type cloudEndpoint struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Commit     *cloudCommit     `json:"commit,omitempty"`
+	Repository *cloudRepository `json:"repository,omitempty"`
+}
+
+type cloudCommit struct {
+	Hash string `json:"hash"`
+}
+
+type cloudRepository struct {
+	FullName string `json:"full_name"`
+}
+
+type cloudPullRequestPage struct {
+	Values []cloudPullRequest `json:"values"`
+	Next   string             `json:"next"`
+}
+
+func (c *cloudProvider) CreatePullRequest(
+	ctx context.Context,
+	opts gitprovider.CreatePullRequestOpts,
+) (*gitprovider.PullRequest, error) {
+	if len(opts.Labels) > 0 {
+		return nil, errLabelsNotSupported
+	}
+	req := cloudCreatePullRequest{
+		Title:       opts.Title,
+		Description: opts.Description,
+	}
+	req.Source.Branch.Name = opts.Head
+	req.Destination.Branch.Name = opts.Base
+	bbPR := &cloudPullRequest{}
+	if err := c.client.do(
+		ctx,
+		http.MethodPost,
+		c.client.url(nil, c.repoPath("pullrequests")...),
+		req,
+		bbPR,
+	); err != nil {
+		return nil, err
+	}
+	return c.convertPR(ctx, bbPR)
+}
+
+func (c *cloudProvider) GetPullRequest(
+	ctx context.Context,
+	id int64,
+) (*gitprovider.PullRequest, error) {
+	bbPR, err := c.getPullRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertPR(ctx, bbPR)
+}
+
+func (c *cloudProvider) ListPullRequests(
+	ctx context.Context,
+	opts gitprovider.ListPullRequestOpts,
+) ([]*gitprovider.PullRequest, error) {
+	if len(opts.Labels) > 0 {
+		return nil, errLabelsNotSupported
+	}
+	query := url.Values{"pagelen": []string{"50"}}
+	switch opts.State {
+	case "", gitprovider.PullRequestStateOpen:
+		query.Add("state", cloudStateOpen)
+	case gitprovider.PullRequestStateClosed:
+		query.Add("state", cloudStateMerged)
+		query.Add("state", cloudStateDeclined)
+		query.Add("state", cloudStateSuperseded)
+	}
+	var filters []string
+	if opts.Head != "" {
+		filters = append(filters, fmt.Sprintf("source.branch.name = %s", strconv.Quote(opts.Head)))
+	}
+	if opts.Base != "" {
+		filters = append(filters, fmt.Sprintf("destination.branch.name = %s", strconv.Quote(opts.Base)))
+	}
+	if len(filters) > 0 {
+		query.Set("q", strings.Join(filters, " AND "))
+	}
+	var prs []*gitprovider.PullRequest
+	next := c.client.url(query, c.repoPath("pullrequests")...)
+	for next != "" {
+		page := &cloudPullRequestPage{}
+		if err := c.client.do(ctx, http.MethodGet, next, nil, page); err != nil {
+			return nil, err
+		}
+		for i := range page.Values {
+			pr, err := c.convertPR(ctx, &page.Values[i])
+			if err != nil {
+				return nil, err
+			}
+			prs = append(prs, pr)
+		}
+		next = page.Next
+	}
+	return prs, nil
+}
+
+func (c *cloudProvider) IsPullRequestMerged(ctx context.Context, id int64) (bool, error) {
+	bbPR, err := c.getPullRequest(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return bbPR.State == cloudStateMerged, nil
+}
+
+func (c *cloudProvider) CommentOnPullRequest(
+	ctx context.Context,
+	id int64,
+	body string,
+) error {
+	req := map[string]any{"content": map[string]string{"raw": body}}
+	return c.client.do(
+		ctx,
+		http.MethodPost,
+		c.client.url(
+			nil,
+			c.repoPath("pullrequests", strconv.FormatInt(id, 10), "comments")...,
+		),
+		req,
+		nil,
+	)
+}
+
+// ClosePullRequest declines the pull request, which is how Bitbucket closes a
+// pull request without merging it.
+func (c *cloudProvider) ClosePullRequest(ctx context.Context, id int64) error {
+	return c.client.do(
+		ctx,
+		http.MethodPost,
+		c.client.url(
+			nil,
+			c.repoPath("pullrequests", strconv.FormatInt(id, 10), "decline")...,
+		),
+		nil,
+		nil,
+	)
+}
+
+func (c *cloudProvider) SetCommitStatus(
+	ctx context.Context,
+	sha string,
+	status gitprovider.CommitStatus,
+) error {
+	bs, err := newBuildStatus(status, cloudMaxBuildStatusKeyLength)
+	if err != nil {
+		return err
+	}
+	return c.client.do(
+		ctx,
+		http.MethodPost,
+		c.client.url(nil, c.repoPath("commit", sha, "statuses", "build")...),
+		bs,
+		nil,
+	)
+}
+
+func (c *cloudProvider) getPullRequest(ctx context.Context, id int64) (*cloudPullRequest, error) {
+	bbPR := &cloudPullRequest{}
+	if err := c.client.do(
+		ctx,
+		http.MethodGet,
+		c.client.url(nil, c.repoPath("pullrequests", strconv.FormatInt(id, 10))...),
+		nil,
+		bbPR,
+	); err != nil {
+		return nil, err
+	}
+	return bbPR, nil
+}
+
+// convertPR converts the provided Bitbucket Cloud pull request into a
+// gitprovider.PullRequest. Because Bitbucket Cloud abbreviates the commit
+// hashes it includes in pull requests, these are expanded using the API of
+// the repository the commits belong to.
+func (c *cloudProvider) convertPR(
+	ctx context.Context,
+	bbPR *cloudPullRequest,
+) (*gitprovider.PullRequest, error) {
+	pr := &gitprovider.PullRequest{
+		Number:    bbPR.ID,
+		URL:       bbPR.Links.HTML.Href,
+		State:     gitprovider.PullRequestStateClosed,
+		Object:    bbPR,
+		HeadRef:   bbPR.Source.Branch.Name,
+		Title:     bbPR.Title,
+		UpdatedAt: bbPR.UpdatedOn,
+	}
+	if bbPR.State == cloudStateOpen {
+		pr.State = gitprovider.PullRequestStateOpen
+	}
+	// The source repository is nil if the fork it belonged to has been deleted.
+	headRepo := bbPR.Source.Repository
+	if headRepo == nil ||
+		bbPR.Destination.Repository == nil ||
+		headRepo.FullName != bbPR.Destination.Repository.FullName {
+		pr.FromFork = true
+	}
+	var err error
+	if bbPR.Source.Commit != nil {
+		pr.HeadSHA = bbPR.Source.Commit.Hash
+		if headRepo != nil {
+			if pr.HeadSHA, err = c.expandCommitHash(ctx, headRepo.FullName, pr.HeadSHA); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if bbPR.MergeCommit != nil {
+		if pr.MergeCommitSHA, err = c.expandCommitHash(
+			ctx,
+			c.workspace+"/"+c.repo,
+			bbPR.MergeCommit.Hash,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return pr, nil
+}
+
+// expandCommitHash returns the full hash of the commit identified by the
+// provided, possibly abbreviated, hash in the repository with the provided
+// full name (i.e. "workspace/repo").
+func (c *cloudProvider) expandCommitHash(
+	ctx context.Context,
+	repoFullName string,
+	hash string,
+) (string, error) {
+	if hash == "" || len(hash) >= fullCommitHashLength {
+		return hash, nil
+	}
+	workspace, repo, ok := strings.Cut(repoFullName, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repository name %q", repoFullName)
+	}
+	commit := &cloudCommit{}
+	if err := c.client.do(
+		ctx,
+		http.MethodGet,
+		c.client.url(nil, "repositories", workspace, repo, "commit", hash),
+		nil,
+		commit,
+	); err != nil {
+		return "", fmt.Errorf("error expanding commit hash %q: %w", hash, err)
+	}
+	return commit.Hash, nil
+}
+
+// repoPath returns the segments of the path of the API endpoint for the
+// provider's repository, followed by the provided segments.
+func (c *cloudProvider) repoPath(segments ...string) []string {
+	return append([]string{"repositories", c.workspace, c.repo}, segments...)
+}
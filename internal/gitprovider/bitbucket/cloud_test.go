@@ -0,0 +1,281 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+const (
+	testHeadSHA  = "1111111111111111111111111111111111111111"
+	testMergeSHA = "2222222222222222222222222222222222222222"
+)
+
+func newTestCloudServer(t *testing.T) *httptest.Server {
+	pr := func(id int64, state string, mergeCommit string) map[string]any {
+		pr := map[string]any{
+			"id":    id,
+			"title": "fake-title",
+			"state": state,
+			"source": map[string]any{
+				"branch":     map[string]any{"name": "head"},
+				"commit":     map[string]any{"hash": testHeadSHA[:12]},
+				"repository": map[string]any{"full_name": "workspace/repo"},
+			},
+			"destination": map[string]any{
+				"branch":     map[string]any{"name": "base"},
+				"repository": map[string]any{"full_name": "workspace/repo"},
+			},
+			"updated_on": "2024-01-01T00:00:00.123456+00:00",
+			"links": map[string]any{
+				"html": map[string]any{
+					"href": "https://bitbucket.org/workspace/repo/pull-requests/1",
+				},
+			},
+		}
+		if mergeCommit != "" {
+			pr["merge_commit"] = map[string]any{"hash": mergeCommit}
+		}
+		return pr
+	}
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+		var res any
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/repositories/workspace/repo/pullrequests":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"title":       "fake-title",
+				"description": "fake-description",
+				"source":      map[string]any{"branch": map[string]any{"name": "head"}},
+				"destination": map[string]any{"branch": map[string]any{"name": "base"}},
+			}, req)
+			res = pr(1, cloudStateOpen, "")
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/repositories/workspace/repo/pullrequests/1/comments":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{"content": map[string]any{"raw": "comment"}}, req)
+			res = map[string]any{}
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/repositories/workspace/repo/pullrequests/1/decline":
+			res = pr(1, cloudStateDeclined, "")
+		case r.URL.Path == "/repositories/workspace/repo/pullrequests/1":
+			res = pr(1, cloudStateMerged, testMergeSHA[:12])
+		case r.URL.Path == "/repositories/workspace/repo/pullrequests/2":
+			res = pr(2, cloudStateDeclined, "")
+		case r.URL.Path == "/repositories/workspace/repo/pullrequests":
+			if r.URL.Query().Get("page") == "2" {
+				res = map[string]any{"values": []any{pr(3, cloudStateOpen, "")}}
+				break
+			}
+			require.Equal(t, []string{cloudStateOpen}, r.URL.Query()["state"])
+			require.Equal(
+				t,
+				`source.branch.name = "head" AND destination.branch.name = "base"`,
+				r.URL.Query().Get("q"),
+			)
+			res = map[string]any{
+				"values": []any{pr(2, cloudStateOpen, "")},
+				"next":   srv.URL + "/repositories/workspace/repo/pullrequests?page=2",
+			}
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/repositories/workspace/repo/commit/"+testHeadSHA+"/statuses/build":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"key":         "kargo/test",
+				"state":       "SUCCESSFUL",
+				"name":        "kargo/test",
+				"description": "fake-description",
+				"url":         "https://kargo.example.com",
+			}, req)
+			res = map[string]any{}
+		case r.URL.Path == "/repositories/workspace/repo/commit/"+testHeadSHA[:12]:
+			res = map[string]any{"hash": testHeadSHA}
+		case r.URL.Path == "/repositories/workspace/repo/commit/"+testMergeSHA[:12]:
+			res = map[string]any{"hash": testMergeSHA}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	return srv
+}
+
+func newTestCloudProvider(srv *httptest.Server) *cloudProvider {
+	return newCloudProvider(
+		&client{
+			baseURL:    srv.URL,
+			token:      "fake-token",
+			httpClient: srv.Client(),
+		},
+		"workspace",
+		"repo",
+	)
+}
+
+func TestCloudProvider_CreatePullRequest(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+
+	pr, err := newTestCloudProvider(srv).CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:        "head",
+			Base:        "base",
+			Title:       "fake-title",
+			Description: "fake-description",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pr.Number)
+	require.Equal(t, "https://bitbucket.org/workspace/repo/pull-requests/1", pr.URL)
+	require.Equal(t, gitprovider.PullRequestStateOpen, pr.State)
+	require.Equal(t, testHeadSHA, pr.HeadSHA)
+	require.Equal(t, "head", pr.HeadRef)
+	require.Empty(t, pr.MergeCommitSHA)
+	require.False(t, pr.FromFork)
+	require.Equal(t, "fake-title", pr.Title)
+	require.Equal(
+		t,
+		time.Date(2024, 1, 1, 0, 0, 0, 123456000, time.UTC),
+		pr.UpdatedAt.UTC(),
+	)
+
+	_, err = newTestCloudProvider(srv).CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:   "head",
+			Base:   "base",
+			Labels: []string{"preview"},
+		},
+	)
+	require.ErrorContains(t, err, "labels are not supported")
+}
+
+func TestCloudProvider_GetPullRequest(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+	p := newTestCloudProvider(srv)
+
+	pr, err := p.GetPullRequest(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	require.Equal(t, testMergeSHA, pr.MergeCommitSHA)
+
+	_, err = p.GetPullRequest(context.Background(), 42)
+	require.ErrorContains(t, err, "unexpected HTTP status 404")
+}
+
+func TestCloudProvider_ListPullRequests(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+	p := newTestCloudProvider(srv)
+
+	prs, err := p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			Head: "head",
+			Base: "base",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	require.Equal(t, int64(2), prs[0].Number)
+	require.Equal(t, int64(3), prs[1].Number)
+
+	prs, err = p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{Labels: []string{"preview"}},
+	)
+	require.ErrorContains(t, err, "labels are not supported")
+	require.Nil(t, prs)
+}
+
+func TestCloudProvider_IsPullRequestMerged(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+	p := newTestCloudProvider(srv)
+
+	merged, err := p.IsPullRequestMerged(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, merged)
+
+	merged, err = p.IsPullRequestMerged(context.Background(), 2)
+	require.NoError(t, err)
+	require.False(t, merged)
+}
+
+func TestCloudProvider_convertPR(t *testing.T) {
+	p := &cloudProvider{workspace: "workspace", repo: "repo"}
+
+	bbPR := &cloudPullRequest{ID: 1, State: cloudStateSuperseded}
+	bbPR.Source.Commit = &cloudCommit{Hash: testHeadSHA[:12]}
+	bbPR.Destination.Repository = &cloudRepository{FullName: "workspace/repo"}
+
+	// The source repository of a pull request from a deleted fork is unknown,
+	// so its abbreviated head commit hash cannot be expanded.
+	pr, err := p.convertPR(context.Background(), bbPR)
+	require.NoError(t, err)
+	require.True(t, pr.FromFork)
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	require.True(t, strings.HasPrefix(testHeadSHA, pr.HeadSHA))
+}
+
+func TestCloudProvider_CommentOnPullRequest(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+	p := newTestCloudProvider(srv)
+
+	require.NoError(t, p.CommentOnPullRequest(context.Background(), 1, "comment"))
+	require.ErrorContains(
+		t,
+		p.CommentOnPullRequest(context.Background(), 42, "comment"),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestCloudProvider_ClosePullRequest(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+	p := newTestCloudProvider(srv)
+
+	require.NoError(t, p.ClosePullRequest(context.Background(), 1))
+	require.ErrorContains(
+		t,
+		p.ClosePullRequest(context.Background(), 42),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestCloudProvider_SetCommitStatus(t *testing.T) {
+	srv := newTestCloudServer(t)
+	defer srv.Close()
+	p := newTestCloudProvider(srv)
+
+	status := gitprovider.CommitStatus{
+		State:       gitprovider.CommitStatusStateSuccess,
+		Context:     "kargo/test",
+		Description: "fake-description",
+		TargetURL:   "https://kargo.example.com",
+	}
+	require.NoError(t, p.SetCommitStatus(context.Background(), testHeadSHA, status))
+	require.ErrorContains(
+		t,
+		p.SetCommitStatus(context.Background(), testMergeSHA, status),
+		"unexpected HTTP status 404",
+	)
+}
@@ -0,0 +1,315 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+const (
+	dataCenterStateOpen   = "OPEN"
+	dataCenterStateMerged = "MERGED"
+	dataCenterStateAll    = "ALL"
+
+	branchRefPrefix = "refs/heads/"
+
+	// dataCenterMaxBuildStatusKeyLength is the maximum length of the key of a
+	// build status accepted by Bitbucket Data Center.
+	dataCenterMaxBuildStatusKeyLength = 255
+)
+
+// dataCenterProvider is a gitprovider.GitProviderService backed by the
+// Bitbucket Data Center REST API.
+type dataCenterProvider struct {
+	client  *client
+	project string
+	repo    string
+}
+
+func newDataCenterProvider(c *client, project, repo string) *dataCenterProvider {
+	return &dataCenterProvider{
+		client:  c,
+		project: project,
+		repo:    repo,
+	}
+}
+
+type dataCenterPullRequest struct {
+	ID      int64         `json:"id"`
+	Version int64         `json:"version"`
+	Title   string        `json:"title"`
+	State   string        `json:"state"`
+	FromRef dataCenterRef `json:"fromRef"`
+	ToRef   dataCenterRef `json:"toRef"`
+	// UpdatedDate is the time the pull request was last updated, in
+	// milliseconds since the Unix epoch.
+	UpdatedDate int64 `json:"updatedDate,omitempty"`
+	Links       struct {
+		Self []link `json:"self"`
+	} `json:"links"`
+	Properties struct {
+		MergeCommit *struct {
+			ID string `json:"id"`
+		} `json:"mergeCommit,omitempty"`
+	} `json:"properties"`
+}
+
+type dataCenterCreatePullRequest struct {
+	Title       string               `json:"title"`
+	Description string               `json:"description,omitempty"`
+	FromRef     dataCenterRef        `json:"fromRef"`
+	ToRef       dataCenterRef        `json:"toRef"`
+	Reviewers   []dataCenterReviewer `json:"reviewers,omitempty"`
+}
+
+type dataCenterReviewer struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+type dataCenterRef struct {
+	ID           string               `json:"id"`
+	DisplayID    string               `json:"displayId,omitempty"`
+	LatestCommit string               `json:"latestCommit,omitempty"`
+	Repository   dataCenterRepository `json:"repository"`
+}
+
+type dataCenterRepository struct {
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+}
+
+type dataCenterPullRequestPage struct {
+	Values        []dataCenterPullRequest `json:"values"`
+	IsLastPage    bool                    `json:"isLastPage"`
+	NextPageStart int                     `json:"nextPageStart"`
+}
+
+func (d *dataCenterProvider) CreatePullRequest(
+	ctx context.Context,
+	opts gitprovider.CreatePullRequestOpts,
+) (*gitprovider.PullRequest, error) {
+	if len(opts.Labels) > 0 {
+		return nil, errLabelsNotSupported
+	}
+	repo := dataCenterRepository{Slug: d.repo}
+	repo.Project.Key = d.project
+	req := dataCenterCreatePullRequest{
+		Title:       opts.Title,
+		Description: opts.Description,
+		FromRef: dataCenterRef{
+			ID:         branchRefPrefix + opts.Head,
+			Repository: repo,
+		},
+		ToRef: dataCenterRef{
+			ID:         branchRefPrefix + opts.Base,
+			Repository: repo,
+		},
+	}
+	for _, username := range opts.Reviewers {
+		reviewer := dataCenterReviewer{}
+		reviewer.User.Name = username
+		req.Reviewers = append(req.Reviewers, reviewer)
+	}
+	bbPR := &dataCenterPullRequest{}
+	if err := d.client.do(
+		ctx,
+		http.MethodPost,
+		d.client.url(nil, d.repoPath("pull-requests")...),
+		req,
+		bbPR,
+	); err != nil {
+		return nil, err
+	}
+	return convertDataCenterPR(bbPR), nil
+}
+
+func (d *dataCenterProvider) GetPullRequest(
+	ctx context.Context,
+	id int64,
+) (*gitprovider.PullRequest, error) {
+	bbPR, err := d.getPullRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertDataCenterPR(bbPR), nil
+}
+
+func (d *dataCenterProvider) ListPullRequests(
+	ctx context.Context,
+	opts gitprovider.ListPullRequestOpts,
+) ([]*gitprovider.PullRequest, error) {
+	if len(opts.Labels) > 0 {
+		return nil, errLabelsNotSupported
+	}
+	query := url.Values{
+		"limit":     []string{"100"},
+		"direction": []string{"INCOMING"},
+	}
+	// The API cannot list pull requests in any state other than a specific one
+	// or all of them, so closed pull requests are filtered below.
+	if opts.State == gitprovider.PullRequestStateClosed {
+		query.Set("state", dataCenterStateAll)
+	} else {
+		query.Set("state", dataCenterStateOpen)
+	}
+	if opts.Base != "" {
+		query.Set("at", branchRefPrefix+opts.Base)
+	}
+	var prs []*gitprovider.PullRequest
+	for {
+		page := &dataCenterPullRequestPage{}
+		if err := d.client.do(
+			ctx,
+			http.MethodGet,
+			d.client.url(query, d.repoPath("pull-requests")...),
+			nil,
+			page,
+		); err != nil {
+			return nil, err
+		}
+		for i := range page.Values {
+			pr := convertDataCenterPR(&page.Values[i])
+			if opts.State == gitprovider.PullRequestStateClosed && pr.IsOpen() {
+				continue
+			}
+			// The API does not support filtering pull requests by source branch,
+			// so we do it here.
+			if opts.Head != "" && pr.HeadRef != opts.Head {
+				continue
+			}
+			prs = append(prs, pr)
+		}
+		if page.IsLastPage {
+			break
+		}
+		query.Set("start", strconv.Itoa(page.NextPageStart))
+	}
+	return prs, nil
+}
+
+func (d *dataCenterProvider) IsPullRequestMerged(ctx context.Context, id int64) (bool, error) {
+	bbPR, err := d.getPullRequest(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return bbPR.State == dataCenterStateMerged, nil
+}
+
+func (d *dataCenterProvider) CommentOnPullRequest(
+	ctx context.Context,
+	id int64,
+	body string,
+) error {
+	return d.client.do(
+		ctx,
+		http.MethodPost,
+		d.client.url(
+			nil,
+			d.repoPath("pull-requests", strconv.FormatInt(id, 10), "comments")...,
+		),
+		map[string]string{"text": body},
+		nil,
+	)
+}
+
+// ClosePullRequest declines the pull request, which is how Bitbucket closes a
+// pull request without merging it.
+func (d *dataCenterProvider) ClosePullRequest(ctx context.Context, id int64) error {
+	// Declining a pull request requires its current version, which guards
+	// against declining a pull request that has changed in the meantime.
+	bbPR, err := d.getPullRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	return d.client.do(
+		ctx,
+		http.MethodPost,
+		d.client.url(
+			url.Values{"version": []string{strconv.FormatInt(bbPR.Version, 10)}},
+			d.repoPath("pull-requests", strconv.FormatInt(id, 10), "decline")...,
+		),
+		nil,
+		nil,
+	)
+}
+
+func (d *dataCenterProvider) SetCommitStatus(
+	ctx context.Context,
+	sha string,
+	status gitprovider.CommitStatus,
+) error {
+	bs, err := newBuildStatus(status, dataCenterMaxBuildStatusKeyLength)
+	if err != nil {
+		return err
+	}
+	return d.client.do(
+		ctx,
+		http.MethodPost,
+		d.client.url(nil, d.repoPath("commits", sha, "builds")...),
+		bs,
+		nil,
+	)
+}
+
+func (d *dataCenterProvider) getPullRequest(
+	ctx context.Context,
+	id int64,
+) (*dataCenterPullRequest, error) {
+	bbPR := &dataCenterPullRequest{}
+	if err := d.client.do(
+		ctx,
+		http.MethodGet,
+		d.client.url(nil, d.repoPath("pull-requests", strconv.FormatInt(id, 10))...),
+		nil,
+		bbPR,
+	); err != nil {
+		return nil, err
+	}
+	return bbPR, nil
+}
+
+// repoPath returns the segments of the path of the API endpoint for the
+// provider's repository, followed by the provided segments.
+func (d *dataCenterProvider) repoPath(segments ...string) []string {
+	return append([]string{"projects", d.project, "repos", d.repo}, segments...)
+}
+
+func convertDataCenterPR(bbPR *dataCenterPullRequest) *gitprovider.PullRequest {
+	pr := &gitprovider.PullRequest{
+		Number:  bbPR.ID,
+		State:   gitprovider.PullRequestStateClosed,
+		Object:  bbPR,
+		HeadSHA: bbPR.FromRef.LatestCommit,
+		HeadRef: bbPR.FromRef.DisplayID,
+		Title:   bbPR.Title,
+		FromFork: bbPR.FromRef.Repository.Slug != bbPR.ToRef.Repository.Slug ||
+			bbPR.FromRef.Repository.Project.Key != bbPR.ToRef.Repository.Project.Key,
+	}
+	if bbPR.State == dataCenterStateOpen {
+		pr.State = gitprovider.PullRequestStateOpen
+	}
+	if pr.HeadRef == "" {
+		pr.HeadRef = strings.TrimPrefix(bbPR.FromRef.ID, branchRefPrefix)
+	}
+	if len(bbPR.Links.Self) > 0 {
+		pr.URL = bbPR.Links.Self[0].Href
+	}
+	if bbPR.Properties.MergeCommit != nil {
+		pr.MergeCommitSHA = bbPR.Properties.MergeCommit.ID
+	}
+	if bbPR.UpdatedDate > 0 {
+		pr.UpdatedAt = ptr.To(time.UnixMilli(bbPR.UpdatedDate).UTC())
+	}
+	return pr
+}
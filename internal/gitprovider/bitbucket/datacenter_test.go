@@ -0,0 +1,313 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func newTestDataCenterServer(t *testing.T) *httptest.Server {
+	ref := func(branch, project, slug string) map[string]any {
+		return map[string]any{
+			"id":           "refs/heads/" + branch,
+			"displayId":    branch,
+			"latestCommit": testHeadSHA,
+			"repository": map[string]any{
+				"slug":    slug,
+				"project": map[string]any{"key": project},
+			},
+		}
+	}
+	pr := func(id int64, state string, head string) map[string]any {
+		pr := map[string]any{
+			"id":          id,
+			"version":     3,
+			"title":       "fake-title",
+			"state":       state,
+			"fromRef":     ref(head, "PROJ", "repo"),
+			"toRef":       ref("base", "PROJ", "repo"),
+			"updatedDate": int64(1704067200000),
+			"links": map[string]any{
+				"self": []any{map[string]any{
+					"href": "https://bitbucket.example.com/projects/PROJ/repos/repo/pull-requests/1",
+				}},
+			},
+		}
+		if state == dataCenterStateMerged {
+			pr["properties"] = map[string]any{
+				"mergeCommit": map[string]any{"id": testMergeSHA},
+			}
+		}
+		return pr
+	}
+
+	const prsPath = "/rest/api/1.0/projects/PROJ/repos/repo/pull-requests"
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+		var res any
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == prsPath:
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			repo := map[string]any{
+				"slug":    "repo",
+				"project": map[string]any{"key": "PROJ"},
+			}
+			require.Equal(t, map[string]any{
+				"title":       "fake-title",
+				"description": "fake-description",
+				"fromRef":     map[string]any{"id": "refs/heads/head", "repository": repo},
+				"toRef":       map[string]any{"id": "refs/heads/base", "repository": repo},
+				"reviewers": []any{
+					map[string]any{"user": map[string]any{"name": "reviewer"}},
+				},
+			}, req)
+			res = pr(1, dataCenterStateOpen, "head")
+		case r.Method == http.MethodPost && r.URL.Path == prsPath+"/2/comments":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{"text": "comment"}, req)
+			res = map[string]any{}
+		case r.Method == http.MethodPost && r.URL.Path == prsPath+"/2/decline":
+			// The version of the pull request must match its current version
+			require.Equal(t, "3", r.URL.Query().Get("version"))
+			res = pr(2, "DECLINED", "head")
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/rest/api/1.0/projects/PROJ/repos/repo/commits/"+testHeadSHA+"/builds":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"key":   "kargo/test",
+				"state": "FAILED",
+				"name":  "kargo/test",
+				"url":   "https://kargo.example.com",
+			}, req)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case r.URL.Path == prsPath+"/1":
+			res = pr(1, dataCenterStateMerged, "head")
+		case r.URL.Path == prsPath+"/2":
+			res = pr(2, "DECLINED", "head")
+		case r.URL.Path == prsPath:
+			query := r.URL.Query()
+			require.Equal(t, "INCOMING", query.Get("direction"))
+			require.Equal(t, "refs/heads/base", query.Get("at"))
+			if query.Get("state") == dataCenterStateAll {
+				res = map[string]any{
+					"values": []any{
+						pr(1, dataCenterStateMerged, "head"),
+						pr(2, dataCenterStateOpen, "head"),
+					},
+					"isLastPage": true,
+				}
+				break
+			}
+			require.Equal(t, dataCenterStateOpen, query.Get("state"))
+			if query.Get("start") == "25" {
+				res = map[string]any{
+					"values":     []any{pr(4, dataCenterStateOpen, "head")},
+					"isLastPage": true,
+				}
+				break
+			}
+			res = map[string]any{
+				"values": []any{
+					pr(2, dataCenterStateOpen, "head"),
+					pr(3, dataCenterStateOpen, "other-head"),
+				},
+				"isLastPage":    false,
+				"nextPageStart": 25,
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+func newTestDataCenterProvider(t *testing.T, srv *httptest.Server) gitprovider.GitProviderService {
+	p, err := NewBitbucketProvider(
+		srv.URL+"/scm/PROJ/repo.git",
+		&gitprovider.GitProviderOptions{Token: "fake-token"},
+	)
+	require.NoError(t, err)
+	require.IsType(t, &dataCenterProvider{}, p)
+	return p
+}
+
+func TestDataCenterProvider_CreatePullRequest(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+
+	pr, err := newTestDataCenterProvider(t, srv).CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:        "head",
+			Base:        "base",
+			Title:       "fake-title",
+			Description: "fake-description",
+			Reviewers:   []string{"reviewer"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pr.Number)
+	require.Equal(
+		t,
+		"https://bitbucket.example.com/projects/PROJ/repos/repo/pull-requests/1",
+		pr.URL,
+	)
+	require.Equal(t, gitprovider.PullRequestStateOpen, pr.State)
+	require.Equal(t, testHeadSHA, pr.HeadSHA)
+	require.Equal(t, "head", pr.HeadRef)
+	require.Empty(t, pr.MergeCommitSHA)
+	require.False(t, pr.FromFork)
+	require.Equal(t, "fake-title", pr.Title)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *pr.UpdatedAt)
+
+	_, err = newTestDataCenterProvider(t, srv).CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:   "head",
+			Base:   "base",
+			Labels: []string{"preview"},
+		},
+	)
+	require.ErrorContains(t, err, "labels are not supported")
+}
+
+func TestDataCenterProvider_GetPullRequest(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+	p := newTestDataCenterProvider(t, srv)
+
+	pr, err := p.GetPullRequest(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	require.Equal(t, testMergeSHA, pr.MergeCommitSHA)
+
+	_, err = p.GetPullRequest(context.Background(), 42)
+	require.ErrorContains(t, err, "unexpected HTTP status 404")
+}
+
+func TestDataCenterProvider_ListPullRequests(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+	p := newTestDataCenterProvider(t, srv)
+
+	prs, err := p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			Head: "head",
+			Base: "base",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	require.Equal(t, int64(2), prs[0].Number)
+	require.Equal(t, int64(4), prs[1].Number)
+
+	prs, err = p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			State: gitprovider.PullRequestStateClosed,
+			Base:  "base",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	require.Equal(t, int64(1), prs[0].Number)
+
+	prs, err = p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{Labels: []string{"preview"}},
+	)
+	require.ErrorContains(t, err, "labels are not supported")
+	require.Nil(t, prs)
+}
+
+func TestDataCenterProvider_IsPullRequestMerged(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+	p := newTestDataCenterProvider(t, srv)
+
+	merged, err := p.IsPullRequestMerged(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, merged)
+
+	merged, err = p.IsPullRequestMerged(context.Background(), 2)
+	require.NoError(t, err)
+	require.False(t, merged)
+}
+
+func TestConvertDataCenterPR(t *testing.T) {
+	bbPR := &dataCenterPullRequest{
+		ID:    1,
+		State: dataCenterStateOpen,
+		FromRef: dataCenterRef{
+			ID:         "refs/heads/head",
+			Repository: dataCenterRepository{Slug: "fork"},
+		},
+		ToRef: dataCenterRef{
+			ID:         "refs/heads/base",
+			Repository: dataCenterRepository{Slug: "repo"},
+		},
+	}
+	pr := convertDataCenterPR(bbPR)
+	require.Equal(t, gitprovider.PullRequestStateOpen, pr.State)
+	require.Equal(t, "head", pr.HeadRef)
+	require.True(t, pr.FromFork)
+	require.Nil(t, pr.UpdatedAt)
+	require.Empty(t, pr.URL)
+}
+
+func TestDataCenterProvider_CommentOnPullRequest(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+	p := newTestDataCenterProvider(t, srv)
+
+	require.NoError(t, p.CommentOnPullRequest(context.Background(), 2, "comment"))
+	require.ErrorContains(
+		t,
+		p.CommentOnPullRequest(context.Background(), 42, "comment"),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestDataCenterProvider_ClosePullRequest(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+	p := newTestDataCenterProvider(t, srv)
+
+	require.NoError(t, p.ClosePullRequest(context.Background(), 2))
+	require.ErrorContains(
+		t,
+		p.ClosePullRequest(context.Background(), 42),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestDataCenterProvider_SetCommitStatus(t *testing.T) {
+	srv := newTestDataCenterServer(t)
+	defer srv.Close()
+	p := newTestDataCenterProvider(t, srv)
+
+	status := gitprovider.CommitStatus{
+		State:     gitprovider.CommitStatusStateError,
+		Context:   "kargo/test",
+		TargetURL: "https://kargo.example.com",
+	}
+	require.NoError(t, p.SetCommitStatus(context.Background(), testHeadSHA, status))
+	require.ErrorContains(
+		t,
+		p.SetCommitStatus(context.Background(), testMergeSHA, status),
+		"unexpected HTTP status 404",
+	)
+}
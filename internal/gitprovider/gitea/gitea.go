@@ -0,0 +1,395 @@
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+const (
+	GitProviderServiceName = "gitea"
+
+	// pageSize is the number of pull requests requested per page when listing
+	// pull requests. Gitea caps this at a configurable maximum, which defaults
+	// to 50.
+	pageSize = 50
+
+	// maxErrorBodySize is the maximum number of bytes of an error response's
+	// body that are included in the error returned to the caller.
+	maxErrorBodySize = 1024
+)
+
+var (
+	registration = gitprovider.ProviderRegistration{
+		Predicate: func(repoURL string) bool {
+			u, err := url.Parse(git.NormalizeURL(repoURL))
+			if err != nil {
+				return false
+			}
+			// We assume that any hostname with the word "gitea" or "forgejo" in
+			// the hostname, as well as Codeberg, can use this provider. Because
+			// Gitea and Forgejo are almost always self-hosted, most instances will
+			// not be recognized this way and the provider must be selected
+			// explicitly by name.
+			return strings.Contains(u.Host, GitProviderServiceName) ||
+				strings.Contains(u.Host, "forgejo") ||
+				u.Host == "codeberg.org"
+		},
+		NewService: func(
+			repoURL string,
+			opts *gitprovider.GitProviderOptions,
+		) (gitprovider.GitProviderService, error) {
+			return NewGiteaProvider(repoURL, opts)
+		},
+	}
+)
+
+func init() {
+	gitprovider.RegisterProvider(GitProviderServiceName, registration)
+}
+
+// giteaProvider is a gitprovider.GitProviderService backed by the REST API of
+// Gitea or of its fork, Forgejo, whose APIs are compatible.
+type giteaProvider struct {
+	baseURL    string
+	owner      string
+	repo       string
+	token      string
+	httpClient *http.Client
+}
+
+// NewGiteaProvider returns a gitprovider.GitProviderService for the Gitea or
+// Forgejo repository with the provided URL. The API is assumed to be served
+// over HTTPS from the same host as the repository, unless the repository URL
+// is itself an HTTP URL.
+func NewGiteaProvider(
+	repoURL string,
+	opts *gitprovider.GitProviderOptions,
+) (gitprovider.GitProviderService, error) {
+	if opts == nil {
+		opts = &gitprovider.GitProviderOptions{}
+	}
+	baseURL, owner, repo, err := parseGiteaURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	return &giteaProvider{
+		baseURL: baseURL,
+		owner:   owner,
+		repo:    repo,
+		token:   opts.Token,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: opts.InsecureSkipTLSVerify, // nolint: gosec
+				},
+			},
+		},
+	}, nil
+}
+
+type giteaPullRequest struct {
+	Number         int64        `json:"number"`
+	HTMLURL        string       `json:"html_url"`
+	State          string       `json:"state"`
+	Title          string       `json:"title"`
+	Merged         bool         `json:"merged"`
+	MergeCommitSHA *string      `json:"merge_commit_sha"`
+	Head           giteaBranch  `json:"head"`
+	Base           giteaBranch  `json:"base"`
+	Labels         []giteaLabel `json:"labels"`
+	UpdatedAt      *time.Time   `json:"updated_at"`
+}
+
+type giteaBranch struct {
+	Ref  string           `json:"ref"`
+	SHA  string           `json:"sha"`
+	Repo *giteaRepository `json:"repo"`
+}
+
+type giteaRepository struct {
+	FullName string `json:"full_name"`
+}
+
+type giteaLabel struct {
+	Name string `json:"name"`
+}
+
+func (g *giteaProvider) CreatePullRequest(
+	ctx context.Context,
+	opts gitprovider.CreatePullRequestOpts,
+) (*gitprovider.PullRequest, error) {
+	req := map[string]any{
+		"head":  opts.Head,
+		"base":  opts.Base,
+		"title": opts.Title,
+		"body":  opts.Description,
+	}
+	// Labels can only be specified by ID, so only assignees are supported.
+	if len(opts.Assignees) > 0 {
+		req["assignees"] = opts.Assignees
+	}
+	gtPR := &giteaPullRequest{}
+	if err := g.do(ctx, http.MethodPost, g.url(nil, "pulls"), req, gtPR); err != nil {
+		return nil, err
+	}
+	return convertGiteaPR(gtPR), nil
+}
+
+func (g *giteaProvider) GetPullRequest(
+	ctx context.Context,
+	id int64,
+) (*gitprovider.PullRequest, error) {
+	gtPR, err := g.getPullRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertGiteaPR(gtPR), nil
+}
+
+func (g *giteaProvider) ListPullRequests(
+	ctx context.Context,
+	opts gitprovider.ListPullRequestOpts,
+) ([]*gitprovider.PullRequest, error) {
+	query := url.Values{"limit": []string{strconv.Itoa(pageSize)}}
+	switch opts.State {
+	case "", gitprovider.PullRequestStateOpen:
+		query.Set("state", "open")
+	case gitprovider.PullRequestStateClosed:
+		query.Set("state", "closed")
+	}
+	var prs []*gitprovider.PullRequest
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		var gtPRs []giteaPullRequest
+		if err := g.do(ctx, http.MethodGet, g.url(query, "pulls"), nil, &gtPRs); err != nil {
+			return nil, err
+		}
+		for i := range gtPRs {
+			pr := convertGiteaPR(&gtPRs[i])
+			// The Gitea API only supports filtering pull requests by label ID,
+			// and not at all by head or base branch, so we do it here.
+			if (opts.Head != "" && pr.HeadRef != opts.Head) ||
+				(opts.Base != "" && gtPRs[i].Base.Ref != opts.Base) ||
+				!hasLabels(pr, opts.Labels) {
+				continue
+			}
+			prs = append(prs, pr)
+		}
+		if len(gtPRs) < pageSize {
+			break
+		}
+	}
+	return prs, nil
+}
+
+func (g *giteaProvider) IsPullRequestMerged(ctx context.Context, id int64) (bool, error) {
+	gtPR, err := g.getPullRequest(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return gtPR.Merged, nil
+}
+
+func (g *giteaProvider) CommentOnPullRequest(
+	ctx context.Context,
+	id int64,
+	body string,
+) error {
+	// Pull requests are issues, whose comments are managed by the issues API.
+	return g.do(
+		ctx,
+		http.MethodPost,
+		g.url(nil, "issues", strconv.FormatInt(id, 10), "comments"),
+		map[string]string{"body": body},
+		nil,
+	)
+}
+
+func (g *giteaProvider) ClosePullRequest(ctx context.Context, id int64) error {
+	return g.do(
+		ctx,
+		http.MethodPatch,
+		g.url(nil, "pulls", strconv.FormatInt(id, 10)),
+		map[string]string{"state": "closed"},
+		nil,
+	)
+}
+
+func (g *giteaProvider) SetCommitStatus(
+	ctx context.Context,
+	sha string,
+	status gitprovider.CommitStatus,
+) error {
+	return g.do(
+		ctx,
+		http.MethodPost,
+		g.url(nil, "statuses", sha),
+		map[string]string{
+			"state":       strings.ToLower(string(status.State)),
+			"context":     status.Context,
+			"description": status.Description,
+			"target_url":  status.TargetURL,
+		},
+		nil,
+	)
+}
+
+func (g *giteaProvider) getPullRequest(ctx context.Context, id int64) (*giteaPullRequest, error) {
+	gtPR := &giteaPullRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodGet,
+		g.url(nil, "pulls", strconv.FormatInt(id, 10)),
+		nil,
+		gtPR,
+	); err != nil {
+		return nil, err
+	}
+	return gtPR, nil
+}
+
+// url returns the absolute URL of the API endpoint for the provider's
+// repository at the provided path, with the provided query parameters.
+func (g *giteaProvider) url(query url.Values, pathSegments ...string) string {
+	segments := append([]string{"repos", g.owner, g.repo}, pathSegments...)
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	u := g.baseURL + "/" + strings.Join(segments, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// do sends a request with the provided method to the provided absolute URL.
+// If reqBody is non-nil, it is marshaled to JSON and sent as the body of the
+// request. If resBody is non-nil, the body of a successful response is
+// unmarshaled into it. A response with a non-2xx status is returned as an
+// error.
+func (g *giteaProvider) do(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	reqBody any,
+	resBody any,
+) error {
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if g.token != "" {
+		req.Header.Set("Authorization", "token "+g.token)
+	}
+	res, err := g.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending %s request to %q: %w", method, reqURL, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+		return fmt.Errorf(
+			"unexpected HTTP status %d for %s request to %q: %s",
+			res.StatusCode,
+			method,
+			reqURL,
+			strings.TrimSpace(string(msg)),
+		)
+	}
+	if resBody == nil {
+		return nil
+	}
+	if err = json.NewDecoder(res.Body).Decode(resBody); err != nil {
+		return fmt.Errorf("error decoding response from %q: %w", reqURL, err)
+	}
+	return nil
+}
+
+// hasLabels returns true if the given pull request has all the given labels.
+func hasLabels(pr *gitprovider.PullRequest, labels []string) bool {
+	for _, label := range labels {
+		if !slices.Contains(pr.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+func convertGiteaPR(gtPR *giteaPullRequest) *gitprovider.PullRequest {
+	pr := &gitprovider.PullRequest{
+		Number:    gtPR.Number,
+		URL:       gtPR.HTMLURL,
+		State:     gitprovider.PullRequestStateClosed,
+		Object:    gtPR,
+		HeadSHA:   gtPR.Head.SHA,
+		HeadRef:   gtPR.Head.Ref,
+		Title:     gtPR.Title,
+		UpdatedAt: gtPR.UpdatedAt,
+	}
+	if gtPR.State == "open" {
+		pr.State = gitprovider.PullRequestStateOpen
+	}
+	if gtPR.MergeCommitSHA != nil {
+		pr.MergeCommitSHA = *gtPR.MergeCommitSHA
+	}
+	// The head repository is nil if the fork it belonged to has been deleted.
+	if gtPR.Head.Repo == nil || gtPR.Base.Repo == nil ||
+		gtPR.Head.Repo.FullName != gtPR.Base.Repo.FullName {
+		pr.FromFork = true
+	}
+	for _, label := range gtPR.Labels {
+		pr.Labels = append(pr.Labels, label.Name)
+	}
+	return pr
+}
+
+// parseGiteaURL returns the base URL of the API of the Gitea instance hosting
+// the repository with the provided URL, along with the repository's owner and
+// name. Gitea may be served from a subpath, in which case all but the last two
+// segments of the repository URL's path are treated as part of the base URL.
+func parseGiteaURL(repoURL string) (string, string, string, error) {
+	u, err := url.Parse(git.NormalizeURL(repoURL))
+	if err != nil {
+		return "", "", "", fmt.Errorf("error parsing gitea repository URL %q: %w", repoURL, err)
+	}
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return "", "", "", fmt.Errorf("could not extract repository owner and name from URL %q", repoURL)
+	}
+	owner, repo := parts[len(parts)-2], parts[len(parts)-1]
+	base := url.URL{Scheme: "https", Host: u.Host}
+	switch u.Scheme {
+	case "http":
+		base.Scheme = "http"
+	case "ssh":
+		// The API is not served on the port that SSH is served on
+		base.Host = u.Hostname()
+	}
+	base.Path = "/" + strings.Join(append(slices.Clone(parts[:len(parts)-2]), "api", "v1"), "/")
+	return base.String(), owner, repo, nil
+}
@@ -0,0 +1,323 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+func TestParseGiteaURL(t *testing.T) {
+	testCases := []struct {
+		repoURL         string
+		expectedBaseURL string
+		expectedOwner   string
+		expectedRepo    string
+		expectedErr     string
+	}{
+		{
+			repoURL:         "https://gitea.example.com/owner/repo.git",
+			expectedBaseURL: "https://gitea.example.com/api/v1",
+			expectedOwner:   "owner",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "http://example.com:3000/gitea/owner/repo",
+			expectedBaseURL: "http://example.com:3000/gitea/api/v1",
+			expectedOwner:   "owner",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "ssh://git@gitea.example.com:2222/owner/repo.git",
+			expectedBaseURL: "https://gitea.example.com/api/v1",
+			expectedOwner:   "owner",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:         "git@codeberg.org:owner/repo.git",
+			expectedBaseURL: "https://codeberg.org/api/v1",
+			expectedOwner:   "owner",
+			expectedRepo:    "repo",
+		},
+		{
+			repoURL:     "https://gitea.example.com/owner",
+			expectedErr: "could not extract repository owner and name",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			baseURL, owner, repo, err := parseGiteaURL(testCase.repoURL)
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedBaseURL, baseURL)
+			require.Equal(t, testCase.expectedOwner, owner)
+			require.Equal(t, testCase.expectedRepo, repo)
+		})
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	require.True(t, registration.Predicate("https://gitea.example.com/owner/repo.git"))
+	require.True(t, registration.Predicate("https://forgejo.example.com/owner/repo.git"))
+	require.True(t, registration.Predicate("https://codeberg.org/owner/repo.git"))
+	require.False(t, registration.Predicate("https://git.example.com/owner/repo.git"))
+}
+
+const (
+	testHeadSHA  = "1111111111111111111111111111111111111111"
+	testMergeSHA = "2222222222222222222222222222222222222222"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	pr := func(number int64, state string, head string, labels ...string) map[string]any {
+		pr := map[string]any{
+			"number":   number,
+			"html_url": "https://gitea.example.com/owner/repo/pulls/" + strconv.FormatInt(number, 10),
+			"state":    state,
+			"title":    "fake-title",
+			"head": map[string]any{
+				"ref":  head,
+				"sha":  testHeadSHA,
+				"repo": map[string]any{"full_name": "owner/repo"},
+			},
+			"base": map[string]any{
+				"ref":  "base",
+				"repo": map[string]any{"full_name": "owner/repo"},
+			},
+			"updated_at": "2024-01-01T00:00:00Z",
+		}
+		if state == "closed" {
+			pr["merged"] = true
+			pr["merge_commit_sha"] = testMergeSHA
+		}
+		var prLabels []any
+		for _, label := range labels {
+			prLabels = append(prLabels, map[string]any{"name": label})
+		}
+		pr["labels"] = prLabels
+		return pr
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token fake-token", r.Header.Get("Authorization"))
+		var res any
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/owner/repo/pulls":
+			req := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]any{
+				"head":      "head",
+				"base":      "base",
+				"title":     "fake-title",
+				"body":      "fake-description",
+				"assignees": []any{"assignee"},
+			}, req)
+			res = pr(1, "open", "head")
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/api/v1/repos/owner/repo/issues/2/comments":
+			req := map[string]string{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]string{"body": "comment"}, req)
+			res = map[string]any{}
+		case r.Method == http.MethodPatch &&
+			r.URL.Path == "/api/v1/repos/owner/repo/pulls/2":
+			req := map[string]string{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]string{"state": "closed"}, req)
+			res = pr(2, "closed", "head")
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/api/v1/repos/owner/repo/statuses/"+testHeadSHA:
+			req := map[string]string{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, map[string]string{
+				"state":       "failure",
+				"context":     "kargo/test",
+				"description": "fake-description",
+				"target_url":  "https://kargo.example.com",
+			}, req)
+			res = map[string]any{}
+		case r.URL.Path == "/api/v1/repos/owner/repo/pulls/1":
+			res = pr(1, "closed", "head")
+		case r.URL.Path == "/api/v1/repos/owner/repo/pulls/2":
+			res = pr(2, "open", "head")
+		case r.URL.Path == "/api/v1/repos/owner/repo/pulls":
+			require.Equal(t, "open", r.URL.Query().Get("state"))
+			require.Equal(t, strconv.Itoa(pageSize), r.URL.Query().Get("limit"))
+			if r.URL.Query().Get("page") == "2" {
+				res = []any{pr(pageSize+1, "open", "head", "preview")}
+				break
+			}
+			prs := make([]any, 0, pageSize)
+			for i := 1; i <= pageSize; i++ {
+				head := "other-head"
+				if i == 2 {
+					head = "head"
+				}
+				prs = append(prs, pr(int64(i), "open", head))
+			}
+			res = prs
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+func newTestProvider(t *testing.T, srv *httptest.Server) gitprovider.GitProviderService {
+	p, err := NewGiteaProvider(
+		srv.URL+"/owner/repo.git",
+		&gitprovider.GitProviderOptions{Token: "fake-token"},
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	pr, err := newTestProvider(t, srv).CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:        "head",
+			Base:        "base",
+			Title:       "fake-title",
+			Description: "fake-description",
+			Assignees:   []string{"assignee"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pr.Number)
+	require.Equal(t, "https://gitea.example.com/owner/repo/pulls/1", pr.URL)
+	require.Equal(t, gitprovider.PullRequestStateOpen, pr.State)
+	require.Equal(t, testHeadSHA, pr.HeadSHA)
+	require.Equal(t, "head", pr.HeadRef)
+	require.Empty(t, pr.MergeCommitSHA)
+	require.False(t, pr.FromFork)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *pr.UpdatedAt)
+}
+
+func TestGetPullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	pr, err := p.GetPullRequest(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	require.Equal(t, testMergeSHA, pr.MergeCommitSHA)
+
+	_, err = p.GetPullRequest(context.Background(), 42)
+	require.ErrorContains(t, err, "unexpected HTTP status 404")
+}
+
+func TestListPullRequests(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	prs, err := p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			Head: "head",
+			Base: "base",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	require.Equal(t, int64(2), prs[0].Number)
+	require.Equal(t, int64(pageSize+1), prs[1].Number)
+
+	prs, err = p.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{Labels: []string{"preview"}},
+	)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	require.Equal(t, []string{"preview"}, prs[0].Labels)
+}
+
+func TestIsPullRequestMerged(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	merged, err := p.IsPullRequestMerged(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, merged)
+
+	merged, err = p.IsPullRequestMerged(context.Background(), 2)
+	require.NoError(t, err)
+	require.False(t, merged)
+}
+
+func TestConvertGiteaPR(t *testing.T) {
+	pr := convertGiteaPR(&giteaPullRequest{
+		Number: 1,
+		State:  "closed",
+		Base: giteaBranch{
+			Ref:  "base",
+			Repo: &giteaRepository{FullName: "owner/repo"},
+		},
+	})
+	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
+	// The head repository of a pull request from a deleted fork is unknown
+	require.True(t, pr.FromFork)
+	require.Empty(t, pr.MergeCommitSHA)
+}
+
+func TestCommentOnPullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	require.NoError(t, p.CommentOnPullRequest(context.Background(), 2, "comment"))
+	require.ErrorContains(
+		t,
+		p.CommentOnPullRequest(context.Background(), 42, "comment"),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestClosePullRequest(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	require.NoError(t, p.ClosePullRequest(context.Background(), 2))
+	require.ErrorContains(
+		t,
+		p.ClosePullRequest(context.Background(), 42),
+		"unexpected HTTP status 404",
+	)
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+	p := newTestProvider(t, srv)
+
+	status := gitprovider.CommitStatus{
+		State:       gitprovider.CommitStatusStateFailure,
+		Context:     "kargo/test",
+		Description: "fake-description",
+		TargetURL:   "https://kargo.example.com",
+	}
+	require.NoError(t, p.SetCommitStatus(context.Background(), testHeadSHA, status))
+	require.ErrorContains(
+		t,
+		p.SetCommitStatus(context.Background(), testMergeSHA, status),
+		"unexpected HTTP status 404",
+	)
+}
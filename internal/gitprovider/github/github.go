@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/google/go-github/v56/github"
@@ -17,6 +19,10 @@ import (
 
 const (
 	GitProviderServiceName = "github"
+
+	// maxStatusDescriptionLength is the maximum length of the description of a
+	// commit status accepted by the GitHub API.
+	maxStatusDescriptionLength = 140
 )
 
 var (
@@ -105,9 +111,93 @@ func (g *GitHubProvider) CreatePullRequest(
 	if err != nil {
 		return nil, err
 	}
+	// Labels, assignees, and reviewers cannot be specified when creating a pull
+	// request, so they are added to it afterwards.
+	if len(opts.Labels) > 0 || len(opts.Assignees) > 0 {
+		req := &github.IssueRequest{}
+		if len(opts.Labels) > 0 {
+			req.Labels = &opts.Labels
+		}
+		if len(opts.Assignees) > 0 {
+			req.Assignees = &opts.Assignees
+		}
+		if _, _, err = g.client.Issues.Edit(
+			ctx,
+			g.owner,
+			g.repo,
+			ghPR.GetNumber(),
+			req,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error adding labels and assignees to pull request %d: %w",
+				ghPR.GetNumber(), err,
+			)
+		}
+		// Reflect the labels in the returned pull request
+		for _, label := range opts.Labels {
+			ghPR.Labels = append(ghPR.Labels, &github.Label{Name: github.String(label)})
+		}
+	}
+	if len(opts.Reviewers) > 0 {
+		if _, _, err = g.client.PullRequests.RequestReviewers(
+			ctx,
+			g.owner,
+			g.repo,
+			ghPR.GetNumber(),
+			github.ReviewersRequest{Reviewers: opts.Reviewers},
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error requesting reviews of pull request %d: %w",
+				ghPR.GetNumber(), err,
+			)
+		}
+	}
+	if opts.AutoMerge {
+		if err = g.enableAutoMerge(ctx, ghPR.GetNodeID()); err != nil {
+			return nil, fmt.Errorf(
+				"error enabling auto-merge for pull request %d: %w",
+				ghPR.GetNumber(), err,
+			)
+		}
+	}
 	return convertGithubPR(ghPR), nil
 }
 
+// enableAutoMerge enables auto-merge for the pull request with the given node
+// ID. This is only possible using the GraphQL API.
+func (g *GitHubProvider) enableAutoMerge(ctx context.Context, nodeID string) error {
+	// The GraphQL endpoint is a sibling of the REST API's base URL for both
+	// github.com (https://api.github.com/) and GitHub Enterprise Server
+	// (https://<host>/api/v3/).
+	req, err := g.client.NewRequest(
+		http.MethodPost,
+		"../graphql",
+		map[string]any{
+			"query": `mutation($id: ID!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id}) {
+    clientMutationId
+  }
+}`,
+			"variables": map[string]any{"id": nodeID},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error creating GraphQL request: %w", err)
+	}
+	res := struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	if _, err = g.client.Do(ctx, req, &res); err != nil {
+		return err
+	}
+	if len(res.Errors) > 0 {
+		return errors.New(res.Errors[0].Message)
+	}
+	return nil
+}
+
 func (g *GitHubProvider) GetPullRequest(
 	ctx context.Context,
 	id int64,
@@ -126,6 +216,9 @@ func (g *GitHubProvider) ListPullRequests(
 	listOpts := github.PullRequestListOptions{
 		Head: opts.Head,
 		Base: opts.Base,
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
 	}
 	switch opts.State {
 	case "", gitprovider.PullRequestStateOpen:
@@ -133,17 +226,39 @@ func (g *GitHubProvider) ListPullRequests(
 	case gitprovider.PullRequestStateClosed:
 		listOpts.State = "closed"
 	}
-	ghPRs, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, &listOpts)
-	if err != nil {
-		return nil, err
-	}
-	prs := make([]*gitprovider.PullRequest, len(ghPRs))
-	for i, ghPR := range ghPRs {
-		prs[i] = convertGithubPR(ghPR)
+	var prs []*gitprovider.PullRequest
+	for {
+		ghPRs, res, err := g.client.PullRequests.List(ctx, g.owner, g.repo, &listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, ghPR := range ghPRs {
+			pr := convertGithubPR(ghPR)
+			// The GitHub API does not support filtering pull requests by label,
+			// so we do it here.
+			if !hasLabels(pr, opts.Labels) {
+				continue
+			}
+			prs = append(prs, pr)
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
 	}
 	return prs, nil
 }
 
+// hasLabels returns true if the given pull request has all the given labels.
+func hasLabels(pr *gitprovider.PullRequest, labels []string) bool {
+	for _, label := range labels {
+		if !slices.Contains(pr.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
 func convertGithubPR(ghPR *github.PullRequest) *gitprovider.PullRequest {
 	var prState gitprovider.PullRequestState
 	switch ptr.Deref(ghPR.State, "") {
@@ -152,14 +267,28 @@ func convertGithubPR(ghPR *github.PullRequest) *gitprovider.PullRequest {
 	case "closed":
 		prState = gitprovider.PullRequestStateClosed
 	}
-	return &gitprovider.PullRequest{
+	pr := &gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(ghPR.Number, 0)),
 		URL:            ptr.Deref(ghPR.HTMLURL, ""),
 		State:          prState,
 		MergeCommitSHA: ptr.Deref(ghPR.MergeCommitSHA, ""),
 		Object:         ghPR,
-		HeadSHA:        ptr.Deref(ghPR.Head.SHA, ""),
+		HeadSHA:        ghPR.GetHead().GetSHA(),
+		HeadRef:        ghPR.GetHead().GetRef(),
+		Title:          ghPR.GetTitle(),
+	}
+	// The head repository is nil if the fork it belonged to has been deleted.
+	if headRepo := ghPR.GetHead().GetRepo(); headRepo == nil ||
+		headRepo.GetFullName() != ghPR.GetBase().GetRepo().GetFullName() {
+		pr.FromFork = true
+	}
+	for _, label := range ghPR.Labels {
+		pr.Labels = append(pr.Labels, label.GetName())
 	}
+	if ghPR.UpdatedAt != nil {
+		pr.UpdatedAt = ptr.To(ghPR.UpdatedAt.Time)
+	}
+	return pr
 }
 
 func parseGitHubURL(repoURL string) (string, string, string, error) {
@@ -183,3 +312,53 @@ func (g *GitHubProvider) IsPullRequestMerged(ctx context.Context, id int64) (boo
 	}
 	return merged, nil
 }
+
+func (g *GitHubProvider) CommentOnPullRequest(
+	ctx context.Context,
+	id int64,
+	body string,
+) error {
+	_, _, err := g.client.Issues.CreateComment(
+		ctx,
+		g.owner,
+		g.repo,
+		int(id),
+		&github.IssueComment{Body: &body},
+	)
+	return err
+}
+
+func (g *GitHubProvider) ClosePullRequest(ctx context.Context, id int64) error {
+	_, _, err := g.client.PullRequests.Edit(
+		ctx,
+		g.owner,
+		g.repo,
+		int(id),
+		&github.PullRequest{State: github.String("closed")},
+	)
+	return err
+}
+
+func (g *GitHubProvider) SetCommitStatus(
+	ctx context.Context,
+	sha string,
+	status gitprovider.CommitStatus,
+) error {
+	// https://docs.github.com/en/rest/commits/statuses?apiVersion=2022-11-28#create-a-commit-status
+	ghStatus := &github.RepoStatus{
+		State:   github.String(strings.ToLower(string(status.State))),
+		Context: github.String(status.Context),
+	}
+	if status.Description != "" {
+		description := status.Description
+		if len(description) > maxStatusDescriptionLength {
+			description = description[:maxStatusDescriptionLength-3] + "..."
+		}
+		ghStatus.Description = &description
+	}
+	if status.TargetURL != "" {
+		ghStatus.TargetURL = github.String(status.TargetURL)
+	}
+	_, _, err := g.client.Repositories.CreateStatus(ctx, g.owner, g.repo, sha, ghStatus)
+	return err
+}
@@ -1,9 +1,19 @@
 package github
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/v56/github"
 	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/gitprovider"
 )
 
 func TestParseGitHubURL(t *testing.T) {
@@ -57,3 +67,232 @@ func TestParseGitHubURL(t *testing.T) {
 		})
 	}
 }
+
+func TestListPullRequests(t *testing.T) {
+	const baseRepo = `{"full_name":"akuity/kargo"}`
+	pages := []string{
+		`[{
+			"number": 1,
+			"state": "open",
+			"title": "Add feature",
+			"html_url": "https://github.com/akuity/kargo/pull/1",
+			"updated_at": "2024-01-01T00:00:00Z",
+			"labels": [{"name": "preview"}],
+			"head": {"sha": "abc", "ref": "feature", "repo": ` + baseRepo + `},
+			"base": {"ref": "main", "repo": ` + baseRepo + `}
+		}, {
+			"number": 2,
+			"state": "open",
+			"head": {"sha": "def", "ref": "other", "repo": ` + baseRepo + `},
+			"base": {"ref": "main", "repo": ` + baseRepo + `}
+		}]`,
+		`[{
+			"number": 3,
+			"state": "open",
+			"labels": [{"name": "preview"}, {"name": "other"}],
+			"head": {"sha": "ghi", "ref": "main", "repo": {"full_name": "someone/kargo"}},
+			"base": {"ref": "main", "repo": ` + baseRepo + `}
+		}]`,
+	}
+
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		page := 1
+		if p := query.Get("page"); p != "" {
+			_, _ = fmt.Sscanf(p, "%d", &page)
+		}
+		if page < len(pages) {
+			w.Header().Set(
+				"Link",
+				fmt.Sprintf(`<%s%s?page=%d>; rel="next"`, "http://"+r.Host, r.URL.Path, page+1),
+			)
+		}
+		_, _ = w.Write([]byte(pages[page-1]))
+	}))
+	defer server.Close()
+
+	client, err := github.NewClient(nil).WithEnterpriseURLs(server.URL, server.URL)
+	require.NoError(t, err)
+	g := &GitHubProvider{
+		owner:  "akuity",
+		repo:   "kargo",
+		client: client,
+	}
+
+	prs, err := g.ListPullRequests(
+		context.Background(),
+		gitprovider.ListPullRequestOpts{
+			Base:   "main",
+			Labels: []string{"preview"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "main", query.Get("base"))
+	require.Equal(t, "open", query.Get("state"))
+
+	require.Len(t, prs, 2)
+	require.Equal(t, int64(1), prs[0].Number)
+	require.Equal(t, "abc", prs[0].HeadSHA)
+	require.Equal(t, "feature", prs[0].HeadRef)
+	require.Equal(t, "Add feature", prs[0].Title)
+	require.Equal(t, []string{"preview"}, prs[0].Labels)
+	require.Equal(t, "https://github.com/akuity/kargo/pull/1", prs[0].URL)
+	require.NotNil(t, prs[0].UpdatedAt)
+	require.False(t, prs[0].FromFork)
+	require.Equal(t, int64(3), prs[1].Number)
+	require.True(t, prs[1].FromFork)
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		switch r.URL.Path {
+		case "/api/v3/repos/akuity/kargo/pulls":
+			_, _ = w.Write([]byte(`{"number": 1, "node_id": "PR_1", "state": "open"}`))
+		case "/api/graphql":
+			_, _ = w.Write([]byte(`{"data": {}}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := github.NewClient(nil).WithEnterpriseURLs(server.URL, server.URL)
+	require.NoError(t, err)
+	g := &GitHubProvider{
+		owner:  "akuity",
+		repo:   "kargo",
+		client: client,
+	}
+
+	pr, err := g.CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:      "feature",
+			Base:      "main",
+			Title:     "title",
+			Labels:    []string{"preview"},
+			Assignees: []string{"alice"},
+			Reviewers: []string{"bob"},
+			AutoMerge: true,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pr.Number)
+	require.Equal(t, []string{"preview"}, pr.Labels)
+
+	require.Len(t, requests, 4)
+	require.Contains(t, requests[0], "POST /api/v3/repos/akuity/kargo/pulls")
+	require.Equal(
+		t,
+		`PATCH /api/v3/repos/akuity/kargo/issues/1 {"labels":["preview"],"assignees":["alice"]}`+"\n",
+		requests[1],
+	)
+	require.Equal(
+		t,
+		`POST /api/v3/repos/akuity/kargo/pulls/1/requested_reviewers {"reviewers":["bob"]}`+"\n",
+		requests[2],
+	)
+	require.Contains(t, requests[3], "POST /api/graphql")
+	require.Contains(t, requests[3], "enablePullRequestAutoMerge")
+	require.Contains(t, requests[3], `"variables":{"id":"PR_1"}`)
+}
+
+func TestEnableAutoMergeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"errors": [{"message": "auto-merge is not allowed"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := github.NewClient(nil).WithEnterpriseURLs(server.URL, server.URL)
+	require.NoError(t, err)
+	g := &GitHubProvider{client: client}
+
+	err = g.enableAutoMerge(context.Background(), "PR_1")
+	require.EqualError(t, err, "auto-merge is not allowed")
+}
+
+func TestCommentOnAndClosePullRequest(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := github.NewClient(nil).WithEnterpriseURLs(server.URL, server.URL)
+	require.NoError(t, err)
+	g := &GitHubProvider{
+		owner:  "akuity",
+		repo:   "kargo",
+		client: client,
+	}
+
+	require.NoError(t, g.CommentOnPullRequest(context.Background(), 1, "comment"))
+	require.NoError(t, g.ClosePullRequest(context.Background(), 1))
+	require.Equal(
+		t,
+		[]string{
+			`POST /api/v3/repos/akuity/kargo/issues/1/comments {"body":"comment"}` + "\n",
+			`PATCH /api/v3/repos/akuity/kargo/pulls/1 {"state":"closed"}` + "\n",
+		},
+		requests,
+	)
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := github.NewClient(nil).WithEnterpriseURLs(server.URL, server.URL)
+	require.NoError(t, err)
+	g := &GitHubProvider{
+		owner:  "akuity",
+		repo:   "kargo",
+		client: client,
+	}
+
+	require.NoError(t, g.SetCommitStatus(
+		context.Background(),
+		"abc123",
+		gitprovider.CommitStatus{
+			State:       gitprovider.CommitStatusStateSuccess,
+			Context:     "kargo/test",
+			Description: "Promoted",
+			TargetURL:   "https://kargo.example.com",
+		},
+	))
+	require.NoError(t, g.SetCommitStatus(
+		context.Background(),
+		"abc123",
+		gitprovider.CommitStatus{
+			State:       gitprovider.CommitStatusStatePending,
+			Context:     "kargo/test",
+			Description: strings.Repeat("a", 200),
+		},
+	))
+	require.Equal(
+		t,
+		[]string{
+			`POST /api/v3/repos/akuity/kargo/statuses/abc123 ` +
+				`{"state":"success","target_url":"https://kargo.example.com",` +
+				`"description":"Promoted","context":"kargo/test"}` + "\n",
+			`POST /api/v3/repos/akuity/kargo/statuses/abc123 ` +
+				`{"state":"pending","description":"` + strings.Repeat("a", 137) +
+				`...","context":"kargo/test"}` + "\n",
+		},
+		requests,
+	)
+}
@@ -63,10 +63,52 @@ type mergeRequestClient interface {
 		opt *gitlab.GetMergeRequestsOptions,
 		options ...gitlab.RequestOptionFunc,
 	) (*gitlab.MergeRequest, *gitlab.Response, error)
+
+	UpdateMergeRequest(
+		pid any,
+		mergeRequest int,
+		opt *gitlab.UpdateMergeRequestOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+
+	AcceptMergeRequest(
+		pid any,
+		mergeRequest int,
+		opt *gitlab.AcceptMergeRequestOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.MergeRequest, *gitlab.Response, error)
+}
+
+type notesClient interface {
+	CreateMergeRequestNote(
+		pid any,
+		mergeRequest int,
+		opt *gitlab.CreateMergeRequestNoteOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Note, *gitlab.Response, error)
+}
+
+type commitsClient interface {
+	SetCommitStatus(
+		pid any,
+		sha string,
+		opt *gitlab.SetCommitStatusOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.CommitStatus, *gitlab.Response, error)
+}
+
+type usersClient interface {
+	ListUsers(
+		opt *gitlab.ListUsersOptions,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.User, *gitlab.Response, error)
 }
 
 type gitLabClient struct { // nolint: revive
 	mergeRequests mergeRequestClient
+	notes         notesClient
+	commits       commitsClient
+	users         usersClient
 }
 
 type gitLabProvider struct { // nolint: revive
@@ -110,7 +152,12 @@ func NewGitLabProvider(
 	}
 	return &gitLabProvider{
 		projectName: projectName,
-		client:      &gitLabClient{mergeRequests: client.MergeRequests},
+		client: &gitLabClient{
+			mergeRequests: client.MergeRequests,
+			notes:         client.Notes,
+			commits:       client.Commits,
+			users:         client.Users,
+		},
 	}, nil
 }
 
@@ -118,19 +165,71 @@ func (g *gitLabProvider) CreatePullRequest(
 	_ context.Context,
 	opts gitprovider.CreatePullRequestOpts,
 ) (*gitprovider.PullRequest, error) {
-	glMR, _, err := g.client.mergeRequests.CreateMergeRequest(g.projectName, &gitlab.CreateMergeRequestOptions{
+	createOpts := &gitlab.CreateMergeRequestOptions{
 		Title:              &opts.Title,
 		Description:        &opts.Description,
 		SourceBranch:       &opts.Head,
 		TargetBranch:       &opts.Base,
 		RemoveSourceBranch: gitlab.Ptr(true),
-	})
+	}
+	if len(opts.Labels) > 0 {
+		createOpts.Labels = (*gitlab.LabelOptions)(&opts.Labels)
+	}
+	if len(opts.Assignees) > 0 {
+		assigneeIDs, err := g.getUserIDs(opts.Assignees)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving assignees: %w", err)
+		}
+		createOpts.AssigneeIDs = &assigneeIDs
+	}
+	if len(opts.Reviewers) > 0 {
+		reviewerIDs, err := g.getUserIDs(opts.Reviewers)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving reviewers: %w", err)
+		}
+		createOpts.ReviewerIDs = &reviewerIDs
+	}
+	glMR, _, err := g.client.mergeRequests.CreateMergeRequest(g.projectName, createOpts)
 	if err != nil {
 		return nil, err
 	}
+	if opts.AutoMerge {
+		acceptedMR, _, err := g.client.mergeRequests.AcceptMergeRequest(
+			g.projectName,
+			glMR.IID,
+			&gitlab.AcceptMergeRequestOptions{
+				MergeWhenPipelineSucceeds: gitlab.Ptr(true),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error enabling merge when pipeline succeeds for merge request %d: %w",
+				glMR.IID, err,
+			)
+		}
+		glMR = acceptedMR
+	}
 	return convertGitlabMR(glMR), nil
 }
 
+// getUserIDs returns the IDs of the users with the given usernames.
+func (g *gitLabProvider) getUserIDs(usernames []string) ([]int, error) {
+	ids := make([]int, 0, len(usernames))
+	for _, username := range usernames {
+		users, _, err := g.client.users.ListUsers(
+			&gitlab.ListUsersOptions{Username: gitlab.Ptr(username)},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error finding user %q: %w", username, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user %q not found", username)
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return ids, nil
+}
+
 func (g *gitLabProvider) GetPullRequest(
 	_ context.Context,
 	id int64,
@@ -147,18 +246,38 @@ func (g *gitLabProvider) ListPullRequests(
 	opts gitprovider.ListPullRequestOpts,
 ) ([]*gitprovider.PullRequest, error) {
 	listOpts := &gitlab.ListProjectMergeRequestsOptions{
-		SourceBranch: &opts.Head,
-		TargetBranch: &opts.Base,
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
 	}
-	glMRs, _, err := g.client.mergeRequests.ListProjectMergeRequests(g.projectName, listOpts)
-	if err != nil {
-		return nil, err
+	if opts.Head != "" {
+		listOpts.SourceBranch = &opts.Head
+	}
+	if opts.Base != "" {
+		listOpts.TargetBranch = &opts.Base
 	}
-	prs := make([]*gitprovider.PullRequest, 0, len(glMRs))
-	for _, glMR := range glMRs {
-		if (opts.State == gitprovider.PullRequestStateOpen) == isMROpen(glMR) {
+	switch opts.State {
+	case "", gitprovider.PullRequestStateOpen:
+		listOpts.State = gitlab.Ptr("opened")
+	case gitprovider.PullRequestStateClosed:
+		listOpts.State = gitlab.Ptr("closed")
+	}
+	if len(opts.Labels) > 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&opts.Labels)
+	}
+	var prs []*gitprovider.PullRequest
+	for {
+		glMRs, res, err := g.client.mergeRequests.ListProjectMergeRequests(g.projectName, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, glMR := range glMRs {
 			prs = append(prs, convertGitlabMR(glMR))
 		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
 	}
 	return prs, nil
 }
@@ -171,6 +290,54 @@ func (g *gitLabProvider) IsPullRequestMerged(_ context.Context, id int64) (bool,
 	return glMR.State == "merged", nil
 }
 
+func (g *gitLabProvider) CommentOnPullRequest(
+	_ context.Context,
+	id int64,
+	body string,
+) error {
+	_, _, err := g.client.notes.CreateMergeRequestNote(
+		g.projectName,
+		int(id),
+		&gitlab.CreateMergeRequestNoteOptions{Body: &body},
+	)
+	return err
+}
+
+func (g *gitLabProvider) ClosePullRequest(_ context.Context, id int64) error {
+	_, _, err := g.client.mergeRequests.UpdateMergeRequest(
+		g.projectName,
+		int(id),
+		&gitlab.UpdateMergeRequestOptions{StateEvent: gitlab.Ptr("close")},
+	)
+	return err
+}
+
+func (g *gitLabProvider) SetCommitStatus(
+	_ context.Context,
+	sha string,
+	status gitprovider.CommitStatus,
+) error {
+	opts := &gitlab.SetCommitStatusOptions{
+		State: gitlab.Pending,
+		Name:  &status.Context,
+	}
+	switch status.State {
+	case gitprovider.CommitStatusStateSuccess:
+		opts.State = gitlab.Success
+	case gitprovider.CommitStatusStateFailure, gitprovider.CommitStatusStateError:
+		// GitLab has no distinct state for errors
+		opts.State = gitlab.Failed
+	}
+	if status.Description != "" {
+		opts.Description = &status.Description
+	}
+	if status.TargetURL != "" {
+		opts.TargetURL = &status.TargetURL
+	}
+	_, _, err := g.client.commits.SetCommitStatus(g.projectName, sha, opts)
+	return err
+}
+
 func convertGitlabMR(glMR *gitlab.MergeRequest) *gitprovider.PullRequest {
 	var prState gitprovider.PullRequestState
 	if isMROpen(glMR) {
@@ -185,6 +352,11 @@ func convertGitlabMR(glMR *gitlab.MergeRequest) *gitprovider.PullRequest {
 		MergeCommitSHA: glMR.MergeCommitSHA,
 		Object:         glMR,
 		HeadSHA:        glMR.SHA,
+		HeadRef:        glMR.SourceBranch,
+		FromFork:       glMR.SourceProjectID != glMR.TargetProjectID,
+		Title:          glMR.Title,
+		Labels:         glMR.Labels,
+		UpdatedAt:      glMR.UpdatedAt,
 	}
 }
 
@@ -16,6 +16,12 @@ type mockGitLabClient struct {
 	mr         *gitlab.MergeRequest
 	createOpts *gitlab.CreateMergeRequestOptions
 	listOpts   *gitlab.ListProjectMergeRequestsOptions
+	updateOpts *gitlab.UpdateMergeRequestOptions
+	acceptOpts *gitlab.AcceptMergeRequestOptions
+	noteOpts   *gitlab.CreateMergeRequestNoteOptions
+	statusSHA  string
+	statusOpts *gitlab.SetCommitStatusOptions
+	users      map[string]int
 	pid        any
 }
 
@@ -49,6 +55,62 @@ func (m *mockGitLabClient) GetMergeRequest(
 	return m.mr, nil, nil
 }
 
+func (m *mockGitLabClient) UpdateMergeRequest(
+	pid any,
+	_ int,
+	opt *gitlab.UpdateMergeRequestOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	m.pid = pid
+	m.updateOpts = opt
+	return m.mr, nil, nil
+}
+
+func (m *mockGitLabClient) AcceptMergeRequest(
+	pid any,
+	_ int,
+	opt *gitlab.AcceptMergeRequestOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.MergeRequest, *gitlab.Response, error) {
+	m.pid = pid
+	m.acceptOpts = opt
+	return m.mr, nil, nil
+}
+
+func (m *mockGitLabClient) CreateMergeRequestNote(
+	pid any,
+	_ int,
+	opt *gitlab.CreateMergeRequestNoteOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.Note, *gitlab.Response, error) {
+	m.pid = pid
+	m.noteOpts = opt
+	return &gitlab.Note{}, nil, nil
+}
+
+func (m *mockGitLabClient) SetCommitStatus(
+	pid any,
+	sha string,
+	opt *gitlab.SetCommitStatusOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.CommitStatus, *gitlab.Response, error) {
+	m.pid = pid
+	m.statusSHA = sha
+	m.statusOpts = opt
+	return &gitlab.CommitStatus{}, nil, nil
+}
+
+func (m *mockGitLabClient) ListUsers(
+	opt *gitlab.ListUsersOptions,
+	_ ...gitlab.RequestOptionFunc,
+) ([]*gitlab.User, *gitlab.Response, error) {
+	id, ok := m.users[*opt.Username]
+	if !ok {
+		return nil, nil, nil
+	}
+	return []*gitlab.User{{ID: id, Username: *opt.Username}}, nil, nil
+}
+
 func TestCreatePullRequest(t *testing.T) {
 	mockClient := &mockGitLabClient{
 		mr: &gitlab.MergeRequest{
@@ -86,6 +148,108 @@ func TestCreatePullRequest(t *testing.T) {
 	require.Equal(t, gitprovider.PullRequestStateClosed, pr.State)
 }
 
+func TestCreatePullRequestWithOptions(t *testing.T) {
+	mockClient := &mockGitLabClient{
+		mr: &gitlab.MergeRequest{
+			IID:   1,
+			State: "opened",
+		},
+		users: map[string]int{"alice": 10, "bob": 20},
+	}
+	g := gitLabProvider{
+		projectName: testProjectName,
+		client: &gitLabClient{
+			mergeRequests: mockClient,
+			users:         mockClient,
+		},
+	}
+
+	_, err := g.CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{
+			Head:      "head",
+			Base:      "base",
+			Title:     "title",
+			Labels:    []string{"preview"},
+			Assignees: []string{"alice"},
+			Reviewers: []string{"alice", "bob"},
+			AutoMerge: true,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, gitlab.LabelOptions{"preview"}, *mockClient.createOpts.Labels)
+	require.Equal(t, []int{10}, *mockClient.createOpts.AssigneeIDs)
+	require.Equal(t, []int{10, 20}, *mockClient.createOpts.ReviewerIDs)
+	require.True(t, *mockClient.acceptOpts.MergeWhenPipelineSucceeds)
+
+	_, err = g.CreatePullRequest(
+		context.Background(),
+		gitprovider.CreatePullRequestOpts{Reviewers: []string{"mallory"}},
+	)
+	require.ErrorContains(t, err, `user "mallory" not found`)
+}
+
+func TestCommentOnPullRequest(t *testing.T) {
+	mockClient := &mockGitLabClient{}
+	g := gitLabProvider{
+		projectName: testProjectName,
+		client:      &gitLabClient{notes: mockClient},
+	}
+
+	require.NoError(t, g.CommentOnPullRequest(context.Background(), 1, "comment"))
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "comment", *mockClient.noteOpts.Body)
+}
+
+func TestClosePullRequest(t *testing.T) {
+	mockClient := &mockGitLabClient{mr: &gitlab.MergeRequest{IID: 1}}
+	g := gitLabProvider{
+		projectName: testProjectName,
+		client:      &gitLabClient{mergeRequests: mockClient},
+	}
+
+	require.NoError(t, g.ClosePullRequest(context.Background(), 1))
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "close", *mockClient.updateOpts.StateEvent)
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	testCases := []struct {
+		state         gitprovider.CommitStatusState
+		expectedState gitlab.BuildStateValue
+	}{
+		{gitprovider.CommitStatusStatePending, gitlab.Pending},
+		{gitprovider.CommitStatusStateSuccess, gitlab.Success},
+		{gitprovider.CommitStatusStateFailure, gitlab.Failed},
+		{gitprovider.CommitStatusStateError, gitlab.Failed},
+	}
+	for _, testCase := range testCases {
+		t.Run(string(testCase.state), func(t *testing.T) {
+			mockClient := &mockGitLabClient{}
+			g := gitLabProvider{
+				projectName: testProjectName,
+				client:      &gitLabClient{commits: mockClient},
+			}
+
+			require.NoError(t, g.SetCommitStatus(
+				context.Background(),
+				"abc123",
+				gitprovider.CommitStatus{
+					State:       testCase.state,
+					Context:     "kargo/test",
+					Description: "fake-description",
+				},
+			))
+			require.Equal(t, testProjectName, mockClient.pid)
+			require.Equal(t, "abc123", mockClient.statusSHA)
+			require.Equal(t, testCase.expectedState, mockClient.statusOpts.State)
+			require.Equal(t, "kargo/test", *mockClient.statusOpts.Name)
+			require.Equal(t, "fake-description", *mockClient.statusOpts.Description)
+			require.Nil(t, mockClient.statusOpts.TargetURL)
+		})
+	}
+}
+
 func TestGetPullRequest(t *testing.T) {
 	mockClient := &mockGitLabClient{
 		mr: &gitlab.MergeRequest{
@@ -129,20 +293,54 @@ func TestListPullRequests(t *testing.T) {
 	}
 
 	opts := gitprovider.ListPullRequestOpts{
-		Head: "head",
-		Base: "base",
+		State:  gitprovider.PullRequestStateClosed,
+		Head:   "head",
+		Base:   "base",
+		Labels: []string{"preview"},
 	}
 	prs, err := g.ListPullRequests(context.Background(), opts)
 
 	require.NoError(t, err)
 	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "closed", *mockClient.listOpts.State)
 	require.Equal(t, opts.Head, *mockClient.listOpts.SourceBranch)
 	require.Equal(t, opts.Base, *mockClient.listOpts.TargetBranch)
+	require.Equal(t, gitlab.LabelOptions(opts.Labels), *mockClient.listOpts.Labels)
 
 	require.Equal(t, int64(mockClient.mr.IID), prs[0].Number)
 	require.Equal(t, mockClient.mr.MergeCommitSHA, prs[0].MergeCommitSHA)
 	require.Equal(t, mockClient.mr.WebURL, prs[0].URL)
 	require.Equal(t, gitprovider.PullRequestStateClosed, prs[0].State)
+
+	// Open merge requests are listed if no state is specified
+	mockClient.mr.State = "opened"
+	prs, err = g.ListPullRequests(context.Background(), gitprovider.ListPullRequestOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "opened", *mockClient.listOpts.State)
+	require.Nil(t, mockClient.listOpts.SourceBranch)
+	require.Nil(t, mockClient.listOpts.Labels)
+	require.Len(t, prs, 1)
+	require.Equal(t, gitprovider.PullRequestStateOpen, prs[0].State)
+}
+
+func TestConvertGitlabMR(t *testing.T) {
+	pr := convertGitlabMR(&gitlab.MergeRequest{
+		IID:             1,
+		State:           "opened",
+		WebURL:          "url",
+		SHA:             "sha",
+		SourceBranch:    "feature",
+		SourceProjectID: 2,
+		TargetProjectID: 1,
+		Title:           "title",
+		Labels:          gitlab.Labels{"preview"},
+	})
+	require.Equal(t, gitprovider.PullRequestStateOpen, pr.State)
+	require.Equal(t, "sha", pr.HeadSHA)
+	require.Equal(t, "feature", pr.HeadRef)
+	require.True(t, pr.FromFork)
+	require.Equal(t, "title", pr.Title)
+	require.Equal(t, []string{"preview"}, pr.Labels)
 }
 
 func TestIsPullRequestMerged(t *testing.T) {
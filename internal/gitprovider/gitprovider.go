@@ -2,6 +2,7 @@ package gitprovider
 
 import (
 	"context"
+	"time"
 )
 
 // GitProviderOptions contains the options for a GitProvider.
@@ -31,6 +32,16 @@ type GitProviderService interface { // nolint: revive
 
 	// IsPullRequestMerged returns whether or not the pull request was merged
 	IsPullRequestMerged(ctx context.Context, number int64) (bool, error)
+
+	// CommentOnPullRequest adds a comment with the given body to a pull request
+	CommentOnPullRequest(ctx context.Context, number int64, body string) error
+
+	// ClosePullRequest closes a pull request without merging it
+	ClosePullRequest(ctx context.Context, number int64) error
+
+	// SetCommitStatus sets a status on the commit with the given SHA. Setting a
+	// status with the same context as an existing one replaces it.
+	SetCommitStatus(ctx context.Context, sha string, status CommitStatus) error
 }
 
 type CreatePullRequestOpts struct {
@@ -38,6 +49,20 @@ type CreatePullRequestOpts struct {
 	Base        string
 	Title       string
 	Description string
+	// Labels are the labels to apply to the pull request. Providers that do not
+	// support labels ignore this field.
+	Labels []string
+	// Assignees are the usernames of the users to assign the pull request to.
+	// Providers that do not support assignees ignore this field.
+	Assignees []string
+	// Reviewers are the usernames of the users to request reviews from.
+	// Providers that do not support requesting reviews by username ignore this
+	// field.
+	Reviewers []string
+	// AutoMerge indicates whether the pull request should be merged
+	// automatically once all of the repository's requirements for merging it
+	// are satisfied. Providers that do not support this ignore this field.
+	AutoMerge bool
 }
 
 type ListPullRequestOpts struct {
@@ -45,6 +70,9 @@ type ListPullRequestOpts struct {
 	State PullRequestState
 	Head  string
 	Base  string
+	// Labels optionally limits the results to pull requests having all of the
+	// given labels.
+	Labels []string
 }
 
 type PullRequestState string
@@ -68,12 +96,46 @@ type PullRequest struct {
 	Object any `json:"-"`
 	// HeadSHA is the SHA of the head commit
 	HeadSHA string `json:"headSHA"`
+	// HeadRef is the name of the branch the changes are pulled from
+	HeadRef string `json:"headRef"`
+	// FromFork indicates whether the head branch belongs to a fork of the
+	// repository, in which case the head commit may not be available in the
+	// repository itself
+	FromFork bool `json:"fromFork"`
+	// Title is the title of the pull request
+	Title string `json:"title"`
+	// Labels are the labels of the pull request
+	Labels []string `json:"labels"`
+	// UpdatedAt is the time the pull request was last updated
+	UpdatedAt *time.Time `json:"updatedAt"`
 }
 
 func (pr *PullRequest) IsOpen() bool {
 	return pr.State == PullRequestStateOpen
 }
 
+type CommitStatusState string
+
+const (
+	CommitStatusStatePending CommitStatusState = "Pending"
+	CommitStatusStateSuccess CommitStatusState = "Success"
+	CommitStatusStateFailure CommitStatusState = "Failure"
+	CommitStatusStateError   CommitStatusState = "Error"
+)
+
+type CommitStatus struct {
+	// State is the state of the status (one of: Pending, Success, Failure,
+	// Error)
+	State CommitStatusState
+	// Context distinguishes the status from statuses set by other systems, or
+	// by the same system for other purposes
+	Context string
+	// Description is a short, human-readable description of the status
+	Description string
+	// TargetURL is an optional URL linking to more details about the status
+	TargetURL string
+}
+
 type FakeGitProviderService struct {
 	CreatePullRequestFn func(
 		context.Context,
@@ -84,7 +146,10 @@ type FakeGitProviderService struct {
 		context.Context,
 		ListPullRequestOpts,
 	) ([]*PullRequest, error)
-	IsPullRequestMergedFn func(context.Context, int64) (bool, error)
+	IsPullRequestMergedFn  func(context.Context, int64) (bool, error)
+	CommentOnPullRequestFn func(context.Context, int64, string) error
+	ClosePullRequestFn     func(context.Context, int64) error
+	SetCommitStatusFn      func(context.Context, string, CommitStatus) error
 }
 
 func (f *FakeGitProviderService) CreatePullRequest(
@@ -114,3 +179,26 @@ func (f *FakeGitProviderService) IsPullRequestMerged(
 ) (bool, error) {
 	return f.IsPullRequestMergedFn(ctx, number)
 }
+
+func (f *FakeGitProviderService) CommentOnPullRequest(
+	ctx context.Context,
+	number int64,
+	body string,
+) error {
+	return f.CommentOnPullRequestFn(ctx, number, body)
+}
+
+func (f *FakeGitProviderService) ClosePullRequest(
+	ctx context.Context,
+	number int64,
+) error {
+	return f.ClosePullRequestFn(ctx, number)
+}
+
+func (f *FakeGitProviderService) SetCommitStatus(
+	ctx context.Context,
+	sha string,
+	status CommitStatus,
+) error {
+	return f.SetCommitStatusFn(ctx, sha, status)
+}
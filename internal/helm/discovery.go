@@ -0,0 +1,481 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/patrickmn/go-cache"
+
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/metrics"
+)
+
+const (
+	// chartContentMediaType is the media type of the layer of an OCI manifest
+	// that holds a packaged chart.
+	chartContentMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	// chartProvenanceMediaType is the media type of the layer of an OCI manifest
+	// that holds a chart's provenance file.
+	chartProvenanceMediaType = "application/vnd.cncf.helm.chart.provenance.v1.prov"
+	// ociCreatedAnnotation is the annotation of an OCI manifest that records
+	// the time the packaged chart was pushed.
+	ociCreatedAnnotation = "org.opencontainers.image.created"
+
+	// maxChartSize is the maximum size of a packaged chart or provenance file
+	// that will be downloaded for the purpose of verification.
+	maxChartSize = 20 << 20
+)
+
+// chartCache caches the metadata of charts in OCI registries and the outcome
+// of provenance verification, both by digest, so that discovering the same
+// chart versions again does not require downloading them again.
+var chartCache = cache.New(30*time.Minute, time.Hour)
+
+// ChartVersion represents a specific version of a Helm chart.
+type ChartVersion struct {
+	// Version is the version of the chart.
+	Version string
+	// AppVersion is the version of the application the chart deploys, as
+	// specified by the chart's metadata. It may be empty.
+	AppVersion string
+	// Digest is the digest of the chart. For charts in classic chart
+	// repositories, this is the digest of the packaged chart. For charts in OCI
+	// registries, this is the digest of the manifest. It may be empty if the
+	// repository does not provide it.
+	Digest string
+	// CreatedAt is the time the chart was packaged or pushed, if known.
+	CreatedAt *time.Time
+}
+
+// DiscoveryOptions represents options for discovering the versions of a chart
+// using DiscoverCharts.
+type DiscoveryOptions struct {
+	// SemverConstraint is an optional constraint that discovered versions must
+	// satisfy.
+	SemverConstraint string
+	// DiscoveryLimit is an optional limit on the number of versions to
+	// discover. The limit is applied after filtering versions based on the
+	// SemverConstraint and the Keyrings. Metadata and provenance are only
+	// retrieved for as many versions as are needed to reach it. If the limit is
+	// zero, all versions are discovered.
+	DiscoveryLimit int
+	// Keyrings is an optional list of OpenPGP keyrings, in binary or ASCII
+	// armored form. If specified, only versions with a provenance file bearing a
+	// valid signature made with a key from any one of them, and attesting to the
+	// digest of the packaged chart, are discovered.
+	Keyrings [][]byte
+}
+
+// DiscoverCharts connects to the specified Helm chart repository and retrieves
+// the available versions of the specified chart, along with their metadata,
+// in descending order. The repository and chart arguments, as well as the
+// credentials, have the same semantics as for DiscoverChartVersions.
+//
+// The SemverConstraint is applied to the list of available versions before the
+// metadata or provenance of any version is retrieved, and retrieval stops as
+// soon as the DiscoveryLimit is reached. Metadata of charts in OCI registries
+// and the outcome of provenance verification are cached by digest.
+//
+// Versions that fail provenance verification, when it is requested, are
+// skipped. An error is only returned if the repository cannot be reached or if
+// the versions, their metadata, or their provenance cannot be retrieved.
+func DiscoverCharts(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	creds *Credentials,
+	opts *DiscoveryOptions,
+) ([]ChartVersion, error) {
+	if opts == nil {
+		opts = &DiscoveryOptions{}
+	}
+
+	var verifier *provenanceVerifier
+	if len(opts.Keyrings) > 0 {
+		var err error
+		if verifier, err = newProvenanceVerifier(opts.Keyrings); err != nil {
+			return nil, fmt.Errorf("error parsing keyrings: %w", err)
+		}
+	}
+
+	var src chartSource
+	switch {
+	case strings.HasPrefix(repoURL, "http://"), strings.HasPrefix(repoURL, "https://"):
+		src = &classicChartSource{repoURL: repoURL, chart: chart, creds: creds}
+	case strings.HasPrefix(repoURL, "oci://"):
+		src = newOCIChartSource(repoURL, creds)
+	default:
+		return nil, fmt.Errorf("repository URL %q is invalid", repoURL)
+	}
+
+	versions, err := src.versions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving versions of chart %q from repository %q: %w",
+			chart,
+			repoURL,
+			err,
+		)
+	}
+	if versions, err = selectVersions(versions, opts.SemverConstraint); err != nil {
+		return nil, fmt.Errorf(
+			"error filtering versions of chart %q from repository %q: %w",
+			chart,
+			repoURL,
+			err,
+		)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	charts := make([]ChartVersion, 0, len(versions))
+	for _, version := range versions {
+		if opts.DiscoveryLimit > 0 && len(charts) >= opts.DiscoveryLimit {
+			break
+		}
+		c, err := src.chartVersion(ctx, version)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving metadata of version %q of chart %q from repository %q: %w",
+				version,
+				chart,
+				repoURL,
+				err,
+			)
+		}
+		if verifier != nil {
+			if err = verifier.verifyCached(ctx, repoURL, src, c); err != nil {
+				var verr *provenanceError
+				if !errors.As(err, &verr) {
+					return nil, fmt.Errorf(
+						"error verifying version %q of chart %q from repository %q: %w",
+						version,
+						chart,
+						repoURL,
+						err,
+					)
+				}
+				logger.Debug(
+					"ignoring chart version that failed provenance verification",
+					"version", version,
+					"reason", verr.Error(),
+				)
+				continue
+			}
+		}
+		charts = append(charts, c.ChartVersion)
+	}
+	return charts, nil
+}
+
+// chartVersion is a ChartVersion along with the information required to
+// retrieve the packaged chart and its provenance file.
+type chartVersion struct {
+	ChartVersion
+	// fileName is the name of the packaged chart, as recorded in its provenance
+	// file.
+	fileName string
+	// location identifies the packaged chart within the repository it was
+	// retrieved from.
+	location string
+	// provenanceLocation identifies the provenance file of the packaged chart
+	// within the repository it was retrieved from. It is empty if the chart is
+	// known to have no provenance file.
+	provenanceLocation string
+	// contentDigest is the digest the packaged chart is expected to have, if
+	// known.
+	contentDigest string
+}
+
+// chartSource is an interface for retrieving information about the versions
+// of a chart from a specific kind of chart repository.
+type chartSource interface {
+	// versions returns all available versions of the chart.
+	versions(ctx context.Context) ([]string, error)
+	// chartVersion returns the metadata of the specified version of the chart.
+	chartVersion(ctx context.Context, version string) (*chartVersion, error)
+	// fetch retrieves the content at the provided location, as returned by
+	// chartVersion. If the content does not exist, a provenanceError is
+	// returned.
+	fetch(ctx context.Context, location string) ([]byte, error)
+}
+
+// classicChartSource is an implementation of chartSource for classic (HTTP/S)
+// chart repositories.
+type classicChartSource struct {
+	repoURL string
+	chart   string
+	creds   *Credentials
+	// entries caches the index entries of the chart, indexed by version.
+	entries map[string]chartIndexEntry
+}
+
+func (c *classicChartSource) versions(context.Context) ([]string, error) {
+	entries, err := getChartIndexEntries(c.repoURL, c.chart, c.creds)
+	if err != nil {
+		return nil, err
+	}
+	c.entries = make(map[string]chartIndexEntry, len(entries))
+	versions := make([]string, len(entries))
+	for i, entry := range entries {
+		c.entries[entry.Version] = entry
+		versions[i] = entry.Version
+	}
+	return versions, nil
+}
+
+func (c *classicChartSource) chartVersion(_ context.Context, version string) (*chartVersion, error) {
+	entry, ok := c.entries[version]
+	if !ok {
+		return nil, fmt.Errorf("version %q not found in repository index", version)
+	}
+	cv := &chartVersion{
+		ChartVersion: ChartVersion{
+			Version:    entry.Version,
+			AppVersion: entry.AppVersion,
+			CreatedAt:  entry.Created,
+		},
+	}
+	if entry.Digest != "" {
+		cv.Digest = "sha256:" + strings.TrimPrefix(entry.Digest, "sha256:")
+		cv.contentDigest = cv.Digest
+	}
+	if len(entry.URLs) > 0 {
+		chartURL, err := resolveChartURL(c.repoURL, entry.URLs[0])
+		if err != nil {
+			return nil, err
+		}
+		cv.location = chartURL
+		cv.provenanceLocation = chartURL + ".prov"
+		if u, err := url.Parse(chartURL); err == nil {
+			cv.fileName = path.Base(u.Path)
+		}
+	}
+	return cv, nil
+}
+
+func (c *classicChartSource) fetch(ctx context.Context, location string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing HTTP/S request to %q: %w", location, err)
+	}
+	// Only send credentials to the host the repository is served from.
+	if c.creds != nil && sameHost(c.repoURL, location) {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %q: %w", location, err)
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, &provenanceError{reason: fmt.Sprintf("%q not found", location)}
+	case res.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("received unexpected HTTP %d when retrieving %q", res.StatusCode, location)
+	}
+	return readAllLimited(res.Body, location)
+}
+
+// resolveChartURL resolves the provided chart URL, as found in the index of
+// the classic chart repository at repoURL, which may be relative to the
+// repository URL.
+func resolveChartURL(repoURL, chartURL string) (string, error) {
+	base, err := url.Parse(strings.TrimSuffix(repoURL, "/") + "/")
+	if err != nil {
+		return "", fmt.Errorf("error parsing repository URL %q: %w", repoURL, err)
+	}
+	ref, err := url.Parse(chartURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing chart URL %q: %w", chartURL, err)
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+// sameHost returns true if the two provided URLs refer to the same host.
+func sameHost(lhs, rhs string) bool {
+	l, err := url.Parse(lhs)
+	if err != nil {
+		return false
+	}
+	r, err := url.Parse(rhs)
+	if err != nil {
+		return false
+	}
+	return l.Host == r.Host
+}
+
+// ociChartSource is an implementation of chartSource for repositories within
+// OCI registries.
+type ociChartSource struct {
+	repoURL string
+	creds   *Credentials
+	// remoteOptions are the options used for all requests to the registry.
+	remoteOptions []remote.Option
+}
+
+func newOCIChartSource(repoURL string, creds *Credentials) *ociChartSource {
+	auth := authn.Anonymous
+	if creds != nil {
+		auth = &authn.Basic{
+			Username: creds.Username,
+			Password: creds.Password,
+		}
+	}
+	return &ociChartSource{
+		repoURL:       repoURL,
+		creds:         creds,
+		remoteOptions: []remote.Option{remote.WithAuth(auth)},
+	}
+}
+
+func (o *ociChartSource) versions(ctx context.Context) ([]string, error) {
+	return getChartVersionsFromOCIRepo(ctx, o.repoURL, o.creds)
+}
+
+func (o *ociChartSource) chartVersion(ctx context.Context, version string) (*chartVersion, error) {
+	ref, err := name.ParseReference(
+		fmt.Sprintf("%s:%s", strings.TrimPrefix(o.repoURL, "oci://"), version),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing reference to version %q: %w", version, err)
+	}
+	opts := append(o.remoteOptions, remote.WithContext(ctx))
+
+	// Resolve the tag to a digest first, as the metadata of a given digest
+	// never changes. Some registries do not support HEAD requests, in which
+	// case the manifest is retrieved regardless.
+	if head, err := remote.Head(ref, opts...); err == nil {
+		if cv, ok := o.cachedChartVersion(version, head.Digest.String()); ok {
+			return cv, nil
+		}
+	}
+
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest: %w", err)
+	}
+	cv, err := o.chartVersionFromManifest(ctx, ref.Context(), version, desc)
+	if err != nil {
+		return nil, err
+	}
+	chartCache.Set(o.chartVersionCacheKey(version, cv.Digest), *cv, cache.DefaultExpiration)
+	return cv, nil
+}
+
+// cachedChartVersion returns the cached metadata of the specified version of
+// the chart, provided it still refers to the manifest with the provided
+// digest.
+func (o *ociChartSource) cachedChartVersion(version, digest string) (*chartVersion, bool) {
+	if entry, ok := chartCache.Get(o.chartVersionCacheKey(version, digest)); ok {
+		metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheChart)
+		cv := entry.(chartVersion) // nolint: forcetypeassert
+		return &cv, true
+	}
+	metrics.RecordDiscoveryCacheMiss(metrics.DiscoveryCacheChart)
+	return nil, false
+}
+
+// chartVersionCacheKey returns the key under which the metadata of the
+// specified version of the chart, whose manifest has the provided digest, is
+// cached.
+func (o *ociChartSource) chartVersionCacheKey(version, digest string) string {
+	return strings.Join([]string{"metadata", o.repoURL, version, digest}, "\x00")
+}
+
+// chartVersionFromManifest returns the metadata of the specified version of the
+// chart from its manifest and the config blob it references.
+func (o *ociChartSource) chartVersionFromManifest(
+	ctx context.Context,
+	repo name.Repository,
+	version string,
+	desc *remote.Descriptor,
+) (*chartVersion, error) {
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifest: %w", err)
+	}
+	cv := &chartVersion{
+		ChartVersion: ChartVersion{
+			Version: version,
+			Digest:  desc.Digest.String(),
+		},
+	}
+	if created, ok := manifest.Annotations[ociCreatedAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, created); err == nil {
+			cv.CreatedAt = &t
+		}
+	}
+	for _, layer := range manifest.Layers {
+		switch layer.MediaType {
+		case chartContentMediaType:
+			cv.location = repo.Digest(layer.Digest.String()).String()
+			cv.contentDigest = layer.Digest.String()
+		case chartProvenanceMediaType:
+			cv.provenanceLocation = repo.Digest(layer.Digest.String()).String()
+		}
+	}
+
+	rawConfig, err := o.fetch(ctx, repo.Digest(manifest.Config.Digest.String()).String())
+	if err != nil {
+		return nil, fmt.Errorf("error getting chart metadata: %w", err)
+	}
+	metadata := struct {
+		Name       string `json:"name"`
+		AppVersion string `json:"appVersion"`
+	}{}
+	if err = json.Unmarshal(rawConfig, &metadata); err != nil {
+		return nil, fmt.Errorf("error parsing chart metadata: %w", err)
+	}
+	cv.AppVersion = metadata.AppVersion
+	cv.fileName = fmt.Sprintf("%s-%s.tgz", metadata.Name, version)
+	return cv, nil
+}
+
+func (o *ociChartSource) fetch(ctx context.Context, location string) ([]byte, error) {
+	digest, err := name.NewDigest(location)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing reference %q: %w", location, err)
+	}
+	opts := append(o.remoteOptions, remote.WithContext(ctx))
+	layer, err := remote.Layer(digest, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting blob %q: %w", location, err)
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		var te *transport.Error
+		if errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
+			return nil, &provenanceError{reason: fmt.Sprintf("%q not found", location)}
+		}
+		return nil, fmt.Errorf("error getting blob %q: %w", location, err)
+	}
+	defer rc.Close()
+	return readAllLimited(rc, location)
+}
+
+// readAllLimited reads all content from the provided reader, returning an
+// error if it exceeds maxChartSize.
+func readAllLimited(r io.Reader, location string) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxChartSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %q: %w", location, err)
+	}
+	if len(data) > maxChartSize {
+		return nil, fmt.Errorf("%q exceeds maximum size of %d bytes", location, maxChartSize)
+	}
+	return data, nil
+}
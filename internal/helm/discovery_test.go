@@ -0,0 +1,334 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"           // nolint: staticcheck
+	"golang.org/x/crypto/openpgp/clearsign" // nolint: staticcheck
+	"k8s.io/utils/ptr"
+)
+
+func TestDiscoverCharts(t *testing.T) {
+	signer, keyring := newTestKeyring(t)
+	_, otherKeyring := newTestKeyring(t)
+
+	charts := map[string][]byte{
+		"fake-chart-1.0.0.tgz": []byte("fake chart 1.0.0"),
+		"fake-chart-1.1.0.tgz": []byte("fake chart 1.1.0"),
+		"fake-chart-1.2.0.tgz": []byte("fake chart 1.2.0"),
+	}
+	provs := map[string][]byte{
+		"fake-chart-1.0.0.tgz.prov": signProvenance(t, signer, "fake-chart-1.0.0.tgz", charts["fake-chart-1.0.0.tgz"]),
+		// The provenance of 1.1.0 attests to different content
+		"fake-chart-1.1.0.tgz.prov": signProvenance(t, signer, "fake-chart-1.1.0.tgz", []byte("tampered")),
+		// 1.2.0 has no provenance
+	}
+
+	// Count the requests for each file, to assert on what is retrieved
+	var requestsMu sync.Mutex
+	requests := map[string]int{}
+	requestCount := func(file string) int {
+		requestsMu.Lock()
+		defer requestsMu.Unlock()
+		return requests[file]
+	}
+
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				file := strings.TrimPrefix(r.URL.Path, "/fake-repo/")
+				requestsMu.Lock()
+				requests[file]++
+				requestsMu.Unlock()
+				if file == "index.yaml" {
+					_, _ = w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+      appVersion: v1.0.0
+      digest: ` + sha256Hex(charts["fake-chart-1.0.0.tgz"]) + `
+      created: "2024-01-01T00:00:00Z"
+      urls:
+        - fake-chart-1.0.0.tgz
+    - version: 1.1.0
+      appVersion: v1.1.0
+      urls:
+        - fake-chart-1.1.0.tgz
+    - version: 1.2.0
+      appVersion: v1.2.0
+      urls:
+        - charts/../fake-chart-1.2.0.tgz
+    - version: not-semver
+`))
+					return
+				}
+				if content, ok := charts[file]; ok {
+					_, _ = w.Write(content)
+					return
+				}
+				if content, ok := provs[file]; ok {
+					_, _ = w.Write(content)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+		),
+	)
+	defer testServer.Close()
+	testRepoURL := testServer.URL + "/fake-repo"
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		opts       *DiscoveryOptions
+		assertions func(*testing.T, []ChartVersion, error)
+	}{
+		{
+			name:    "invalid repository URL",
+			repoURL: "ftp://example.com",
+			assertions: func(t *testing.T, _ []ChartVersion, err error) {
+				require.ErrorContains(t, err, "is invalid")
+			},
+		},
+		{
+			name:    "invalid keyring",
+			repoURL: testRepoURL,
+			opts:    &DiscoveryOptions{Keyrings: [][]byte{[]byte("not a keyring")}},
+			assertions: func(t *testing.T, _ []ChartVersion, err error) {
+				require.ErrorContains(t, err, "error parsing keyrings")
+			},
+		},
+		{
+			name:    "without verification",
+			repoURL: testRepoURL,
+			assertions: func(t *testing.T, versions []ChartVersion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]ChartVersion{
+						{Version: "1.2.0", AppVersion: "v1.2.0"},
+						{Version: "1.1.0", AppVersion: "v1.1.0"},
+						{
+							Version:    "1.0.0",
+							AppVersion: "v1.0.0",
+							Digest:     "sha256:" + sha256Hex(charts["fake-chart-1.0.0.tgz"]),
+							CreatedAt:  ptr.To(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+					versions,
+				)
+			},
+		},
+		{
+			name:    "with semver constraint and discovery limit",
+			repoURL: testRepoURL,
+			opts: &DiscoveryOptions{
+				SemverConstraint: "<1.2.0",
+				DiscoveryLimit:   1,
+			},
+			assertions: func(t *testing.T, versions []ChartVersion, err error) {
+				require.NoError(t, err)
+				require.Len(t, versions, 1)
+				require.Equal(t, "1.1.0", versions[0].Version)
+			},
+		},
+		{
+			name:    "with verification",
+			repoURL: testRepoURL,
+			opts:    &DiscoveryOptions{Keyrings: [][]byte{otherKeyring, keyring}},
+			assertions: func(t *testing.T, versions []ChartVersion, err error) {
+				require.NoError(t, err)
+				// Only 1.0.0 has a valid provenance file
+				require.Len(t, versions, 1)
+				require.Equal(t, "1.0.0", versions[0].Version)
+			},
+		},
+		{
+			name:    "with verification of versions verified before",
+			repoURL: testRepoURL,
+			opts:    &DiscoveryOptions{Keyrings: [][]byte{otherKeyring, keyring}},
+			assertions: func(t *testing.T, versions []ChartVersion, err error) {
+				require.NoError(t, err)
+				require.Len(t, versions, 1)
+				require.Equal(t, "1.0.0", versions[0].Version)
+				// The outcome for 1.0.0 is cached by digest, whereas 1.1.0 has
+				// no digest in the index and is verified again
+				require.Equal(t, 1, requestCount("fake-chart-1.0.0.tgz"))
+				require.Equal(t, 1, requestCount("fake-chart-1.0.0.tgz.prov"))
+				require.Equal(t, 2, requestCount("fake-chart-1.1.0.tgz.prov"))
+			},
+		},
+		{
+			name:    "with semver constraint, discovery limit, and verification",
+			repoURL: testRepoURL,
+			opts: &DiscoveryOptions{
+				SemverConstraint: ">=1.1.0",
+				DiscoveryLimit:   1,
+				Keyrings:         [][]byte{keyring},
+			},
+			assertions: func(t *testing.T, versions []ChartVersion, err error) {
+				require.NoError(t, err)
+				// 1.2.0 has no provenance and 1.1.0 was tampered with
+				require.Empty(t, versions)
+				// 1.0.0 does not satisfy the constraint and is never retrieved
+				require.Equal(t, 1, requestCount("fake-chart-1.0.0.tgz"))
+				require.Equal(t, 1, requestCount("fake-chart-1.0.0.tgz.prov"))
+			},
+		},
+		{
+			name:    "with verification using untrusted keyring",
+			repoURL: testRepoURL,
+			opts:    &DiscoveryOptions{Keyrings: [][]byte{otherKeyring}},
+			assertions: func(t *testing.T, versions []ChartVersion, err error) {
+				require.NoError(t, err)
+				require.Empty(t, versions)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			versions, err := DiscoverCharts(
+				context.Background(),
+				testCase.repoURL,
+				"fake-chart",
+				nil,
+				testCase.opts,
+			)
+			testCase.assertions(t, versions, err)
+		})
+	}
+}
+
+func TestOCIChartSource(t *testing.T) {
+	signer, keyring := newTestKeyring(t)
+
+	registry := httptest.NewServer(ociregistry.New())
+	defer registry.Close()
+	repoURL := fmt.Sprintf("oci://%s/charts/fake-chart", strings.TrimPrefix(registry.URL, "http://"))
+
+	chart := []byte("fake chart 1.0.0")
+	prov := signProvenance(t, signer, "fake-chart-1.0.0.tgz", chart)
+	config, err := json.Marshal(map[string]string{
+		"name":       "fake-chart",
+		"version":    "1.0.0",
+		"appVersion": "v1.0.0",
+	})
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(strings.TrimPrefix(repoURL, "oci://") + ":1.0.0")
+	require.NoError(t, err)
+	manifest := v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		Annotations: map[string]string{
+			ociCreatedAnnotation: "2024-01-01T00:00:00Z",
+		},
+	}
+	for i, blob := range []struct {
+		content   []byte
+		mediaType types.MediaType
+	}{
+		{config, "application/vnd.cncf.helm.config.v1+json"},
+		{chart, chartContentMediaType},
+		{prov, chartProvenanceMediaType},
+	} {
+		layer := static.NewLayer(blob.content, blob.mediaType)
+		require.NoError(t, remote.WriteLayer(ref.Context(), layer))
+		desc := v1.Descriptor{
+			MediaType: blob.mediaType,
+			Size:      int64(len(blob.content)),
+		}
+		desc.Digest, err = layer.Digest()
+		require.NoError(t, err)
+		if i == 0 {
+			manifest.Config = desc
+		} else {
+			manifest.Layers = append(manifest.Layers, desc)
+		}
+	}
+	rawManifest, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, remote.Put(ref, testManifest(rawManifest)))
+
+	src := newOCIChartSource(repoURL, nil)
+	cv, err := src.chartVersion(context.Background(), "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", cv.Version)
+	require.Equal(t, "v1.0.0", cv.AppVersion)
+	require.Equal(t, "sha256:"+sha256Hex(rawManifest), cv.Digest)
+	require.Equal(t, ptr.To(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), cv.CreatedAt)
+	require.Equal(t, "fake-chart-1.0.0.tgz", cv.fileName)
+
+	verifier, err := newProvenanceVerifier([][]byte{keyring})
+	require.NoError(t, err)
+	require.NoError(t, verifier.verify(context.Background(), src, cv))
+
+	// The metadata is cached by digest
+	_, ok := src.cachedChartVersion("1.0.0", cv.Digest)
+	require.True(t, ok)
+	cached, err := src.chartVersion(context.Background(), "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, cv, cached)
+}
+
+// testManifest is a raw OCI image manifest that can be pushed to a registry.
+type testManifest []byte
+
+func (m testManifest) RawManifest() ([]byte, error) {
+	return m, nil
+}
+
+func (m testManifest) MediaType() (types.MediaType, error) {
+	return types.OCIManifestSchema1, nil
+}
+
+// newTestKeyring returns a new OpenPGP entity and a binary keyring holding
+// its public key.
+func newTestKeyring(t *testing.T) (*openpgp.Entity, []byte) {
+	entity, err := openpgp.NewEntity("Kargo Test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, entity.Serialize(buf))
+	return entity, buf.Bytes()
+}
+
+// signProvenance returns a provenance file for a chart with the provided
+// file name and content, signed by the provided entity, in the format produced
+// by `helm package --sign`.
+func signProvenance(t *testing.T, signer *openpgp.Entity, fileName string, content []byte) []byte {
+	buf := &bytes.Buffer{}
+	w, err := clearsign.Encode(buf, signer.PrivateKey, nil)
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(
+		w,
+		"name: fake-chart\n\n...\nfiles:\n  %s: sha256:%s\n",
+		fileName,
+		sha256Hex(content),
+	)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"gopkg.in/yaml.v3"
@@ -62,19 +63,30 @@ func DiscoverChartVersions(
 		)
 	}
 
+	if versions, err = selectVersions(versions, semverConstraint); err != nil {
+		return nil, fmt.Errorf(
+			"error filtering versions of chart %q from repository %q: %w",
+			chart,
+			repoURL,
+			err,
+		)
+	}
+	return versions, nil
+}
+
+// selectVersions returns those of the provided versions that are valid
+// semantic versions satisfying the provided semver constraint, if any, in
+// descending order.
+func selectVersions(versions []string, semverConstraint string) ([]string, error) {
 	semvers := versionsToSemVerCollection(versions)
 	if len(semvers) == 0 {
 		return nil, nil
 	}
 
 	if semverConstraint != "" {
+		var err error
 		if semvers, err = filterSemVers(semvers, semverConstraint); err != nil {
-			return nil, fmt.Errorf(
-				"error filtering versions of chart %q from repository %q: %w",
-				chart,
-				repoURL,
-				err,
-			)
+			return nil, err
 		}
 	}
 
@@ -102,6 +114,37 @@ func getChartVersionsFromClassicRepo(
 	chart string,
 	creds *Credentials,
 ) ([]string, error) {
+	entries, err := getChartIndexEntries(repoURL, chart, creds)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]string, len(entries))
+	for i, entry := range entries {
+		versions[i] = entry.Version
+	}
+	return versions, nil
+}
+
+// chartIndexEntry is an entry for a single version of a chart in the index of
+// a classic chart repository.
+type chartIndexEntry struct {
+	Version    string     `yaml:"version,omitempty"`
+	AppVersion string     `yaml:"appVersion,omitempty"`
+	Digest     string     `yaml:"digest,omitempty"`
+	Created    *time.Time `yaml:"created,omitempty"`
+	URLs       []string   `yaml:"urls,omitempty"`
+}
+
+// getChartIndexEntries connects to the classic (HTTP/S) chart repository
+// specified by repoURL and retrieves the index entries for all available
+// versions of the specified chart. The provided repoURL MUST begin with
+// protocol http:// or https://. Provided credentials may be nil for public
+// repositories, but must be non-nil for private repositories.
+func getChartIndexEntries(
+	repoURL string,
+	chart string,
+	creds *Credentials,
+) ([]chartIndexEntry, error) {
 	indexURL := fmt.Sprintf("%s/index.yaml", strings.TrimSuffix(repoURL, "/"))
 	req, err := http.NewRequest(http.MethodGet, indexURL, nil)
 	if err != nil {
@@ -127,22 +170,12 @@ func getChartVersionsFromClassicRepo(
 		return nil, fmt.Errorf("error reading repository index from %q: %w", indexURL, err)
 	}
 	index := struct {
-		Entries map[string][]struct {
-			Version string `json:"version,omitempty"`
-		} `json:"entries,omitempty"`
+		Entries map[string][]chartIndexEntry `json:"entries,omitempty"`
 	}{}
 	if err = yaml.Unmarshal(resBodyBytes, &index); err != nil {
 		return nil, fmt.Errorf("error unmarshaling repository index from %q: %w", indexURL, err)
 	}
-	entries, ok := index.Entries[chart]
-	if !ok {
-		return nil, nil
-	}
-	versions := make([]string, len(entries))
-	for i, entry := range entries {
-		versions[i] = entry.Version
-	}
-	return versions, nil
+	return index.Entries[chart], nil
 }
 
 // getChartVersionsFromOCIRepo connects to the OCI repository specified by
@@ -0,0 +1,169 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/crypto/openpgp"           // nolint: staticcheck
+	"golang.org/x/crypto/openpgp/clearsign" // nolint: staticcheck
+	"sigs.k8s.io/yaml"
+
+	"github.com/akuity/kargo/internal/metrics"
+)
+
+// provenanceError is returned when a chart fails provenance verification, as
+// opposed to when its provenance cannot be retrieved.
+type provenanceError struct {
+	reason string
+}
+
+// Error implements error.
+func (p *provenanceError) Error() string {
+	return p.reason
+}
+
+// provenanceVerifier verifies the provenance files of charts in the same
+// manner as `helm verify`.
+type provenanceVerifier struct {
+	keyring openpgp.EntityList
+	// fingerprint identifies the keyrings the verifier was created from, so
+	// that cached verification outcomes are only reused for the same keys.
+	fingerprint string
+}
+
+// newProvenanceVerifier returns a provenanceVerifier that trusts the keys in
+// the provided keyrings, each of which may be in binary or ASCII armored form.
+func newProvenanceVerifier(keyrings [][]byte) (*provenanceVerifier, error) {
+	v := &provenanceVerifier{}
+	h := sha256.New()
+	for i, keyring := range keyrings {
+		_, _ = fmt.Fprintf(h, "%d:", len(keyring))
+		_, _ = h.Write(keyring)
+		var entities openpgp.EntityList
+		var err error
+		if bytes.Contains(keyring, []byte("-----BEGIN PGP")) {
+			entities, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(keyring))
+		} else {
+			entities, err = openpgp.ReadKeyRing(bytes.NewReader(keyring))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading keyring %d: %w", i, err)
+		}
+		v.keyring = append(v.keyring, entities...)
+	}
+	if len(v.keyring) == 0 {
+		return nil, errors.New("keyrings hold no keys")
+	}
+	v.fingerprint = hex.EncodeToString(h.Sum(nil))
+	return v, nil
+}
+
+// verifyCached behaves like verify, but reuses the outcome of any previous
+// verification of the chart version with the same digest in the repository at
+// repoURL. Only definitive outcomes are cached; errors retrieving the packaged
+// chart or its provenance file are not.
+func (p *provenanceVerifier) verifyCached(
+	ctx context.Context,
+	repoURL string,
+	src chartSource,
+	cv *chartVersion,
+) error {
+	if cv.Digest == "" {
+		return p.verify(ctx, src, cv)
+	}
+	key := strings.Join([]string{"provenance", repoURL, cv.Digest, p.fingerprint}, "\x00")
+	if entry, ok := chartCache.Get(key); ok {
+		metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheChart)
+		if reason := entry.(string); reason != "" { // nolint: forcetypeassert
+			return &provenanceError{reason: reason}
+		}
+		return nil
+	}
+	metrics.RecordDiscoveryCacheMiss(metrics.DiscoveryCacheChart)
+	err := p.verify(ctx, src, cv)
+	if err == nil {
+		chartCache.Set(key, "", cache.DefaultExpiration)
+		return nil
+	}
+	var verr *provenanceError
+	if errors.As(err, &verr) {
+		chartCache.Set(key, verr.reason, cache.DefaultExpiration)
+	}
+	return err
+}
+
+// verify retrieves the packaged chart and provenance file of the provided
+// chart version from the provided source and verifies that the provenance file
+// bears a valid signature made with a trusted key and attests to the digest of
+// the packaged chart. A provenanceError is returned if verification fails.
+func (p *provenanceVerifier) verify(ctx context.Context, src chartSource, cv *chartVersion) error {
+	if cv.location == "" {
+		return &provenanceError{reason: "packaged chart location is unknown"}
+	}
+	if cv.provenanceLocation == "" {
+		return &provenanceError{reason: "chart has no provenance file"}
+	}
+	prov, err := src.fetch(ctx, cv.provenanceLocation)
+	if err != nil {
+		return err
+	}
+	block, _ := clearsign.Decode(prov)
+	if block == nil {
+		return &provenanceError{reason: "provenance file holds no signature block"}
+	}
+	if _, err = openpgp.CheckDetachedSignature(
+		p.keyring,
+		bytes.NewReader(block.Bytes),
+		block.ArmoredSignature.Body,
+	); err != nil {
+		return &provenanceError{reason: fmt.Sprintf("invalid signature: %s", err)}
+	}
+
+	// The signed message consists of the chart's metadata and the digests of
+	// files, separated by a YAML document end marker.
+	parts := bytes.SplitN(block.Plaintext, []byte("\n...\n"), 2)
+	if len(parts) < 2 {
+		return &provenanceError{reason: "provenance file holds no digests"}
+	}
+	sums := struct {
+		Files map[string]string `json:"files"`
+	}{}
+	if err = yaml.Unmarshal(parts[1], &sums); err != nil {
+		return &provenanceError{reason: fmt.Sprintf("error parsing digests: %s", err)}
+	}
+	expected, ok := sums.Files[cv.fileName]
+	if !ok {
+		return &provenanceError{
+			reason: fmt.Sprintf("provenance file holds no digest for %q", cv.fileName),
+		}
+	}
+
+	chart, err := src.fetch(ctx, cv.location)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(chart)
+	actual := "sha256:" + hex.EncodeToString(sum[:])
+	if actual != expected {
+		return &provenanceError{
+			reason: fmt.Sprintf("digest of %q does not match: %q != %q", cv.fileName, expected, actual),
+		}
+	}
+	// Cached outcomes are keyed by digest, so the packaged chart must also be
+	// the one the repository claims it is.
+	if cv.contentDigest != "" && actual != cv.contentDigest {
+		return &provenanceError{
+			reason: fmt.Sprintf(
+				"digest of %q does not match the repository: %q != %q",
+				cv.fileName, cv.contentDigest, actual,
+			),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	libSemver "github.com/akuity/kargo/internal/controller/semver"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// Artifact is a representation of a generic (i.e. non-image) artifact stored
+// in an OCI registry.
+type Artifact struct {
+	Tag    string
+	Digest string
+	// ArtifactType is the artifactType of the artifact's manifest or, if that is
+	// not set, the media type of the artifact's config.
+	ArtifactType string
+	// Annotations are the annotations of the artifact's manifest.
+	Annotations map[string]string
+}
+
+// ArtifactSelector is an interface for selecting generic artifacts from a
+// repository within an OCI registry.
+type ArtifactSelector interface {
+	// Select selects artifacts from a repository within an OCI registry.
+	Select(context.Context) ([]Artifact, error)
+}
+
+// ArtifactSelectorOptions represents options for creating an ArtifactSelector.
+type ArtifactSelectorOptions struct {
+	// StrictSemvers, when set to true, will cause only tags containing ALL of
+	// the major, minor, and patch version components to be counted as valid
+	// semantic versions when using SelectionStrategySemVer.
+	StrictSemvers bool
+	// Constraint specifies strategy-specific constraints on artifact selection.
+	// For SelectionStrategySemVer, this is an optional semantic version
+	// constraint. For SelectionStrategyDigest, this is the required name of the
+	// tag whose digest should be selected.
+	Constraint string
+	// AllowRegex is an optional regular expression that can be used to constrain
+	// artifact selection based on eligible tags.
+	AllowRegex string
+	allowRegex *regexp.Regexp
+	// Ignore is an optional list of tags that should explicitly be ignored when
+	// selecting artifacts.
+	Ignore []string
+	// ArtifactType is an optional artifact type that selected artifacts must
+	// have.
+	ArtifactType string
+	// Annotations is an optional set of annotations that the manifests of
+	// selected artifacts must ALL carry with exactly the specified values.
+	Annotations map[string]string
+	// Creds holds optional credentials for authenticating to the repository.
+	Creds *Credentials
+	// InsecureSkipTLSVerify is an optional flag, that if set to true, will
+	// disable verification of the repository's TLS certificate.
+	InsecureSkipTLSVerify bool
+	// DiscoveryLimit is an optional limit on the number of artifacts that can be
+	// discovered by the ArtifactSelector. The limit is applied after filtering
+	// artifacts based on all other options. If the limit is zero, all
+	// discovered artifacts will be returned.
+	DiscoveryLimit int
+}
+
+// artifactSelector implements the ArtifactSelector interface.
+type artifactSelector struct {
+	repoClient *repositoryClient
+	strategy   SelectionStrategy
+	opts       ArtifactSelectorOptions
+	constraint *semver.Constraints
+}
+
+// NewArtifactSelector returns an implementation of the ArtifactSelector
+// interface that selects generic artifacts from a repository within an OCI
+// registry based on a selection strategy and a set of optional constraints.
+// SelectionStrategyNewestBuild is not supported because generic artifacts do
+// not carry a reliable build timestamp.
+func NewArtifactSelector(
+	repoURL string,
+	strategy SelectionStrategy,
+	opts *ArtifactSelectorOptions,
+) (ArtifactSelector, error) {
+	if opts == nil {
+		opts = &ArtifactSelectorOptions{}
+	}
+
+	s := &artifactSelector{strategy: strategy}
+
+	switch strategy {
+	case SelectionStrategyDigest:
+		if opts.Constraint == "" {
+			return nil, errors.New("digest selection strategy requires a constraint")
+		}
+	case SelectionStrategyLexical:
+	case SelectionStrategySemVer, "":
+		s.strategy = SelectionStrategySemVer
+		if opts.Constraint != "" {
+			var err error
+			if s.constraint, err = semver.NewConstraint(opts.Constraint); err != nil {
+				return nil, fmt.Errorf(
+					"error parsing semver constraint %q: %w",
+					opts.Constraint,
+					err,
+				)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid artifact selection strategy %q", strategy)
+	}
+
+	if opts.AllowRegex != "" {
+		var err error
+		if opts.allowRegex, err = regexp.Compile(opts.AllowRegex); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				opts.AllowRegex,
+				err,
+			)
+		}
+	}
+
+	repoClient, err := newRepositoryClient(repoURL, opts.InsecureSkipTLSVerify, opts.Creds)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error creating repository client for artifact %q: %w",
+			repoURL,
+			err,
+		)
+	}
+	s.repoClient = repoClient
+	s.opts = *opts
+
+	return s, nil
+}
+
+// Select implements the ArtifactSelector interface.
+func (s *artifactSelector) Select(ctx context.Context) ([]Artifact, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"registry", s.repoClient.registry.name,
+		"artifact", s.repoClient.repoURL,
+		"selectionStrategy", s.strategy,
+		"discoveryLimit", s.opts.DiscoveryLimit,
+	)
+	logger.Trace("discovering artifacts")
+
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	tags, err := s.selectTags(ctx)
+	if err != nil || len(tags) == 0 {
+		return nil, err
+	}
+
+	limit := s.opts.DiscoveryLimit
+	if limit == 0 || limit > len(tags) {
+		limit = len(tags)
+	}
+	artifacts := make([]Artifact, 0, limit)
+
+	for _, tag := range tags {
+		if len(artifacts) >= limit {
+			break
+		}
+
+		artifact, err := s.repoClient.getArtifactByTag(ctx, tag)
+		if err != nil {
+			var te *transport.Error
+			if s.strategy == SelectionStrategyDigest &&
+				errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
+				logger.Trace("found no artifact with tag", "tag", tag)
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error retrieving artifact with tag %q: %w", tag, err)
+		}
+		if !s.matches(artifact) {
+			logger.Trace(
+				"artifact was found, but did not match type or annotation constraints",
+				"tag", tag,
+			)
+			continue
+		}
+
+		logger.Trace(
+			"discovered artifact",
+			"tag", artifact.Tag,
+			"digest", artifact.Digest,
+		)
+		artifacts = append(artifacts, *artifact)
+	}
+
+	if len(artifacts) == 0 {
+		logger.Trace("no artifacts matched criteria")
+		return nil, nil
+	}
+
+	logger.Trace(
+		"discovered artifacts",
+		"count", len(artifacts),
+	)
+	return artifacts, nil
+}
+
+// selectTags returns the tags of all candidate artifacts, in order of
+// preference, according to the selection strategy. If no tags match the
+// criteria, nil is returned.
+func (s *artifactSelector) selectTags(ctx context.Context) ([]string, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	if s.strategy == SelectionStrategyDigest {
+		return []string{s.opts.Constraint}, nil
+	}
+
+	tags, err := s.repoClient.getTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	if len(tags) == 0 {
+		logger.Trace("found no tags")
+		return nil, nil
+	}
+	logger.Trace("got all tags")
+
+	if s.strategy == SelectionStrategySemVer {
+		images := make([]Image, 0, len(tags))
+		for _, tag := range tags {
+			if !allowsTag(tag, s.opts.allowRegex) || ignoresTag(tag, s.opts.Ignore) {
+				continue
+			}
+			sv := libSemver.Parse(tag, s.opts.StrictSemvers)
+			if sv == nil {
+				continue
+			}
+			if s.constraint != nil && !s.constraint.Check(sv) {
+				continue
+			}
+			images = append(images, Image{Tag: tag, semVer: sv})
+		}
+		if len(images) == 0 {
+			logger.Trace("no tags matched criteria")
+			return nil, nil
+		}
+		logger.Trace(
+			"tags matched criteria",
+			"count", len(images),
+		)
+		logger.Trace("sorting tags by semantic version")
+		sortImagesBySemVer(images)
+		tags = make([]string, len(images))
+		for i, image := range images {
+			tags[i] = image.Tag
+		}
+		return tags, nil
+	}
+
+	matchedTags := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if allowsTag(tag, s.opts.allowRegex) && !ignoresTag(tag, s.opts.Ignore) {
+			matchedTags = append(matchedTags, tag)
+		}
+	}
+	if len(matchedTags) == 0 {
+		logger.Trace("no tags matched criteria")
+		return nil, nil
+	}
+	logger.Trace(
+		"tags matched criteria",
+		"count", len(matchedTags),
+	)
+	logger.Trace("sorting tags lexically")
+	sortTagsLexically(matchedTags)
+	return matchedTags, nil
+}
+
+// matches returns true if the given Artifact satisfies the type and annotation
+// constraints of the artifactSelector. It returns false otherwise.
+func (s *artifactSelector) matches(artifact *Artifact) bool {
+	if s.opts.ArtifactType != "" && artifact.ArtifactType != s.opts.ArtifactType {
+		return false
+	}
+	for key, value := range s.opts.Annotations {
+		if v, ok := artifact.Annotations[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
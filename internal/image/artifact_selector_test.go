@@ -0,0 +1,261 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testArtifactType = "application/vnd.cncf.flux.config.v1+json"
+
+func TestNewArtifactSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		strategy   SelectionStrategy
+		opts       *ArtifactSelectorOptions
+		assertions func(*testing.T, ArtifactSelector, error)
+	}{
+		{
+			name:     "digest strategy without constraint",
+			strategy: SelectionStrategyDigest,
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, "digest selection strategy requires a constraint")
+			},
+		},
+		{
+			name:     "invalid semver constraint",
+			strategy: SelectionStrategySemVer,
+			opts:     &ArtifactSelectorOptions{Constraint: "invalid"},
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name:     "invalid allow regex",
+			strategy: SelectionStrategyLexical,
+			opts:     &ArtifactSelectorOptions{AllowRegex: "("},
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name:     "unsupported strategy",
+			strategy: SelectionStrategyNewestBuild,
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, "invalid artifact selection strategy")
+			},
+		},
+		{
+			name: "default strategy",
+			assertions: func(t *testing.T, s ArtifactSelector, err error) {
+				require.NoError(t, err)
+				as, ok := s.(*artifactSelector)
+				require.True(t, ok)
+				require.Equal(t, SelectionStrategySemVer, as.strategy)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewArtifactSelector("example.com/artifact", testCase.strategy, testCase.opts)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestNewArtifactFromManifest(t *testing.T) {
+	testCases := []struct {
+		name       string
+		manifest   string
+		assertions func(*testing.T, *Artifact, error)
+	}{
+		{
+			name:     "invalid manifest",
+			manifest: "{",
+			assertions: func(t *testing.T, _ *Artifact, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "artifact type from manifest",
+			manifest: `{
+				"artifactType": "application/vnd.example+type",
+				"config": {"mediaType": "application/vnd.oci.empty.v1+json"},
+				"annotations": {"foo": "bar"}
+			}`,
+			assertions: func(t *testing.T, artifact *Artifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "application/vnd.example+type", artifact.ArtifactType)
+				require.Equal(t, map[string]string{"foo": "bar"}, artifact.Annotations)
+			},
+		},
+		{
+			name:     "artifact type from config",
+			manifest: `{"config": {"mediaType": "application/vnd.cncf.flux.config.v1+json"}}`,
+			assertions: func(t *testing.T, artifact *Artifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testArtifactType, artifact.ArtifactType)
+				require.Empty(t, artifact.Annotations)
+			},
+		},
+		{
+			name:     "index without artifact type",
+			manifest: `{"manifests": []}`,
+			assertions: func(t *testing.T, artifact *Artifact, err error) {
+				require.NoError(t, err)
+				require.Empty(t, artifact.ArtifactType)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			artifact, err := newArtifactFromManifest([]byte(testCase.manifest))
+			testCase.assertions(t, artifact, err)
+		})
+	}
+}
+
+func TestArtifactSelectorSelect(t *testing.T) {
+	srv := httptest.NewServer(ociregistry.New(ociregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(srv.Close)
+	repoURL := fmt.Sprintf("%s/test/artifact", strings.TrimPrefix(srv.URL, "http://"))
+
+	v100 := pushTestArtifact(t, repoURL, "v1.0.0", testArtifactType, map[string]string{"env": "prod"})
+	v110 := pushTestArtifact(t, repoURL, "v1.1.0", testArtifactType, map[string]string{"env": "dev"})
+	v120 := pushTestArtifact(t, repoURL, "v1.2.0", "application/vnd.example.other+json", nil)
+	latest := pushTestArtifact(t, repoURL, "latest", testArtifactType, nil)
+
+	testCases := []struct {
+		name       string
+		strategy   SelectionStrategy
+		opts       ArtifactSelectorOptions
+		assertions func(*testing.T, []Artifact, error)
+	}{
+		{
+			name:     "semver",
+			strategy: SelectionStrategySemVer,
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 3)
+				require.Equal(t, "v1.2.0", artifacts[0].Tag)
+				require.Equal(t, v120, artifacts[0].Digest)
+				require.Equal(t, "v1.1.0", artifacts[1].Tag)
+				require.Equal(t, "v1.0.0", artifacts[2].Tag)
+			},
+		},
+		{
+			name:     "semver with constraint and discovery limit",
+			strategy: SelectionStrategySemVer,
+			opts: ArtifactSelectorOptions{
+				Constraint:     "<1.2.0",
+				DiscoveryLimit: 1,
+			},
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 1)
+				require.Equal(t, v110, artifacts[0].Digest)
+			},
+		},
+		{
+			name:     "lexical with artifact type",
+			strategy: SelectionStrategyLexical,
+			opts: ArtifactSelectorOptions{
+				ArtifactType: testArtifactType,
+				Ignore:       []string{"v1.1.0"},
+			},
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 2)
+				require.Equal(t, "v1.0.0", artifacts[0].Tag)
+				require.Equal(t, v100, artifacts[0].Digest)
+				require.Equal(t, testArtifactType, artifacts[0].ArtifactType)
+				require.Equal(t, "latest", artifacts[1].Tag)
+			},
+		},
+		{
+			name:     "semver with annotations",
+			strategy: SelectionStrategySemVer,
+			opts: ArtifactSelectorOptions{
+				Annotations: map[string]string{"env": "prod"},
+			},
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 1)
+				require.Equal(t, v100, artifacts[0].Digest)
+			},
+		},
+		{
+			name:     "no matches",
+			strategy: SelectionStrategySemVer,
+			opts: ArtifactSelectorOptions{
+				ArtifactType: "application/vnd.example.missing+json",
+			},
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Nil(t, artifacts)
+			},
+		},
+		{
+			name:     "digest",
+			strategy: SelectionStrategyDigest,
+			opts:     ArtifactSelectorOptions{Constraint: "latest"},
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 1)
+				require.Equal(t, latest, artifacts[0].Digest)
+			},
+		},
+		{
+			name:     "digest with missing tag",
+			strategy: SelectionStrategyDigest,
+			opts:     ArtifactSelectorOptions{Constraint: "missing"},
+			assertions: func(t *testing.T, artifacts []Artifact, err error) {
+				require.NoError(t, err)
+				require.Nil(t, artifacts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewArtifactSelector(repoURL, testCase.strategy, &testCase.opts)
+			require.NoError(t, err)
+			artifacts, err := s.Select(context.Background())
+			testCase.assertions(t, artifacts, err)
+		})
+	}
+}
+
+func pushTestArtifact(
+	t *testing.T,
+	repoURL string,
+	tag string,
+	artifactType string,
+	annotations map[string]string,
+) string {
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+	img = mutate.MediaType(img, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, types.MediaType(artifactType))
+	if annotations != nil {
+		img = mutate.Annotations(img, annotations).(v1.Image) // nolint: forcetypeassert
+	}
+	ref, err := name.ParseReference(fmt.Sprintf("%s:%s", repoURL, tag))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
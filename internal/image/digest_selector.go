@@ -38,6 +38,7 @@ func (d *digestSelector) Select(ctx context.Context) ([]Image, error) {
 		"selectionStrategy", SelectionStrategyDigest,
 		"tag", tag,
 		"platformConstrained", d.opts.platform != nil,
+		"metadataConstrained", d.opts.metadata != nil,
 	)
 	logger.Trace("selecting image")
 
@@ -58,6 +59,15 @@ func (d *digestSelector) Select(ctx context.Context) ([]Image, error) {
 		return nil, nil
 	}
 
+	if !d.opts.metadata.apply(image) {
+		logger.Trace("image with tag did not match metadata constraints")
+		return nil, nil
+	}
+
+	if err = d.repoClient.verifyImage(ctx, image, d.opts.verifier); err != nil {
+		return nil, fmt.Errorf("error verifying image with tag %q: %w", tag, err)
+	}
+
 	logger.Trace("found image with tag")
 	return []Image{*image}, nil
 }
@@ -0,0 +1,162 @@
+package image
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// persistentCache, if non-nil, persists the metadata of images and generic
+// artifacts retrieved by digest, so that it survives controller restarts and is shared by all
+// Warehouses subscribed to the same repository. It is nil unless
+// EnablePersistentCache has been called.
+var persistentCache *diskCache
+
+// EnablePersistentCache enables persisting the metadata of images and generic
+// artifacts retrieved by digest to the provided directory, which is created if it does not already
+// exist. Once enabled, tags are resolved to digests using HEAD requests, which
+// most registries do not count against rate limits, and metadata is only
+// retrieved from the registry for digests that have not been seen before. This
+// function is not safe to call concurrently with image discovery and is meant
+// to be called once during startup.
+func EnablePersistentCache(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("error creating image metadata cache directory %q: %w", dir, err)
+	}
+	persistentCache = &diskCache{dir: dir}
+	return nil
+}
+
+// diskCache is a simple, file-based cache of image and artifact metadata. Each entry is
+// stored in its own file, named after a hash of the entry's key. Because the
+// metadata of an image or artifact never changes for a given digest, entries
+// never expire.
+type diskCache struct {
+	dir string
+}
+
+// diskCacheVersion is the version of the on-disk representation of cached
+// Images and Artifacts. It must be incremented whenever information is added to the
+// representation, so that entries lacking that information are treated as
+// absent rather than served incomplete.
+const diskCacheVersion = 1
+
+// diskCacheEntry is the on-disk representation of a cached Image.
+type diskCacheEntry struct {
+	Version     int               `json:"version,omitempty"`
+	Digest      string            `json:"digest"`
+	CreatedAt   *time.Time        `json:"createdAt,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// diskCacheArtifactEntry is the on-disk representation of a cached Artifact.
+type diskCacheArtifactEntry struct {
+	Version      int               `json:"version,omitempty"`
+	Digest       string            `json:"digest"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+// get returns the Image cached under the provided key, if any. Entries that
+// cannot be read are treated as absent.
+func (d *diskCache) get(key string) (*Image, bool) {
+	var entry diskCacheEntry
+	if !d.read(key, &entry) || entry.Version != diskCacheVersion {
+		return nil, false
+	}
+	return &Image{
+		Digest:      entry.Digest,
+		CreatedAt:   entry.CreatedAt,
+		Labels:      entry.Labels,
+		Annotations: entry.Annotations,
+	}, true
+}
+
+// set caches the provided Image under the provided key.
+func (d *diskCache) set(key string, img Image) error {
+	return d.write(key, diskCacheEntry{
+		Version:     diskCacheVersion,
+		Digest:      img.Digest,
+		CreatedAt:   img.CreatedAt,
+		Labels:      img.Labels,
+		Annotations: img.Annotations,
+	})
+}
+
+// getArtifact returns the Artifact cached under the provided key, if any.
+// Entries that cannot be read are treated as absent.
+func (d *diskCache) getArtifact(key string) (*Artifact, bool) {
+	var entry diskCacheArtifactEntry
+	if !d.read(key, &entry) || entry.Version != diskCacheVersion {
+		return nil, false
+	}
+	return &Artifact{
+		Digest:       entry.Digest,
+		ArtifactType: entry.ArtifactType,
+		Annotations:  entry.Annotations,
+	}, true
+}
+
+// setArtifact caches the provided Artifact under the provided key.
+func (d *diskCache) setArtifact(key string, artifact Artifact) error {
+	return d.write(key, diskCacheArtifactEntry{
+		Version:      diskCacheVersion,
+		Digest:       artifact.Digest,
+		ArtifactType: artifact.ArtifactType,
+		Annotations:  artifact.Annotations,
+	})
+}
+
+// read unmarshals the entry stored under the provided key into the provided
+// value. It returns false if the entry does not exist or cannot be read.
+func (d *diskCache) read(key string, v any) bool {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// write stores the provided value under the provided key. The entry is written
+// to a temporary file first and then renamed, so that concurrent readers never
+// observe a partially written entry.
+func (d *diskCache) write(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling metadata: %w", err)
+	}
+	path := d.path(key)
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("error creating metadata cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("error writing metadata: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("error writing metadata: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error writing metadata: %w", err)
+	}
+	return nil
+}
+
+// path returns the path of the file in which the entry with the provided key
+// is stored. Entries are spread across subdirectories to keep the number of
+// files in any one directory manageable.
+func (d *diskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(d.dir, name[:2], name+".json")
+}
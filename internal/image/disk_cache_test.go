@@ -0,0 +1,239 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestDiskCache(t *testing.T) {
+	c := &diskCache{dir: t.TempDir()}
+
+	_, ok := c.get("fake-key")
+	require.False(t, ok)
+
+	testImage := Image{
+		Tag:         "fake-tag",
+		Digest:      "fake-digest",
+		CreatedAt:   ptr.To(time.Now().UTC().Truncate(time.Second)),
+		Labels:      map[string]string{"fake-label": "fake-value"},
+		Annotations: map[string]string{"fake-annotation": "fake-value"},
+	}
+	require.NoError(t, c.set("fake-key", testImage))
+
+	img, ok := c.get("fake-key")
+	require.True(t, ok)
+	// Tags are mutable, so they must never be persisted
+	require.Empty(t, img.Tag)
+	require.Equal(t, testImage.Digest, img.Digest)
+	require.Equal(t, testImage.CreatedAt, img.CreatedAt)
+	require.Equal(t, testImage.Labels, img.Labels)
+	require.Equal(t, testImage.Annotations, img.Annotations)
+
+	// Entries of an older version are treated as absent
+	require.NoError(t, os.WriteFile(c.path("fake-key"), []byte(`{"digest":"fake-digest"}`), 0600))
+	_, ok = c.get("fake-key")
+	require.False(t, ok)
+
+	// Corrupted entries are treated as absent
+	require.NoError(t, os.WriteFile(c.path("fake-key"), []byte("not json"), 0600))
+	_, ok = c.get("fake-key")
+	require.False(t, ok)
+}
+
+func TestDiskCacheArtifact(t *testing.T) {
+	c := &diskCache{dir: t.TempDir()}
+
+	_, ok := c.getArtifact("fake-key")
+	require.False(t, ok)
+
+	testArtifact := Artifact{
+		Tag:          "fake-tag",
+		Digest:       "fake-digest",
+		ArtifactType: "application/vnd.example.config+json",
+		Annotations:  map[string]string{"fake-annotation": "fake-value"},
+	}
+	require.NoError(t, c.setArtifact("fake-key", testArtifact))
+
+	artifact, ok := c.getArtifact("fake-key")
+	require.True(t, ok)
+	// Tags are mutable, so they must never be persisted
+	require.Empty(t, artifact.Tag)
+	require.Equal(t, testArtifact.Digest, artifact.Digest)
+	require.Equal(t, testArtifact.ArtifactType, artifact.ArtifactType)
+	require.Equal(t, testArtifact.Annotations, artifact.Annotations)
+
+	// Corrupted entries are treated as absent
+	require.NoError(t, os.WriteFile(c.path("fake-key"), []byte("not json"), 0600))
+	_, ok = c.getArtifact("fake-key")
+	require.False(t, ok)
+}
+
+func TestPersistentCache(t *testing.T) {
+	const testDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	testRepoRef, err := name.ParseReference("fake-url")
+	require.NoError(t, err)
+
+	testImage := Image{
+		Digest:    testDigest,
+		CreatedAt: ptr.To(time.Now().UTC().Truncate(time.Second)),
+	}
+
+	require.NoError(t, EnablePersistentCache(t.TempDir()))
+	t.Cleanup(func() { persistentCache = nil })
+
+	var remoteGets int
+	newClient := func() *repositoryClient {
+		r := &repositoryClient{
+			registry: &registry{
+				imageCache: cache.New(30*time.Minute, time.Hour),
+			},
+			repoRef:  testRepoRef,
+			cacheKey: "fake-key",
+			remoteHeadFn: func(
+				name.Reference,
+				...remote.Option,
+			) (*v1.Descriptor, error) {
+				return &v1.Descriptor{Digest: v1.Hash{
+					Algorithm: "sha256",
+					Hex:       testDigest[len("sha256:"):],
+				}}, nil
+			},
+			remoteGetFn: func(
+				name.Reference,
+				...remote.Option,
+			) (*remote.Descriptor, error) {
+				remoteGets++
+				return &remote.Descriptor{}, nil
+			},
+			getImageFromRemoteDescFn: func(
+				context.Context,
+				*remote.Descriptor,
+				*platformConstraint,
+			) (*Image, error) {
+				img := testImage
+				return &img, nil
+			},
+		}
+		r.getImageByDigestFn = r.getImageByDigest
+		return r
+	}
+
+	t.Run("tag is resolved using HEAD and image is retrieved", func(t *testing.T) {
+		img, err := newClient().getImageByTag(context.Background(), "fake-tag", nil)
+		require.NoError(t, err)
+		require.Equal(t, "fake-tag", img.Tag)
+		require.Equal(t, testDigest, img.Digest)
+		require.Equal(t, 1, remoteGets)
+	})
+
+	t.Run("image is served from disk by a new client", func(t *testing.T) {
+		// A new client has an empty in-memory cache, as after a restart
+		img, err := newClient().getImageByTag(context.Background(), "other-tag", nil)
+		require.NoError(t, err)
+		require.Equal(t, "other-tag", img.Tag)
+		require.Equal(t, testImage.CreatedAt, img.CreatedAt)
+		require.Equal(t, 1, remoteGets)
+	})
+
+	t.Run("falls back to GET if HEAD fails", func(t *testing.T) {
+		r := newClient()
+		r.remoteHeadFn = func(name.Reference, ...remote.Option) (*v1.Descriptor, error) {
+			return nil, errors.New("something went wrong")
+		}
+		img, err := r.getImageByTag(context.Background(), "fake-tag", nil)
+		require.NoError(t, err)
+		require.Equal(t, testDigest, img.Digest)
+		require.Equal(t, 2, remoteGets)
+	})
+}
+
+func TestPersistentCacheArtifact(t *testing.T) {
+	const testDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	testRepoRef, err := name.ParseReference("fake-url")
+	require.NoError(t, err)
+
+	require.NoError(t, EnablePersistentCache(t.TempDir()))
+	t.Cleanup(func() { persistentCache = nil })
+
+	var remoteGets int
+	newClient := func() *repositoryClient {
+		return &repositoryClient{
+			registry: &registry{
+				imageCache: cache.New(30*time.Minute, time.Hour),
+			},
+			repoRef:  testRepoRef,
+			cacheKey: "fake-key",
+			remoteHeadFn: func(
+				name.Reference,
+				...remote.Option,
+			) (*v1.Descriptor, error) {
+				return &v1.Descriptor{Digest: v1.Hash{
+					Algorithm: "sha256",
+					Hex:       testDigest[len("sha256:"):],
+				}}, nil
+			},
+			remoteGetFn: func(
+				name.Reference,
+				...remote.Option,
+			) (*remote.Descriptor, error) {
+				remoteGets++
+				return &remote.Descriptor{
+					Descriptor: v1.Descriptor{Digest: v1.Hash{
+						Algorithm: "sha256",
+						Hex:       testDigest[len("sha256:"):],
+					}},
+					Manifest: []byte(`{"artifactType":"application/vnd.example+json"}`),
+				}, nil
+			},
+		}
+	}
+
+	t.Run("tag is resolved using HEAD and artifact is retrieved", func(t *testing.T) {
+		r := newClient()
+		artifact, err := r.getArtifactByTag(context.Background(), "fake-tag")
+		require.NoError(t, err)
+		require.Equal(t, "fake-tag", artifact.Tag)
+		require.Equal(t, testDigest, artifact.Digest)
+		require.Equal(t, "application/vnd.example+json", artifact.ArtifactType)
+		require.Equal(t, 1, remoteGets)
+
+		// The same client serves the artifact from memory
+		artifact, err = r.getArtifactByTag(context.Background(), "other-tag")
+		require.NoError(t, err)
+		require.Equal(t, "other-tag", artifact.Tag)
+		require.Equal(t, 1, remoteGets)
+	})
+
+	t.Run("artifact is served from disk by a new client", func(t *testing.T) {
+		// A new client has an empty in-memory cache, as after a restart
+		artifact, err := newClient().getArtifactByTag(context.Background(), "other-tag")
+		require.NoError(t, err)
+		require.Equal(t, "other-tag", artifact.Tag)
+		require.Equal(t, "application/vnd.example+json", artifact.ArtifactType)
+		require.Equal(t, 1, remoteGets)
+	})
+
+	t.Run("falls back to GET if HEAD fails", func(t *testing.T) {
+		r := newClient()
+		r.remoteHeadFn = func(name.Reference, ...remote.Option) (*v1.Descriptor, error) {
+			return nil, errors.New("something went wrong")
+		}
+		artifact, err := r.getArtifactByTag(context.Background(), "fake-tag")
+		require.NoError(t, err)
+		require.Equal(t, "fake-tag", artifact.Tag)
+		require.Equal(t, testDigest, artifact.Digest)
+		require.Equal(t, 2, remoteGets)
+	})
+}
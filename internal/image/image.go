@@ -1,9 +1,12 @@
 package image
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/name"
 )
 
 // Image is a representation of a container image.
@@ -11,7 +14,18 @@ type Image struct {
 	Tag       string
 	Digest    string
 	CreatedAt *time.Time
-	semVer    *semver.Version
+	// VerificationFailure explains why the image failed signature verification.
+	// It is empty if the image was verified or if no verification was
+	// requested.
+	VerificationFailure string
+	// Labels are the labels of the image's config. For images referenced by an
+	// index, these are the labels of the image matching the platform constraint,
+	// if any, or else of the most recently created image.
+	Labels map[string]string
+	// Annotations are the annotations of the image's manifest or, for images
+	// referenced by an index, of the index merged over those of the image.
+	Annotations map[string]string
+	semVer      *semver.Version
 }
 
 // newImage initializes and returns an Image.
@@ -27,3 +41,16 @@ func newImage(tag, digest string, date *time.Time) Image {
 	}
 	return t
 }
+
+// NormalizeURL normalizes the provided image repository URL so that
+// equivalent references to the same repository (e.g. "nginx",
+// "docker.io/nginx", and "index.docker.io/library/nginx:latest") can be
+// compared. Any tag or digest is stripped. An error is returned if the
+// provided URL cannot be parsed as an image reference.
+func NormalizeURL(repoURL string) (string, error) {
+	ref, err := name.ParseReference(strings.ToLower(strings.TrimSpace(repoURL)))
+	if err != nil {
+		return "", fmt.Errorf("error parsing image repository URL %q: %w", repoURL, err)
+	}
+	return ref.Context().Name(), nil
+}
@@ -1,6 +1,7 @@
 package image
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -45,3 +46,57 @@ func TestNewImage(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeURL(t *testing.T) {
+	testCases := []struct {
+		name       string
+		repoURL    string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:    "invalid URL",
+			repoURL: "fake repo",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing image repository URL")
+			},
+		},
+		{
+			name:    "official Docker Hub image",
+			repoURL: "nginx",
+			assertions: func(t *testing.T, url string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "index.docker.io/library/nginx", url)
+			},
+		},
+		{
+			name:    "Docker Hub image with tag",
+			repoURL: "docker.io/example/app:v1.0.0",
+			assertions: func(t *testing.T, url string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "index.docker.io/example/app", url)
+			},
+		},
+		{
+			name:    "mixed case image with digest",
+			repoURL: "GHCR.io/Example/App@sha256:" + strings.Repeat("a", 64),
+			assertions: func(t *testing.T, url string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "ghcr.io/example/app", url)
+			},
+		},
+		{
+			name:    "registry with port",
+			repoURL: "localhost:5000/example/app",
+			assertions: func(t *testing.T, url string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "localhost:5000/example/app", url)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			url, err := NormalizeURL(testCase.repoURL)
+			testCase.assertions(t, url, err)
+		})
+	}
+}
@@ -32,6 +32,7 @@ func (l *lexicalSelector) Select(ctx context.Context) ([]Image, error) {
 		"image", l.repoClient.repoURL,
 		"selectionStrategy", SelectionStrategyLexical,
 		"platformConstrained", l.opts.platform != nil,
+		"metadataConstrained", l.opts.metadata != nil,
 		"discoveryLimit", l.opts.DiscoveryLimit,
 	)
 	logger.Trace("discovering images")
@@ -47,10 +48,10 @@ func (l *lexicalSelector) Select(ctx context.Context) ([]Image, error) {
 	if limit == 0 || limit > len(tags) {
 		limit = len(tags)
 	}
-	images := make([]Image, 0, limit)
+	discovered := newDiscoveredImages(limit)
 
 	for _, tag := range tags {
-		if len(images) >= limit {
+		if discovered.full() {
 			break
 		}
 
@@ -65,25 +66,36 @@ func (l *lexicalSelector) Select(ctx context.Context) ([]Image, error) {
 			)
 			continue
 		}
+		if !l.opts.metadata.apply(image) {
+			logger.Trace(
+				"image was found, but did not match metadata constraints",
+				"tag", tag,
+			)
+			continue
+		}
+
+		if err = l.repoClient.verifyImage(ctx, image, l.opts.verifier); err != nil {
+			return nil, fmt.Errorf("error verifying image with tag %q: %w", tag, err)
+		}
 
 		logger.Trace(
 			"discovered image",
 			"tag", image.Tag,
 			"digest", image.Digest,
 		)
-		images = append(images, *image)
+		discovered.add(*image)
 	}
 
-	if len(images) == 0 {
+	if len(discovered.images) == 0 {
 		logger.Trace("no images matched criteria")
 		return nil, nil
 	}
 
 	logger.Trace(
 		"discovered images",
-		"count", len(images),
+		"count", len(discovered.images),
 	)
-	return images, nil
+	return discovered.images, nil
 }
 
 // selectTags retrieves all tags from the repository and filters them based on
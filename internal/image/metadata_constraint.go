@@ -0,0 +1,189 @@
+package image
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// MetadataMatchOperator represents the relationship between the value of an
+// image label or annotation and the values of a MetadataMatchExpression.
+type MetadataMatchOperator string
+
+const (
+	// MetadataMatchOperatorIn requires the value of a label or annotation to be
+	// exactly equal to one of the values of the expression.
+	MetadataMatchOperatorIn MetadataMatchOperator = "In"
+	// MetadataMatchOperatorNotIn requires a label or annotation to be absent or
+	// its value not to be equal to any of the values of the expression.
+	MetadataMatchOperatorNotIn MetadataMatchOperator = "NotIn"
+	// MetadataMatchOperatorExists requires a label or annotation to be present.
+	MetadataMatchOperatorExists MetadataMatchOperator = "Exists"
+	// MetadataMatchOperatorDoesNotExist requires a label or annotation to be
+	// absent.
+	MetadataMatchOperatorDoesNotExist MetadataMatchOperator = "DoesNotExist"
+	// MetadataMatchOperatorMatches requires the value of a label or annotation
+	// to match one of the values of the expression, which are treated as
+	// regular expressions.
+	MetadataMatchOperatorMatches MetadataMatchOperator = "Matches"
+)
+
+// MetadataMatchExpression represents a requirement that an image label or
+// annotation must satisfy.
+type MetadataMatchExpression struct {
+	// Key is the key of the label or annotation.
+	Key string
+	// Operator is the relationship between the value of the label or
+	// annotation and the Values.
+	Operator MetadataMatchOperator
+	// Values is a list of values. It must be non-empty for the In, NotIn, and
+	// Matches operators and empty for the Exists and DoesNotExist operators.
+	Values []string
+}
+
+// metadataRequirement is a parsed MetadataMatchExpression.
+type metadataRequirement struct {
+	MetadataMatchExpression
+	regexes []*regexp.Regexp
+}
+
+// metadataConstraint represents a set of requirements that the labels and
+// annotations of an image must all satisfy.
+type metadataConstraint struct {
+	labels      []metadataRequirement
+	annotations []metadataRequirement
+}
+
+// newMetadataConstraint parses the provided label and annotation expressions
+// and returns a metadataConstraint. It returns nil if no expressions are
+// provided.
+func newMetadataConstraint(
+	labelExprs []MetadataMatchExpression,
+	annotationExprs []MetadataMatchExpression,
+) (*metadataConstraint, error) {
+	if len(labelExprs) == 0 && len(annotationExprs) == 0 {
+		return nil, nil
+	}
+	labels, err := parseMetadataRequirements(labelExprs)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing label match expressions: %w", err)
+	}
+	annotations, err := parseMetadataRequirements(annotationExprs)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing annotation match expressions: %w", err)
+	}
+	return &metadataConstraint{
+		labels:      labels,
+		annotations: annotations,
+	}, nil
+}
+
+func parseMetadataRequirements(exprs []MetadataMatchExpression) ([]metadataRequirement, error) {
+	reqs := make([]metadataRequirement, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr.Key == "" {
+			return nil, errors.New("key must not be empty")
+		}
+		req := metadataRequirement{MetadataMatchExpression: expr}
+		switch expr.Operator {
+		case MetadataMatchOperatorIn, MetadataMatchOperatorNotIn:
+			if len(expr.Values) == 0 {
+				return nil, fmt.Errorf("operator %s for key %q requires values", expr.Operator, expr.Key)
+			}
+		case MetadataMatchOperatorExists, MetadataMatchOperatorDoesNotExist:
+			if len(expr.Values) > 0 {
+				return nil, fmt.Errorf("operator %s for key %q does not permit values", expr.Operator, expr.Key)
+			}
+		case MetadataMatchOperatorMatches:
+			if len(expr.Values) == 0 {
+				return nil, fmt.Errorf("operator %s for key %q requires values", expr.Operator, expr.Key)
+			}
+			req.regexes = make([]*regexp.Regexp, 0, len(expr.Values))
+			for _, value := range expr.Values {
+				regex, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("error compiling regular expression %q: %w", value, err)
+				}
+				req.regexes = append(req.regexes, regex)
+			}
+		default:
+			return nil, fmt.Errorf("invalid operator %q for key %q", expr.Operator, expr.Key)
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// apply returns a boolean indicating whether the labels and annotations of
+// the provided Image satisfy the constraint. If they do, the labels and
+// annotations of the Image are replaced with only those that were evaluated by
+// the constraint, so that they can be reported without carrying along all of
+// an image's (potentially numerous) metadata. A nil constraint is satisfied by
+// every Image and strips all of its labels and annotations.
+func (m *metadataConstraint) apply(img *Image) bool {
+	if m == nil {
+		img.Labels = nil
+		img.Annotations = nil
+		return true
+	}
+	labels, ok := matchMetadata(m.labels, img.Labels)
+	if !ok {
+		return false
+	}
+	annotations, ok := matchMetadata(m.annotations, img.Annotations)
+	if !ok {
+		return false
+	}
+	img.Labels = labels
+	img.Annotations = annotations
+	return true
+}
+
+// matchMetadata returns a boolean indicating whether the provided metadata
+// satisfies all the provided requirements, along with the subset of the
+// metadata whose keys are referenced by the requirements.
+func matchMetadata(
+	reqs []metadataRequirement,
+	metadata map[string]string,
+) (map[string]string, bool) {
+	var matched map[string]string
+	for _, req := range reqs {
+		value, exists := metadata[req.Key]
+		if !req.matches(value, exists) {
+			return nil, false
+		}
+		if exists {
+			if matched == nil {
+				matched = map[string]string{}
+			}
+			matched[req.Key] = value
+		}
+	}
+	return matched, true
+}
+
+// matches returns a boolean indicating whether the provided value, and whether
+// it exists at all, satisfy the requirement.
+func (r *metadataRequirement) matches(value string, exists bool) bool {
+	switch r.Operator {
+	case MetadataMatchOperatorIn:
+		return exists && slices.Contains(r.Values, value)
+	case MetadataMatchOperatorNotIn:
+		return !exists || !slices.Contains(r.Values, value)
+	case MetadataMatchOperatorExists:
+		return exists
+	case MetadataMatchOperatorDoesNotExist:
+		return !exists
+	case MetadataMatchOperatorMatches:
+		if !exists {
+			return false
+		}
+		for _, regex := range r.regexes {
+			if regex.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetadataConstraint(t *testing.T) {
+	testCases := []struct {
+		name        string
+		labels      []MetadataMatchExpression
+		annotations []MetadataMatchExpression
+		assertions  func(*testing.T, *metadataConstraint, error)
+	}{
+		{
+			name: "no expressions",
+			assertions: func(t *testing.T, m *metadataConstraint, err error) {
+				require.NoError(t, err)
+				require.Nil(t, m)
+			},
+		},
+		{
+			name:   "empty key",
+			labels: []MetadataMatchExpression{{Operator: MetadataMatchOperatorExists}},
+			assertions: func(t *testing.T, _ *metadataConstraint, err error) {
+				require.ErrorContains(t, err, "error parsing label match expressions")
+				require.ErrorContains(t, err, "key must not be empty")
+			},
+		},
+		{
+			name:   "invalid operator",
+			labels: []MetadataMatchExpression{{Key: "foo", Operator: "Bogus"}},
+			assertions: func(t *testing.T, _ *metadataConstraint, err error) {
+				require.ErrorContains(t, err, "invalid operator")
+			},
+		},
+		{
+			name:   "In without values",
+			labels: []MetadataMatchExpression{{Key: "foo", Operator: MetadataMatchOperatorIn}},
+			assertions: func(t *testing.T, _ *metadataConstraint, err error) {
+				require.ErrorContains(t, err, "requires values")
+			},
+		},
+		{
+			name: "Exists with values",
+			annotations: []MetadataMatchExpression{{
+				Key:      "foo",
+				Operator: MetadataMatchOperatorExists,
+				Values:   []string{"bar"},
+			}},
+			assertions: func(t *testing.T, _ *metadataConstraint, err error) {
+				require.ErrorContains(t, err, "error parsing annotation match expressions")
+				require.ErrorContains(t, err, "does not permit values")
+			},
+		},
+		{
+			name: "invalid regular expression",
+			annotations: []MetadataMatchExpression{{
+				Key:      "foo",
+				Operator: MetadataMatchOperatorMatches,
+				Values:   []string{"("},
+			}},
+			assertions: func(t *testing.T, _ *metadataConstraint, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name: "success",
+			labels: []MetadataMatchExpression{{
+				Key:      "foo",
+				Operator: MetadataMatchOperatorMatches,
+				Values:   []string{"^bar$"},
+			}},
+			annotations: []MetadataMatchExpression{{
+				Key:      "baz",
+				Operator: MetadataMatchOperatorDoesNotExist,
+			}},
+			assertions: func(t *testing.T, m *metadataConstraint, err error) {
+				require.NoError(t, err)
+				require.Len(t, m.labels, 1)
+				require.Len(t, m.labels[0].regexes, 1)
+				require.Len(t, m.annotations, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m, err := newMetadataConstraint(testCase.labels, testCase.annotations)
+			testCase.assertions(t, m, err)
+		})
+	}
+}
+
+func TestMetadataConstraintApply(t *testing.T) {
+	const sourceKey = "org.opencontainers.image.source"
+
+	testImage := Image{
+		Labels: map[string]string{
+			sourceKey:    "https://github.com/example/repo",
+			"ci.passed":  "true",
+			"maintainer": "someone",
+		},
+		Annotations: map[string]string{
+			"org.opencontainers.image.revision": "abc123",
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		labels      []MetadataMatchExpression
+		annotations []MetadataMatchExpression
+		assertions  func(*testing.T, bool, Image)
+	}{
+		{
+			name: "nil constraint strips metadata",
+			assertions: func(t *testing.T, matched bool, img Image) {
+				require.True(t, matched)
+				require.Nil(t, img.Labels)
+				require.Nil(t, img.Annotations)
+			},
+		},
+		{
+			name: "all expressions satisfied",
+			labels: []MetadataMatchExpression{
+				{Key: sourceKey, Operator: MetadataMatchOperatorMatches, Values: []string{"^https://github.com/example/"}},
+				{Key: "ci.passed", Operator: MetadataMatchOperatorIn, Values: []string{"true"}},
+				{Key: "deprecated", Operator: MetadataMatchOperatorDoesNotExist},
+			},
+			annotations: []MetadataMatchExpression{
+				{Key: "org.opencontainers.image.revision", Operator: MetadataMatchOperatorExists},
+			},
+			assertions: func(t *testing.T, matched bool, img Image) {
+				require.True(t, matched)
+				// Only evaluated keys that exist are retained
+				require.Equal(
+					t,
+					map[string]string{
+						sourceKey:   "https://github.com/example/repo",
+						"ci.passed": "true",
+					},
+					img.Labels,
+				)
+				require.Equal(
+					t,
+					map[string]string{"org.opencontainers.image.revision": "abc123"},
+					img.Annotations,
+				)
+			},
+		},
+		{
+			name: "label does not match",
+			labels: []MetadataMatchExpression{
+				{Key: sourceKey, Operator: MetadataMatchOperatorMatches, Values: []string{"^https://github.com/other/"}},
+			},
+			assertions: func(t *testing.T, matched bool, _ Image) {
+				require.False(t, matched)
+			},
+		},
+		{
+			name: "label is excluded",
+			labels: []MetadataMatchExpression{
+				{Key: "ci.passed", Operator: MetadataMatchOperatorNotIn, Values: []string{"true"}},
+			},
+			assertions: func(t *testing.T, matched bool, _ Image) {
+				require.False(t, matched)
+			},
+		},
+		{
+			name: "missing label satisfies NotIn",
+			labels: []MetadataMatchExpression{
+				{Key: "deprecated", Operator: MetadataMatchOperatorNotIn, Values: []string{"true"}},
+			},
+			assertions: func(t *testing.T, matched bool, img Image) {
+				require.True(t, matched)
+				require.Nil(t, img.Labels)
+			},
+		},
+		{
+			name: "annotation is missing",
+			annotations: []MetadataMatchExpression{
+				{Key: "ci.passed", Operator: MetadataMatchOperatorIn, Values: []string{"true"}},
+			},
+			assertions: func(t *testing.T, matched bool, _ Image) {
+				require.False(t, matched)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m, err := newMetadataConstraint(testCase.labels, testCase.annotations)
+			require.NoError(t, err)
+			img := testImage
+			matched := m.apply(&img)
+			testCase.assertions(t, matched, img)
+		})
+	}
+}
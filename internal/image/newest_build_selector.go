@@ -34,6 +34,7 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 		"image", n.repoClient.repoURL,
 		"selectionStrategy", SelectionStrategyNewestBuild,
 		"platformConstrained", n.opts.platform != nil,
+		"metadataConstrained", n.opts.metadata != nil,
 		"discoveryLimit", n.opts.DiscoveryLimit,
 	)
 	logger.Trace("discovering images")
@@ -51,27 +52,47 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 	}
 
 	if n.opts.platform == nil {
-		for _, image := range images[:limit] {
+		discovered := newDiscoveredImages(limit)
+		for i := range images {
+			if discovered.full() {
+				break
+			}
+			image := &images[i]
+			if !n.opts.metadata.apply(image) {
+				logger.Trace(
+					"image was found, but did not match metadata constraints",
+					"digest", image.Digest,
+				)
+				continue
+			}
+			if err = n.repoClient.verifyImage(ctx, image, n.opts.verifier); err != nil {
+				return nil, fmt.Errorf("error verifying image with digest %q: %w", image.Digest, err)
+			}
+			discovered.add(*image)
 			logger.Trace(
 				"discovered image",
 				"tag", image.Tag,
 				"digest", image.Digest,
 			)
 		}
+		if len(discovered.images) == 0 {
+			logger.Trace("no images matched metadata constraints")
+			return nil, nil
+		}
 		logger.Trace(
 			"discovered images",
-			"count", limit,
+			"count", len(discovered.images),
 		)
-		return images[:limit], nil
+		return discovered.images, nil
 	}
 
 	// TODO(hidde): this could be more efficient, as we are fetching the image
 	// _again_ to check if it matches the platform constraint (although we do
 	// cache it indefinitely). We should consider refactoring this to avoid
 	// fetching the image twice.
-	discoveredImages := make([]Image, 0, limit)
+	discovered := newDiscoveredImages(limit)
 	for _, image := range images {
-		if len(discoveredImages) >= limit {
+		if discovered.full() {
 			break
 		}
 
@@ -88,8 +109,19 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 			continue
 		}
 
+		if !n.opts.metadata.apply(discoveredImage) {
+			logger.Trace(
+				"image was found, but did not match metadata constraints",
+				"digest", image.Digest,
+			)
+			continue
+		}
+
 		discoveredImage.Tag = image.Tag
-		discoveredImages = append(discoveredImages, *discoveredImage)
+		if err = n.repoClient.verifyImage(ctx, discoveredImage, n.opts.verifier); err != nil {
+			return nil, fmt.Errorf("error verifying image with digest %q: %w", image.Digest, err)
+		}
+		discovered.add(*discoveredImage)
 
 		logger.Trace(
 			"discovered image",
@@ -99,16 +131,16 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 		)
 	}
 
-	if len(discoveredImages) == 0 {
-		logger.Trace("no images matched platform constraint")
+	if len(discovered.images) == 0 {
+		logger.Trace("no images matched platform or metadata constraints")
 		return nil, nil
 	}
 
 	logger.Trace(
 		"discovered images",
-		"count", len(discoveredImages),
+		"count", len(discovered.images),
 	)
-	return discoveredImages, nil
+	return discovered.images, nil
 }
 
 func (n *newestBuildSelector) selectImages(ctx context.Context) ([]Image, error) {
@@ -3,22 +3,29 @@ package image
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/metrics"
 )
 
 const (
@@ -34,6 +41,18 @@ const (
 
 var metaSem = semaphore.NewWeighted(maxMetadataConcurrency)
 
+var (
+	// tagsGroup deduplicates concurrent requests to list the tags of the same
+	// repository using the same credentials.
+	tagsGroup singleflight.Group
+	// imagesGroup deduplicates concurrent requests to retrieve the same image
+	// by digest.
+	imagesGroup singleflight.Group
+	// artifactsGroup deduplicates concurrent requests to retrieve the same
+	// generic artifact by digest.
+	artifactsGroup singleflight.Group
+)
+
 // repositoryClient is a client for retrieving information from a specific image
 // container repository.
 type repositoryClient struct {
@@ -41,6 +60,11 @@ type repositoryClient struct {
 	repoURL       string
 	repoRef       name.Reference
 	remoteOptions []remote.Option
+	// cacheKey uniquely identifies the repository as accessed using the
+	// client's credentials. It is used to key shared and persistent caches, so
+	// that information retrieved using one set of credentials is never served
+	// to holders of another.
+	cacheKey string
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -78,6 +102,14 @@ type repositoryClient struct {
 	remoteListFn func(name.Repository, ...remote.Option) ([]string, error)
 
 	remoteGetFn func(name.Reference, ...remote.Option) (*remote.Descriptor, error)
+
+	remoteHeadFn func(name.Reference, ...remote.Option) (*v1.Descriptor, error)
+
+	remoteImageFn func(name.Reference, ...remote.Option) (v1.Image, error)
+
+	getSignaturesFn func(ctx context.Context, digest string) ([]signature, error)
+
+	getAttestationsFn func(ctx context.Context, digest string) ([]attestation, error)
 }
 
 // newRepositoryClient parses the provided repository URL to infer registry
@@ -113,6 +145,12 @@ func newRepositoryClient(
 		registry: reg,
 		repoURL:  repoURL,
 		repoRef:  repoRef,
+		cacheKey: fmt.Sprintf(
+			"%s\x00%s\x00%s",
+			repoRef.Context().Name(),
+			creds.Username,
+			creds.Password,
+		),
 		remoteOptions: []remote.Option{
 			remote.WithTransport(&rateLimitedRoundTripper{
 				limiter:              reg.rateLimiter,
@@ -129,21 +167,30 @@ func newRepositoryClient(
 	r.getImageFromV1ImageFn = r.getImageFromV1Image
 	r.remoteListFn = remote.List
 	r.remoteGetFn = remote.Get
+	r.remoteHeadFn = remote.Head
+	r.remoteImageFn = remote.Image
+	r.getSignaturesFn = r.getSignatures
+	r.getAttestationsFn = r.getAttestations
 
 	return r, nil
 }
 
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
-	tags, err := r.remoteListFn(r.repoRef.Context(), opts...)
+	res, err, _ := tagsGroup.Do(r.cacheKey, func() (any, error) {
+		return r.remoteListFn(r.repoRef.Context(), opts...)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("error listing tags for repo URL %s: %w", r.repoURL, err)
 	}
-	return tags, nil
+	// The result may be shared with other callers, so return a copy.
+	return slices.Clone(res.([]string)), nil // nolint: forcetypeassert
 }
 
-// getImageByTag retrieves an Image by tag. This function uses no cache since
-// tags can be mutable.
+// getImageByTag retrieves an Image by tag. Since tags can be mutable, the tag
+// is always resolved using the registry. If the persistent cache is enabled,
+// the tag is resolved to a digest using a HEAD request and the Image is then
+// retrieved by digest, which makes use of the cache.
 func (r *repositoryClient) getImageByTag(
 	ctx context.Context,
 	tag string,
@@ -151,6 +198,23 @@ func (r *repositoryClient) getImageByTag(
 ) (*Image, error) {
 	repoRef := r.repoRef.Context().Tag(tag)
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	if persistentCache != nil && r.remoteHeadFn != nil {
+		// Some registries do not support HEAD requests for manifests. In that
+		// case, fall back to retrieving the manifest.
+		if headDesc, err := r.remoteHeadFn(repoRef, opts...); err == nil {
+			img, err := r.getImageByDigestFn(ctx, headDesc.Digest.String(), platform)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error getting image for tag %q from repo URL %s: %w",
+					tag, r.repoURL, err,
+				)
+			}
+			if img != nil {
+				img.Tag = tag
+			}
+			return img, nil
+		}
+	}
 	desc, err := r.remoteGetFn(repoRef, opts...)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -172,7 +236,9 @@ func (r *repositoryClient) getImageByTag(
 }
 
 // getImageByDigest retrieves an Image for a given digest. This function uses a
-// cache since information retrieved by digest will never change.
+// cache since information retrieved by digest will never change. If the
+// persistent cache is enabled, it is consulted after the in-memory cache.
+// Concurrent requests for the same Image are deduplicated.
 func (r *repositoryClient) getImageByDigest(
 	ctx context.Context,
 	digest string,
@@ -185,43 +251,74 @@ func (r *repositoryClient) getImageByDigest(
 	)
 
 	if entry, exists := r.registry.imageCache.Get(digest); exists {
+		metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheImage)
 		image := entry.(Image) // nolint: forcetypeassert
 		return &image, nil
 	}
 
+	var platformStr string
+	if platform != nil {
+		platformStr = platform.String()
+	}
+	cacheKey := fmt.Sprintf("%s\x00%s\x00%s", r.cacheKey, digest, platformStr)
+
+	if persistentCache != nil {
+		if img, exists := persistentCache.get(cacheKey); exists {
+			metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheImage)
+			r.registry.imageCache.Set(digest, *img, cache.DefaultExpiration)
+			return img, nil
+		}
+	}
+
 	logger.Trace(
 		"image NOT found in cache",
 		"digest", digest,
 	)
+	metrics.RecordDiscoveryCacheMiss(metrics.DiscoveryCacheImage)
 
-	repoRef := r.repoRef.Context().Digest(digest)
-	opts := append(r.remoteOptions, remote.WithContext(ctx))
-	desc, err := r.remoteGetFn(repoRef, opts...)
+	res, err, _ := imagesGroup.Do(cacheKey, func() (any, error) {
+		repoRef := r.repoRef.Context().Digest(digest)
+		opts := append(r.remoteOptions, remote.WithContext(ctx))
+		desc, err := r.remoteGetFn(repoRef, opts...)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting image descriptor for digest %s from repo URL %s: %w",
+				digest, r.repoURL, err,
+			)
+		}
+		img, err := r.getImageFromRemoteDescFn(ctx, desc, platform)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting image from descriptor for digest %s from repo URL %s: %w",
+				digest, r.repoURL, err,
+			)
+		}
+		return img, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf(
-			"error getting image descriptor for digest %s from repo URL %s: %w",
-			digest, r.repoURL, err,
-		)
+		return nil, err
 	}
-
-	img, err := r.getImageFromRemoteDescFn(ctx, desc, platform)
-	if err != nil {
-		return nil, fmt.Errorf(
-			"error getting image from descriptor for digest %s from repo URL %s: %w",
-			digest, r.repoURL, err,
-		)
+	img := res.(*Image) // nolint: forcetypeassert
+	if img == nil {
+		return nil, nil
 	}
 
-	if img != nil {
-		// Cache the image
-		r.registry.imageCache.Set(digest, *img, cache.DefaultExpiration)
-		logger.Trace(
-			"cached image",
-			"digest", digest,
-		)
+	// Cache the image
+	r.registry.imageCache.Set(digest, *img, cache.DefaultExpiration)
+	if persistentCache != nil {
+		if err = persistentCache.set(cacheKey, *img); err != nil {
+			// Failing to persist the image is not fatal.
+			logger.Error(err, "error persisting image metadata", "digest", digest)
+		}
 	}
+	logger.Trace(
+		"cached image",
+		"digest", digest,
+	)
 
-	return img, nil
+	// The result may be shared with other callers, so return a copy.
+	imgCopy := *img
+	return &imgCopy, nil
 }
 
 // getImageFromRemoteDesc gets an Image from a given remote.Descriptor.
@@ -328,6 +425,7 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 			)
 		}
 		img.Digest = digest
+		img.Annotations = mergeAnnotations(img.Annotations, idxManifest.Annotations)
 		return img, nil
 	}
 
@@ -337,6 +435,7 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 	// platform constraint, so we'll follow ALL the references to find the most
 	// recently pushed manifest's createdAt timestamp.
 	var createdAt *time.Time
+	var labels, annotations map[string]string
 	for _, ref := range refs {
 		img, err := r.getImageByDigestFn(ctx, ref.Digest.String(), platform)
 		if err != nil {
@@ -350,14 +449,33 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 		}
 		if createdAt == nil || img.CreatedAt.After(*createdAt) {
 			createdAt = img.CreatedAt
+			labels = img.Labels
+			annotations = img.Annotations
 		}
 	}
 	return &Image{
-		Digest:    digest,
-		CreatedAt: createdAt,
+		Digest:      digest,
+		CreatedAt:   createdAt,
+		Labels:      labels,
+		Annotations: mergeAnnotations(annotations, idxManifest.Annotations),
 	}, nil
 }
 
+// mergeAnnotations returns the annotations of an image merged with those of
+// the index referencing it. Annotations of the index take precedence. The
+// provided maps are not modified, as they may be shared with a cache.
+func mergeAnnotations(imgAnnotations, idxAnnotations map[string]string) map[string]string {
+	if len(idxAnnotations) == 0 {
+		return imgAnnotations
+	}
+	merged := maps.Clone(imgAnnotations)
+	if merged == nil {
+		merged = make(map[string]string, len(idxAnnotations))
+	}
+	maps.Copy(merged, idxAnnotations)
+	return merged
+}
+
 // getImageFromV1Image gets an Image from a given v1.Image. It is valid for this
 // function to return nil the image does not match the specified platform, if
 // any.
@@ -377,12 +495,339 @@ func (r *repositoryClient) getImageFromV1Image(
 		// This image doesn't match the platform constraint.
 		return nil, nil
 	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting manifest for image with digest %s: %w",
+			digest, err,
+		)
+	}
 	return &Image{
-		Digest:    digest,
-		CreatedAt: &cfg.Created.Time,
+		Digest:      digest,
+		CreatedAt:   &cfg.Created.Time,
+		Labels:      cfg.Config.Labels,
+		Annotations: manifest.Annotations,
 	}, nil
 }
 
+// getArtifactByTag retrieves a generic Artifact by tag. Since tags can be
+// mutable, the tag is always resolved to a digest using a HEAD request, which
+// most registries do not count against rate limits, and the Artifact is then
+// retrieved by digest, which makes use of the cache. If the registry does not
+// support HEAD requests for manifests, the manifest is retrieved by tag
+// instead.
+func (r *repositoryClient) getArtifactByTag(
+	ctx context.Context,
+	tag string,
+) (*Artifact, error) {
+	repoRef := r.repoRef.Context().Tag(tag)
+	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	if headDesc, err := r.remoteHeadFn(repoRef, opts...); err == nil {
+		artifact, err := r.getArtifactByDigest(ctx, headDesc.Digest.String())
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting artifact for tag %q from repo URL %s: %w",
+				tag, r.repoURL, err,
+			)
+		}
+		artifact.Tag = tag
+		return artifact, nil
+	}
+	desc, err := r.remoteGetFn(repoRef, opts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting artifact descriptor for tag %q from repo URL %s: %w",
+			tag, r.repoURL, err,
+		)
+	}
+	artifact, err := newArtifactFromManifest(desc.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing manifest for tag %q from repo URL %s: %w",
+			tag, r.repoURL, err,
+		)
+	}
+	artifact.Digest = desc.Digest.String()
+	r.cacheArtifact(ctx, *artifact)
+	artifact.Tag = tag
+	return artifact, nil
+}
+
+// getArtifactByDigest retrieves a generic Artifact for a given digest. This
+// function uses the same caches as getImageByDigest since information
+// retrieved by digest will never change. Concurrent requests for the same
+// Artifact are deduplicated.
+func (r *repositoryClient) getArtifactByDigest(
+	ctx context.Context,
+	digest string,
+) (*Artifact, error) {
+	logger := logging.LoggerFromContext(ctx)
+	logger.Trace(
+		"retrieving artifact",
+		"digest", digest,
+	)
+
+	if entry, exists := r.registry.imageCache.Get(artifactMemoryCacheKey(digest)); exists {
+		metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheArtifact)
+		artifact := entry.(Artifact) // nolint: forcetypeassert
+		return &artifact, nil
+	}
+
+	if persistentCache != nil {
+		if artifact, exists := persistentCache.getArtifact(r.artifactCacheKey(digest)); exists {
+			metrics.RecordDiscoveryCacheHit(metrics.DiscoveryCacheArtifact)
+			r.registry.imageCache.Set(
+				artifactMemoryCacheKey(digest),
+				*artifact,
+				cache.DefaultExpiration,
+			)
+			return artifact, nil
+		}
+	}
+
+	logger.Trace(
+		"artifact NOT found in cache",
+		"digest", digest,
+	)
+	metrics.RecordDiscoveryCacheMiss(metrics.DiscoveryCacheArtifact)
+
+	res, err, _ := artifactsGroup.Do(r.artifactCacheKey(digest), func() (any, error) {
+		repoRef := r.repoRef.Context().Digest(digest)
+		opts := append(r.remoteOptions, remote.WithContext(ctx))
+		desc, err := r.remoteGetFn(repoRef, opts...)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting artifact descriptor for digest %s from repo URL %s: %w",
+				digest, r.repoURL, err,
+			)
+		}
+		artifact, err := newArtifactFromManifest(desc.Manifest)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error parsing manifest for digest %s from repo URL %s: %w",
+				digest, r.repoURL, err,
+			)
+		}
+		artifact.Digest = digest
+		return artifact, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	artifact := *res.(*Artifact) // nolint: forcetypeassert
+	r.cacheArtifact(ctx, artifact)
+
+	// The result may be shared with other callers, so return a copy.
+	return &artifact, nil
+}
+
+// cacheArtifact caches the provided Artifact by digest in the in-memory and,
+// if enabled, persistent caches. Tags are mutable, so they are never cached.
+func (r *repositoryClient) cacheArtifact(ctx context.Context, artifact Artifact) {
+	artifact.Tag = ""
+	r.registry.imageCache.Set(
+		artifactMemoryCacheKey(artifact.Digest),
+		artifact,
+		cache.DefaultExpiration,
+	)
+	if persistentCache != nil {
+		if err := persistentCache.setArtifact(r.artifactCacheKey(artifact.Digest), artifact); err != nil {
+			// Failing to persist the artifact is not fatal.
+			logging.LoggerFromContext(ctx).Error(
+				err, "error persisting artifact metadata",
+				"digest", artifact.Digest,
+			)
+		}
+	}
+	logging.LoggerFromContext(ctx).Trace(
+		"cached artifact",
+		"digest", artifact.Digest,
+	)
+}
+
+// artifactMemoryCacheKey returns the key under which the Artifact with the
+// given digest is stored in the registry's in-memory cache. It is distinct
+// from the key of an Image with the same digest, as both share that cache.
+func artifactMemoryCacheKey(digest string) string {
+	return "artifact\x00" + digest
+}
+
+// artifactCacheKey returns the key under which the Artifact with the given
+// digest is stored in the persistent cache and under which concurrent
+// requests for it are deduplicated.
+func (r *repositoryClient) artifactCacheKey(digest string) string {
+	return fmt.Sprintf("%s\x00%s\x00artifact", r.cacheKey, digest)
+}
+
+// newArtifactFromManifest returns an Artifact populated with the type and
+// annotations found in the given raw manifest or index. The manifest is parsed
+// directly because v1.Manifest does not expose the artifactType field.
+func newArtifactFromManifest(rawManifest []byte) (*Artifact, error) {
+	manifest := struct {
+		ArtifactType string `json:"artifactType"`
+		Config       *struct {
+			MediaType types.MediaType `json:"mediaType"`
+		} `json:"config"`
+		Annotations map[string]string `json:"annotations"`
+	}{}
+	if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+		return nil, err
+	}
+	artifact := &Artifact{
+		ArtifactType: manifest.ArtifactType,
+		Annotations:  manifest.Annotations,
+	}
+	if artifact.ArtifactType == "" && manifest.Config != nil {
+		artifact.ArtifactType = string(manifest.Config.MediaType)
+	}
+	return artifact, nil
+}
+
+// getSignatures retrieves the Cosign signatures of the image with the given
+// digest. It is valid for this function to return no signatures if the image
+// is unsigned.
+func (r *repositoryClient) getSignatures(
+	ctx context.Context,
+	digest string,
+) ([]signature, error) {
+	layers, err := r.getCosignLayers(
+		ctx,
+		digest,
+		cosignSignatureTagSuffix,
+		cosignSimpleSigningMediaType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting signatures: %w", err)
+	}
+	sigs := make([]signature, 0, len(layers))
+	for _, layer := range layers {
+		sig := signature{
+			payload:   layer.content,
+			signature: layer.annotations[cosignSignatureAnnotation],
+		}
+		sig.certificate, sig.chain, sig.bundle = layer.keylessMaterial()
+		sigs = append(sigs, sig)
+	}
+	return sigs, nil
+}
+
+// getAttestations retrieves the Cosign attestations of the image with the
+// given digest. It is valid for this function to return no attestations if the
+// image has none.
+func (r *repositoryClient) getAttestations(
+	ctx context.Context,
+	digest string,
+) ([]attestation, error) {
+	layers, err := r.getCosignLayers(
+		ctx,
+		digest,
+		cosignAttestationTagSuffix,
+		dsseEnvelopeMediaType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting attestations: %w", err)
+	}
+	atts := make([]attestation, 0, len(layers))
+	for _, layer := range layers {
+		att := attestation{envelope: layer.content}
+		att.certificate, att.chain, att.bundle = layer.keylessMaterial()
+		atts = append(atts, att)
+	}
+	return atts, nil
+}
+
+// cosignLayer is a layer of a manifest Cosign stores signatures or
+// attestations in.
+type cosignLayer struct {
+	content     []byte
+	annotations map[string]string
+}
+
+// keylessMaterial returns the signing certificate, certificate chain and
+// transparency log bundle recorded in the layer's annotations, if any.
+func (l cosignLayer) keylessMaterial() (certificate, chain, bundle []byte) {
+	if cert, ok := l.annotations[cosignCertificateAnnotation]; ok {
+		certificate = []byte(cert)
+	}
+	if c, ok := l.annotations[cosignChainAnnotation]; ok {
+		chain = []byte(c)
+	}
+	if b, ok := l.annotations[cosignBundleAnnotation]; ok {
+		bundle = []byte(b)
+	}
+	return certificate, chain, bundle
+}
+
+// getCosignLayers retrieves the layers of the given media type from the
+// manifest Cosign stores under the tag derived from the given digest and tag
+// suffix. If no such manifest exists, no layers are returned.
+func (r *repositoryClient) getCosignLayers(
+	ctx context.Context,
+	digest string,
+	tagSuffix string,
+	mediaType types.MediaType,
+) ([]cosignLayer, error) {
+	hash, err := v1.NewHash(digest)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing digest %s: %w", digest, err)
+	}
+	ref := r.repoRef.Context().Tag(
+		fmt.Sprintf("%s-%s%s", hash.Algorithm, hash.Hex, tagSuffix),
+	)
+	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	img, err := r.remoteImageFn(ref, opts...)
+	if err != nil {
+		var te *transport.Error
+		if errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting %s for digest %s from repo URL %s: %w",
+			ref.TagStr(), digest, r.repoURL, err,
+		)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting manifest of %s for digest %s: %w",
+			ref.TagStr(), digest, err,
+		)
+	}
+	layers := make([]cosignLayer, 0, len(manifest.Layers))
+	for _, desc := range manifest.Layers {
+		if desc.MediaType != mediaType {
+			continue
+		}
+		content, err := readLayer(img, desc.Digest)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error reading layer %s for digest %s: %w",
+				desc.Digest, digest, err,
+			)
+		}
+		layers = append(layers, cosignLayer{
+			content:     content,
+			annotations: desc.Annotations,
+		})
+	}
+	return layers, nil
+}
+
+// readLayer reads the contents of the layer with the given digest from the
+// given v1.Image.
+func readLayer(img v1.Image, digest v1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, maxSignaturePayloadSize))
+}
+
 // rateLimitedRoundTripper is a rate limited implementation of
 // http.RoundTripper.
 type rateLimitedRoundTripper struct {
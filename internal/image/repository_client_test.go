@@ -30,6 +30,8 @@ func TestNewRepository(t *testing.T) {
 	require.NotNil(t, client.getImageFromV1ImageFn)
 	require.NotNil(t, client.remoteListFn)
 	require.NotNil(t, client.remoteGetFn)
+	require.NotNil(t, client.remoteHeadFn)
+	require.NotEmpty(t, client.cacheKey)
 }
 
 func TestGetImageByTag(t *testing.T) {
@@ -514,6 +516,39 @@ func TestImageFromV1ImageIndex(t *testing.T) {
 				require.Equal(t, testImage, *img)
 			},
 		},
+		{
+			name: "index annotations take precedence",
+			idx: &mockImageIndex{
+				indexManifest: &v1.IndexManifest{
+					Annotations: map[string]string{"foo": "index", "bar": "index"},
+					Manifests: []v1.Descriptor{{
+						Platform: &v1.Platform{
+							OS:           "linux",
+							Architecture: "amd64",
+						},
+					}},
+				},
+			},
+			client: &repositoryClient{
+				getImageByDigestFn: func(
+					context.Context, string, *platformConstraint,
+				) (*Image, error) {
+					img := testImage
+					img.Labels = map[string]string{"ci.passed": "true"}
+					img.Annotations = map[string]string{"foo": "image", "baz": "image"}
+					return &img, nil
+				},
+			},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"ci.passed": "true"}, img.Labels)
+				require.Equal(
+					t,
+					map[string]string{"foo": "index", "bar": "index", "baz": "image"},
+					img.Annotations,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -542,6 +577,7 @@ func TestGetImageFromV1Image(t *testing.T) {
 			name: "no platform constraint",
 			img: &mockImage{
 				configFile: &v1.ConfigFile{},
+				manifest:   &v1.Manifest{},
 			},
 			client: &repositoryClient{},
 			assertions: func(t *testing.T, img *Image, err error) {
@@ -576,6 +612,7 @@ func TestGetImageFromV1Image(t *testing.T) {
 					OS:           "linux",
 					Architecture: "amd64",
 				},
+				manifest: &v1.Manifest{},
 			},
 			platform: &platformConstraint{
 				os:   "linux",
@@ -589,6 +626,41 @@ func TestGetImageFromV1Image(t *testing.T) {
 				require.NotNil(t, img.CreatedAt)
 			},
 		},
+		{
+			name: "error getting manifest",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.ErrorContains(t, err, "error getting manifest for image")
+			},
+		},
+		{
+			name: "labels and annotations",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{
+					Config: v1.Config{
+						Labels: map[string]string{"ci.passed": "true"},
+					},
+				},
+				manifest: &v1.Manifest{
+					Annotations: map[string]string{
+						"org.opencontainers.image.source": "https://github.com/example/repo",
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"ci.passed": "true"}, img.Labels)
+				require.Equal(
+					t,
+					map[string]string{"org.opencontainers.image.source": "https://github.com/example/repo"},
+					img.Annotations,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -636,6 +708,7 @@ func (m *mockImageIndex) ImageIndex(v1.Hash) (v1.ImageIndex, error) {
 
 type mockImage struct {
 	configFile *v1.ConfigFile
+	manifest   *v1.Manifest
 }
 
 func (m *mockImage) Layers() ([]v1.Layer, error) {
@@ -667,7 +740,10 @@ func (m *mockImage) Digest() (v1.Hash, error) {
 }
 
 func (m *mockImage) Manifest() (*v1.Manifest, error) {
-	return nil, errNotImplemented
+	if m.manifest == nil {
+		return nil, errNotImplemented
+	}
+	return m.manifest, nil
 }
 
 func (m *mockImage) RawManifest() ([]byte, error) {
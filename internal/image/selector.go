@@ -65,6 +65,14 @@ type SelectorOptions struct {
 	// return nil.
 	Platform string
 	platform *platformConstraint
+	// LabelMatchExpressions is an optional list of expressions that the labels
+	// of an image's config must all satisfy for the image to be selected.
+	LabelMatchExpressions []MetadataMatchExpression
+	// AnnotationMatchExpressions is an optional list of expressions that the
+	// annotations of an image's manifest must all satisfy for the image to be
+	// selected.
+	AnnotationMatchExpressions []MetadataMatchExpression
+	metadata                   *metadataConstraint
 	// Creds holds optional credentials for authenticating to the image
 	// repository.
 	Creds *Credentials
@@ -73,9 +81,17 @@ type SelectorOptions struct {
 	InsecureSkipTLSVerify bool
 	// DiscoveryLimit is an optional limit on the number of images that can be
 	// discovered by the Selector. The limit is applied after filtering images
-	// based on the AllowRegex and Ignore fields. If the limit is zero, all
-	// discovered images will be returned.
+	// based on the AllowRegex and Ignore fields, and only to images that passed
+	// verification. If the limit is zero, all discovered images will be
+	// returned.
 	DiscoveryLimit int
+	// Verification holds optional options for verifying the signatures of
+	// discovered images. If specified, images that fail verification are still
+	// returned by Selector implementations, but with their VerificationFailure
+	// field set to explain why. Such images do not count toward the
+	// DiscoveryLimit, but no more of them than the DiscoveryLimit are returned.
+	Verification *VerificationOptions
+	verifier     *signatureVerifier
 }
 
 // NewSelector returns some implementation of the Selector interface that
@@ -108,6 +124,23 @@ func NewSelector(
 		}
 	}
 
+	if len(opts.LabelMatchExpressions) > 0 || len(opts.AnnotationMatchExpressions) > 0 {
+		var err error
+		if opts.metadata, err = newMetadataConstraint(
+			opts.LabelMatchExpressions,
+			opts.AnnotationMatchExpressions,
+		); err != nil {
+			return nil, fmt.Errorf("error parsing metadata constraint: %w", err)
+		}
+	}
+
+	if opts.Verification != nil {
+		var err error
+		if opts.verifier, err = newSignatureVerifier(opts.Verification); err != nil {
+			return nil, fmt.Errorf("error parsing verification options: %w", err)
+		}
+	}
+
 	repoClient, err := newRepositoryClient(repoURL, opts.InsecureSkipTLSVerify, opts.Creds)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -47,6 +47,7 @@ func (s *semVerSelector) Select(ctx context.Context) ([]Image, error) {
 		"image", s.repoClient.repoURL,
 		"selectionStrategy", SelectionStrategySemVer,
 		"platformConstrained", s.opts.platform != nil,
+		"metadataConstrained", s.opts.metadata != nil,
 		"discoveryLimit", s.opts.DiscoveryLimit,
 	)
 	logger.Trace("discovering images")
@@ -62,10 +63,10 @@ func (s *semVerSelector) Select(ctx context.Context) ([]Image, error) {
 	if limit == 0 || limit > len(images) {
 		limit = len(images)
 	}
-	discoveredImages := make([]Image, 0, limit)
+	discovered := newDiscoveredImages(limit)
 
 	for _, svImage := range images {
-		if len(discoveredImages) >= limit {
+		if discovered.full() {
 			break
 		}
 
@@ -80,25 +81,36 @@ func (s *semVerSelector) Select(ctx context.Context) ([]Image, error) {
 			)
 			continue
 		}
+		if !s.opts.metadata.apply(image) {
+			logger.Trace(
+				"image was found, but did not match metadata constraints",
+				"tag", svImage.Tag,
+			)
+			continue
+		}
+
+		if err = s.repoClient.verifyImage(ctx, image, s.opts.verifier); err != nil {
+			return nil, fmt.Errorf("error verifying image with tag %q: %w", svImage.Tag, err)
+		}
 
 		logger.Trace(
 			"discovered image",
 			"tag", image.Tag,
 			"digest", image.Digest,
 		)
-		discoveredImages = append(discoveredImages, *image)
+		discovered.add(*image)
 	}
 
-	if len(discoveredImages) == 0 {
+	if len(discovered.images) == 0 {
 		logger.Trace("no images matched criteria")
 		return nil, nil
 	}
 
 	logger.Trace(
 		"discovered images",
-		"count", len(discoveredImages),
+		"count", len(discovered.images),
 	)
-	return discoveredImages, nil
+	return discovered.images, nil
 }
 
 func (s *semVerSelector) selectImages(ctx context.Context) ([]Image, error) {
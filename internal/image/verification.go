@@ -0,0 +1,887 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// cosignSignatureTagSuffix is the suffix of the tag under which Cosign
+	// stores the signatures of an image. The full tag is derived from the
+	// image's digest, e.g. sha256-<hex>.sig.
+	cosignSignatureTagSuffix = ".sig"
+
+	// cosignSimpleSigningMediaType is the media type of the layers of a Cosign
+	// signature manifest. Each such layer holds a payload that was signed.
+	cosignSimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	// cosignSignatureType is the type of the payloads Cosign signs for container
+	// images.
+	cosignSignatureType = "cosign container image signature"
+
+	// cosignAttestationTagSuffix is the suffix of the tag under which Cosign
+	// stores the attestations of an image. The full tag is derived from the
+	// image's digest, e.g. sha256-<hex>.att.
+	cosignAttestationTagSuffix = ".att"
+
+	// dsseEnvelopeMediaType is the media type of the layers of a Cosign
+	// attestation manifest. Each such layer holds a DSSE envelope wrapping an
+	// in-toto statement.
+	dsseEnvelopeMediaType = "application/vnd.dsse.envelope.v1+json"
+
+	// inTotoPayloadType is the type of the payloads of the DSSE envelopes
+	// Cosign creates for attestations.
+	inTotoPayloadType = "application/vnd.in-toto+json"
+
+	cosignSignatureAnnotation   = "dev.cosignproject.cosign/signature"
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	cosignChainAnnotation       = "dev.sigstore.cosign/chain"
+	cosignBundleAnnotation      = "dev.sigstore.cosign/bundle"
+
+	// rekorHashedRekordKind is the kind of transparency log entry that records
+	// a signature over the hash of an artifact.
+	rekorHashedRekordKind = "hashedrekord"
+
+	// rekorInTotoKind is the kind of transparency log entry that records an
+	// in-toto attestation.
+	rekorInTotoKind = "intoto"
+
+	// maxSignaturePayloadSize is the maximum size of a signed payload that will
+	// be read from an image repository.
+	maxSignaturePayloadSize = 1 << 20
+)
+
+var (
+	// fulcioIssuerV1OID is the OID of the certificate extension in which older
+	// versions of Fulcio record the OIDC issuer as a raw string.
+	fulcioIssuerV1OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// fulcioIssuerV2OID is the OID of the certificate extension in which Fulcio
+	// records the OIDC issuer as a DER-encoded UTF8String.
+	fulcioIssuerV2OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// VerificationOptions represents options for verifying the Cosign signatures of
+// images discovered by a Selector. At least one of the PublicKeys or Keyless
+// fields must be specified. If both are specified, an image bearing a valid
+// signature satisfying either is considered verified.
+type VerificationOptions struct {
+	// PublicKeys is a list of PEM-encoded public keys. An image is considered
+	// verified if it bears a valid signature made with any one of them.
+	PublicKeys [][]byte
+	// Keyless holds options for verifying signatures made using ephemeral keys
+	// bound to an identity by a signing certificate.
+	Keyless *KeylessVerificationOptions
+	// AttestationPredicateTypes is an optional list of in-toto predicate types.
+	// If specified, an image is only considered verified if, in addition to a
+	// valid signature, it bears an attestation of each of these types signed
+	// in a way that satisfies PublicKeys or Keyless.
+	AttestationPredicateTypes []string
+}
+
+// KeylessVerificationOptions represents options for verifying "keyless" Cosign
+// signatures. Verification happens offline, using the signing certificate and
+// transparency log bundle attached to each signature.
+type KeylessVerificationOptions struct {
+	// Identities is a list of identities that are trusted to sign images. An
+	// image is considered verified if it bears a valid signature made by any one
+	// of them.
+	Identities []KeylessIdentity
+	// CertificateAuthorities holds the PEM-encoded certificates of the
+	// certificate authorities that are trusted to issue signing certificates.
+	CertificateAuthorities []byte
+	// TransparencyLogPublicKey is the PEM-encoded public key of the
+	// transparency log that is trusted to timestamp signatures.
+	TransparencyLogPublicKey []byte
+}
+
+// KeylessIdentity represents an identity that is trusted to sign images. One
+// of Issuer or IssuerRegex and one of Subject or SubjectRegex must be
+// specified.
+type KeylessIdentity struct {
+	// Issuer is the exact OIDC issuer that must have authenticated the signer.
+	Issuer string
+	// IssuerRegex is a regular expression the OIDC issuer that authenticated
+	// the signer must match.
+	IssuerRegex string
+	// Subject is the exact subject the signing certificate must have been
+	// issued to.
+	Subject string
+	// SubjectRegex is a regular expression the subject the signing certificate
+	// was issued to must match.
+	SubjectRegex string
+}
+
+// signature is a Cosign signature retrieved from an image repository.
+type signature struct {
+	// payload is the signed payload.
+	payload []byte
+	// signature is the base64-encoded signature over the payload.
+	signature string
+	// certificate is the PEM-encoded signing certificate, if any.
+	certificate []byte
+	// chain is the PEM-encoded certificate chain of the signing certificate,
+	// if any.
+	chain []byte
+	// bundle is the JSON-encoded transparency log bundle, if any.
+	bundle []byte
+}
+
+// attestation is a Cosign attestation retrieved from an image repository.
+type attestation struct {
+	// envelope is the JSON-encoded DSSE envelope wrapping the attestation.
+	envelope []byte
+	// certificate is the PEM-encoded signing certificate, if any.
+	certificate []byte
+	// chain is the PEM-encoded certificate chain of the signing certificate,
+	// if any.
+	chain []byte
+	// bundle is the JSON-encoded transparency log bundle, if any.
+	bundle []byte
+}
+
+// dsseEnvelope is a DSSE envelope wrapping a signed payload.
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []struct {
+		Sig string `json:"sig"`
+	} `json:"signatures"`
+}
+
+// inTotoStatement is the portion of an in-toto statement relevant to
+// verifying attestations.
+type inTotoStatement struct {
+	PredicateType string          `json:"predicateType"`
+	Subject       []inTotoSubject `json:"subject"`
+}
+
+// inTotoSubject is an artifact an in-toto statement is about.
+type inTotoSubject struct {
+	Digest map[string]string `json:"digest"`
+}
+
+// signedPayload is the payload Cosign signs for container images.
+type signedPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// transparencyLogBundle is the transparency log bundle Cosign attaches to
+// keyless signatures.
+type transparencyLogBundle struct {
+	SignedEntryTimestamp []byte                       `json:"SignedEntryTimestamp"`
+	Payload              transparencyLogBundlePayload `json:"Payload"`
+}
+
+// transparencyLogBundlePayload is the portion of a transparencyLogBundle that
+// is signed by the transparency log. The order of the fields matters, as the
+// signature is made over the canonical JSON encoding of this struct.
+type transparencyLogBundlePayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// hashedRekordEntry is a transparency log entry recording a signature over
+// the hash of an artifact.
+type hashedRekordEntry struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   string `json:"content"`
+			PublicKey struct {
+				Content string `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// inTotoEntry is a transparency log entry recording an in-toto attestation.
+type inTotoEntry struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Content struct {
+			Envelope struct {
+				Signatures []struct {
+					PublicKey string `json:"publicKey"`
+				} `json:"signatures"`
+			} `json:"envelope"`
+			PayloadHash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"payloadHash"`
+		} `json:"content"`
+	} `json:"spec"`
+}
+
+// errPredicateTypeMismatch is returned when an attestation is not of the
+// predicate type being verified.
+var errPredicateTypeMismatch = errors.New("attestation is of another predicate type")
+
+// signatureVerifier verifies Cosign signatures and attestations of images.
+type signatureVerifier struct {
+	publicKeys     []crypto.PublicKey
+	keyless        *keylessVerifier
+	predicateTypes []string
+}
+
+// keylessVerifier verifies keyless Cosign signatures.
+type keylessVerifier struct {
+	identities []identityMatcher
+	roots      *x509.CertPool
+	logKey     crypto.PublicKey
+}
+
+// identityMatcher matches the identity a signing certificate was issued to.
+type identityMatcher struct {
+	issuer       string
+	issuerRegex  *regexp.Regexp
+	subject      string
+	subjectRegex *regexp.Regexp
+}
+
+// newSignatureVerifier returns a signatureVerifier initialized from the
+// provided VerificationOptions.
+func newSignatureVerifier(opts *VerificationOptions) (*signatureVerifier, error) {
+	if len(opts.PublicKeys) == 0 && opts.Keyless == nil {
+		return nil, errors.New("at least one public key or keyless verification options must be specified")
+	}
+	v := &signatureVerifier{
+		publicKeys:     make([]crypto.PublicKey, 0, len(opts.PublicKeys)),
+		predicateTypes: opts.AttestationPredicateTypes,
+	}
+	for i, keyPEM := range opts.PublicKeys {
+		key, err := parsePublicKey(keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key %d: %w", i, err)
+		}
+		v.publicKeys = append(v.publicKeys, key)
+	}
+	if opts.Keyless != nil {
+		var err error
+		if v.keyless, err = newKeylessVerifier(opts.Keyless); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// newKeylessVerifier returns a keylessVerifier initialized from the provided
+// KeylessVerificationOptions.
+func newKeylessVerifier(opts *KeylessVerificationOptions) (*keylessVerifier, error) {
+	if len(opts.Identities) == 0 {
+		return nil, errors.New("at least one identity must be specified for keyless verification")
+	}
+	v := &keylessVerifier{
+		identities: make([]identityMatcher, 0, len(opts.Identities)),
+		roots:      x509.NewCertPool(),
+	}
+	for i, identity := range opts.Identities {
+		matcher, err := newIdentityMatcher(identity)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing identity %d: %w", i, err)
+		}
+		v.identities = append(v.identities, matcher)
+	}
+	if !v.roots.AppendCertsFromPEM(opts.CertificateAuthorities) {
+		return nil, errors.New("no valid certificate authority certificates found")
+	}
+	var err error
+	if v.logKey, err = parsePublicKey(opts.TransparencyLogPublicKey); err != nil {
+		return nil, fmt.Errorf("error parsing transparency log public key: %w", err)
+	}
+	return v, nil
+}
+
+// newIdentityMatcher returns an identityMatcher for the provided
+// KeylessIdentity.
+func newIdentityMatcher(identity KeylessIdentity) (identityMatcher, error) {
+	m := identityMatcher{
+		issuer:  identity.Issuer,
+		subject: identity.Subject,
+	}
+	if identity.Issuer == "" && identity.IssuerRegex == "" {
+		return m, errors.New("one of issuer or issuer regex must be specified")
+	}
+	if identity.Subject == "" && identity.SubjectRegex == "" {
+		return m, errors.New("one of subject or subject regex must be specified")
+	}
+	var err error
+	if identity.IssuerRegex != "" {
+		if m.issuerRegex, err = regexp.Compile(identity.IssuerRegex); err != nil {
+			return m, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				identity.IssuerRegex,
+				err,
+			)
+		}
+	}
+	if identity.SubjectRegex != "" {
+		if m.subjectRegex, err = regexp.Compile(identity.SubjectRegex); err != nil {
+			return m, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				identity.SubjectRegex,
+				err,
+			)
+		}
+	}
+	return m, nil
+}
+
+// matches returns true if the provided issuer and any one of the provided
+// subjects satisfy the identityMatcher. It returns false otherwise.
+func (m identityMatcher) matches(issuer string, subjects []string) bool {
+	if m.issuer != "" && m.issuer != issuer {
+		return false
+	}
+	if m.issuerRegex != nil && !m.issuerRegex.MatchString(issuer) {
+		return false
+	}
+	return slices.ContainsFunc(subjects, func(subject string) bool {
+		if m.subject != "" && m.subject != subject {
+			return false
+		}
+		return m.subjectRegex == nil || m.subjectRegex.MatchString(subject)
+	})
+}
+
+// verifyImage verifies the Cosign signatures and, if required, attestations
+// of the provided Image. If the Image fails verification, its
+// VerificationFailure field is set to explain why. An error is only returned
+// if the signatures or attestations could not be retrieved.
+func (r *repositoryClient) verifyImage(
+	ctx context.Context,
+	img *Image,
+	verifier *signatureVerifier,
+) error {
+	if verifier == nil {
+		return nil
+	}
+	logger := logging.LoggerFromContext(ctx)
+	sigs, err := r.getSignaturesFn(ctx, img.Digest)
+	if err != nil {
+		return fmt.Errorf(
+			"error retrieving signatures for image with digest %s: %w",
+			img.Digest,
+			err,
+		)
+	}
+	err = verifier.verify(img.Digest, sigs)
+	if err == nil && len(verifier.predicateTypes) > 0 {
+		var atts []attestation
+		if atts, err = r.getAttestationsFn(ctx, img.Digest); err != nil {
+			return fmt.Errorf(
+				"error retrieving attestations for image with digest %s: %w",
+				img.Digest,
+				err,
+			)
+		}
+		err = verifier.verifyAttestations(img.Digest, atts)
+	}
+	if err != nil {
+		img.VerificationFailure = err.Error()
+		logger.Trace(
+			"image failed verification",
+			"tag", img.Tag,
+			"digest", img.Digest,
+			"reason", img.VerificationFailure,
+		)
+		return nil
+	}
+	logger.Trace(
+		"image passed verification",
+		"tag", img.Tag,
+		"digest", img.Digest,
+	)
+	return nil
+}
+
+// verify returns nil if any one of the provided signatures is a valid
+// signature of the image with the provided digest. It returns an error
+// explaining why verification failed otherwise.
+func (v *signatureVerifier) verify(digest string, sigs []signature) error {
+	if len(sigs) == 0 {
+		return errors.New("no signatures found")
+	}
+	errs := make([]error, 0, len(sigs))
+	for _, sig := range sigs {
+		err := v.verifySignature(digest, sig)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return fmt.Errorf("none of %d signatures could be verified: %w", len(sigs), errors.Join(errs...))
+}
+
+// verifySignature returns nil if the provided signature is a valid signature
+// of the image with the provided digest. It returns an error otherwise.
+func (v *signatureVerifier) verifySignature(digest string, sig signature) error {
+	var payload signedPayload
+	if err := json.Unmarshal(sig.payload, &payload); err != nil {
+		return fmt.Errorf("error unmarshaling signed payload: %w", err)
+	}
+	if payload.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("unexpected signed payload type %q", payload.Critical.Type)
+	}
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf(
+			"signed payload is for digest %s",
+			payload.Critical.Image.DockerManifestDigest,
+		)
+	}
+	rawSig, err := base64.StdEncoding.DecodeString(sig.signature)
+	if err != nil {
+		return fmt.Errorf("error decoding signature: %w", err)
+	}
+	return v.verifySignedMessage(sig, rawSig, matchHashedRekordEntry(sig, rawSig))
+}
+
+// verifyAttestations returns nil if, for each required predicate type, any
+// one of the provided attestations is a valid attestation of that type of the
+// image with the provided digest. It returns an error explaining why
+// verification failed otherwise.
+func (v *signatureVerifier) verifyAttestations(digest string, atts []attestation) error {
+	for _, predicateType := range v.predicateTypes {
+		var errs []error
+		verified := false
+		for _, att := range atts {
+			err := v.verifyAttestation(digest, predicateType, att)
+			if err == nil {
+				verified = true
+				break
+			}
+			if !errors.Is(err, errPredicateTypeMismatch) {
+				errs = append(errs, err)
+			}
+		}
+		switch {
+		case verified:
+		case len(errs) == 0:
+			return fmt.Errorf("no %q attestations found", predicateType)
+		default:
+			return fmt.Errorf(
+				"none of %d %q attestations could be verified: %w",
+				len(errs),
+				predicateType,
+				errors.Join(errs...),
+			)
+		}
+	}
+	return nil
+}
+
+// verifyAttestation returns nil if the provided attestation is a valid
+// attestation of the provided predicate type of the image with the provided
+// digest. It returns an error wrapping errPredicateTypeMismatch if the
+// attestation is of another predicate type, and another error otherwise.
+func (v *signatureVerifier) verifyAttestation(
+	digest string,
+	predicateType string,
+	att attestation,
+) error {
+	var envelope dsseEnvelope
+	if err := json.Unmarshal(att.envelope, &envelope); err != nil {
+		return fmt.Errorf("error unmarshaling attestation envelope: %w", err)
+	}
+	if envelope.PayloadType != inTotoPayloadType {
+		return fmt.Errorf("unexpected attestation payload type %q", envelope.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return fmt.Errorf("error decoding attestation payload: %w", err)
+	}
+	var statement inTotoStatement
+	if err = json.Unmarshal(payload, &statement); err != nil {
+		return fmt.Errorf("error unmarshaling attestation statement: %w", err)
+	}
+	if statement.PredicateType != predicateType {
+		return errPredicateTypeMismatch
+	}
+	hash, err := v1.NewHash(digest)
+	if err != nil {
+		return fmt.Errorf("error parsing digest %s: %w", digest, err)
+	}
+	if !slices.ContainsFunc(statement.Subject, func(subject inTotoSubject) bool {
+		return subject.Digest[hash.Algorithm] == hash.Hex
+	}) {
+		return fmt.Errorf("attestation is not about digest %s", digest)
+	}
+	if len(envelope.Signatures) == 0 {
+		return errors.New("attestation is not signed")
+	}
+	// Signatures over a DSSE envelope are made over its pre-authentication
+	// encoding rather than over its payload.
+	sig := signature{
+		payload:     dssePreAuthEncoding(envelope.PayloadType, payload),
+		certificate: att.certificate,
+		chain:       att.chain,
+		bundle:      att.bundle,
+	}
+	errs := make([]error, 0, len(envelope.Signatures))
+	for _, envelopeSig := range envelope.Signatures {
+		rawSig, err := base64.StdEncoding.DecodeString(envelopeSig.Sig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error decoding attestation signature: %w", err))
+			continue
+		}
+		if err = v.verifySignedMessage(
+			sig,
+			rawSig,
+			matchInTotoEntry(payload, att.certificate),
+		); err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// verifySignedMessage returns nil if the provided raw signature over the
+// payload of the provided signature was made with a trusted public key or by a
+// trusted keyless identity. For the latter, the provided function is used to
+// verify that the transparency log entry in the signature's bundle records the
+// signature.
+func (v *signatureVerifier) verifySignedMessage(
+	sig signature,
+	rawSig []byte,
+	matchEntry func(body []byte) error,
+) error {
+	if len(v.publicKeys) > 0 {
+		for _, key := range v.publicKeys {
+			if err := verifyRawSignature(key, sig.payload, rawSig); err == nil {
+				return nil
+			}
+		}
+		if v.keyless == nil || sig.certificate == nil {
+			return errors.New("signature was not made with any trusted public key")
+		}
+	}
+	return v.keyless.verify(sig, rawSig, matchEntry)
+}
+
+// dssePreAuthEncoding returns the DSSE pre-authentication encoding of the
+// provided payload type and payload, which is what DSSE signatures are made
+// over.
+func dssePreAuthEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf(
+		"DSSEv1 %d %s %d %s",
+		len(payloadType),
+		payloadType,
+		len(payload),
+		payload,
+	))
+}
+
+// verify returns nil if the provided keyless signature was made by a trusted
+// identity, using a certificate issued by a trusted certificate authority, and
+// was timestamped by the trusted transparency log while that certificate was
+// valid. It returns an error otherwise. The provided function is used to
+// verify that the transparency log entry in the signature's bundle records
+// the signature.
+func (v *keylessVerifier) verify(
+	sig signature,
+	rawSig []byte,
+	matchEntry func(body []byte) error,
+) error {
+	if sig.certificate == nil {
+		return errors.New("signature has no signing certificate")
+	}
+	if sig.bundle == nil {
+		return errors.New("signature has no transparency log bundle")
+	}
+	certs, err := parseCertificates(sig.certificate)
+	if err != nil {
+		return fmt.Errorf("error parsing signing certificate: %w", err)
+	}
+	cert := certs[0]
+
+	integratedTime, err := v.verifyBundle(sig, matchEntry)
+	if err != nil {
+		return err
+	}
+
+	intermediates := x509.NewCertPool()
+	if sig.chain != nil {
+		chain, err := parseCertificates(sig.chain)
+		if err != nil {
+			return fmt.Errorf("error parsing certificate chain: %w", err)
+		}
+		for _, c := range chain {
+			intermediates.AddCert(c)
+		}
+	}
+	if _, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   integratedTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("signing certificate is not trusted: %w", err)
+	}
+
+	if err = verifyRawSignature(cert.PublicKey, sig.payload, rawSig); err != nil {
+		return err
+	}
+
+	issuer := certificateIssuer(cert)
+	subjects := certificateSubjects(cert)
+	for _, identity := range v.identities {
+		if identity.matches(issuer, subjects) {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"signing certificate identity %v issued by %q is not trusted",
+		subjects,
+		issuer,
+	)
+}
+
+// verifyBundle verifies that the transparency log bundle attached to the
+// provided signature was signed by the trusted transparency log and, using the
+// provided function, that it records the signature. It returns the time at
+// which the signature was integrated into the transparency log.
+func (v *keylessVerifier) verifyBundle(
+	sig signature,
+	matchEntry func(body []byte) error,
+) (time.Time, error) {
+	var bundle transparencyLogBundle
+	if err := json.Unmarshal(sig.bundle, &bundle); err != nil {
+		return time.Time{}, fmt.Errorf("error unmarshaling transparency log bundle: %w", err)
+	}
+	signed, err := json.Marshal(bundle.Payload)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error marshaling transparency log bundle payload: %w", err)
+	}
+	if err = verifyRawSignature(v.logKey, signed, bundle.SignedEntryTimestamp); err != nil {
+		return time.Time{}, fmt.Errorf("transparency log bundle is not trusted: %w", err)
+	}
+
+	body, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error decoding transparency log entry: %w", err)
+	}
+	if err = matchEntry(body); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(bundle.Payload.IntegratedTime, 0), nil
+}
+
+// matchHashedRekordEntry returns a function that verifies that a transparency
+// log entry records the provided signature of an image.
+func matchHashedRekordEntry(sig signature, rawSig []byte) func([]byte) error {
+	return func(body []byte) error {
+		var entry hashedRekordEntry
+		if err := json.Unmarshal(body, &entry); err != nil {
+			return fmt.Errorf("error unmarshaling transparency log entry: %w", err)
+		}
+		if entry.Kind != rekorHashedRekordKind {
+			return fmt.Errorf("unexpected transparency log entry kind %q", entry.Kind)
+		}
+		payloadHash := sha256.Sum256(sig.payload)
+		if entry.Spec.Data.Hash.Algorithm != "sha256" ||
+			entry.Spec.Data.Hash.Value != hex.EncodeToString(payloadHash[:]) {
+			return errors.New("transparency log entry does not match signed payload")
+		}
+		entrySig, err := base64.StdEncoding.DecodeString(entry.Spec.Signature.Content)
+		if err != nil || !bytes.Equal(entrySig, rawSig) {
+			return errors.New("transparency log entry does not match signature")
+		}
+		entryCert, err := base64.StdEncoding.DecodeString(entry.Spec.Signature.PublicKey.Content)
+		if err != nil || !bytes.Equal(bytes.TrimSpace(entryCert), bytes.TrimSpace(sig.certificate)) {
+			return errors.New("transparency log entry does not match signing certificate")
+		}
+		return nil
+	}
+}
+
+// matchInTotoEntry returns a function that verifies that a transparency log
+// entry records an attestation with the provided payload, signed using the
+// provided certificate.
+func matchInTotoEntry(payload, certificate []byte) func([]byte) error {
+	return func(body []byte) error {
+		var entry inTotoEntry
+		if err := json.Unmarshal(body, &entry); err != nil {
+			return fmt.Errorf("error unmarshaling transparency log entry: %w", err)
+		}
+		if entry.Kind != rekorInTotoKind {
+			return fmt.Errorf("unexpected transparency log entry kind %q", entry.Kind)
+		}
+		payloadHash := sha256.Sum256(payload)
+		if entry.Spec.Content.PayloadHash.Algorithm != "sha256" ||
+			entry.Spec.Content.PayloadHash.Value != hex.EncodeToString(payloadHash[:]) {
+			return errors.New("transparency log entry does not match attestation payload")
+		}
+		for _, entrySig := range entry.Spec.Content.Envelope.Signatures {
+			entryCert, err := base64.StdEncoding.DecodeString(entrySig.PublicKey)
+			if err == nil && bytes.Equal(bytes.TrimSpace(entryCert), bytes.TrimSpace(certificate)) {
+				return nil
+			}
+		}
+		return errors.New("transparency log entry does not match signing certificate")
+	}
+}
+
+// verifyRawSignature verifies that the provided signature is a valid signature
+// over the provided message made with the private key corresponding to the
+// provided public key.
+func verifyRawSignature(key crypto.PublicKey, message, sig []byte) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, message, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// parsePublicKey parses a PEM-encoded PKIX public key.
+func parsePublicKey(keyPEM []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// parseCertificates parses one or more PEM-encoded certificates.
+func parseCertificates(certsPEM []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		if block, certsPEM = pem.Decode(certsPEM); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates found")
+	}
+	return certs, nil
+}
+
+// certificateIssuer returns the OIDC issuer recorded in the provided signing
+// certificate, if any.
+func certificateIssuer(cert *x509.Certificate) string {
+	var issuer string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(fulcioIssuerV2OID):
+			var v2Issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &v2Issuer); err == nil {
+				return v2Issuer
+			}
+		case ext.Id.Equal(fulcioIssuerV1OID):
+			issuer = string(ext.Value)
+		}
+	}
+	return issuer
+}
+
+// certificateSubjects returns the subjects the provided signing certificate
+// was issued to.
+func certificateSubjects(cert *x509.Certificate) []string {
+	subjects := make([]string, 0, len(cert.EmailAddresses)+len(cert.URIs))
+	subjects = append(subjects, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjects = append(subjects, uri.String())
+	}
+	return subjects
+}
+
+// discoveredImages accumulates the images discovered by a Selector. The
+// Selector's DiscoveryLimit applies only to images that passed verification,
+// so images failing it never crowd out those that could become part of
+// Freight. Images failing verification are kept as well, up to the same limit,
+// so that their failures can be reported.
+type discoveredImages struct {
+	limit    int
+	verified int
+	failed   int
+	images   []Image
+}
+
+// newDiscoveredImages returns a discoveredImages that accumulates up to the
+// provided number of verified images.
+func newDiscoveredImages(limit int) *discoveredImages {
+	return &discoveredImages{
+		limit:  limit,
+		images: make([]Image, 0, limit),
+	}
+}
+
+// full returns true if the limit on the number of verified images has been
+// reached.
+func (d *discoveredImages) full() bool {
+	return d.verified >= d.limit
+}
+
+// add adds the provided Image, unless it failed verification and the limit on
+// the number of images failing verification has been reached.
+func (d *discoveredImages) add(img Image) {
+	if img.VerificationFailure != "" {
+		if d.failed >= d.limit {
+			return
+		}
+		d.failed++
+	} else {
+		d.verified++
+	}
+	d.images = append(d.images, img)
+}
@@ -0,0 +1,891 @@
+package image
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDigest        = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testIssuer        = "https://token.example.com"
+	testEmail         = "release-bot@example.com"
+	testPredicateType = "https://slsa.dev/provenance/v1"
+)
+
+func TestNewSignatureVerifier(t *testing.T) {
+	signer := newTestSigner(t)
+	testCases := []struct {
+		name       string
+		opts       *VerificationOptions
+		assertions func(*testing.T, *signatureVerifier, error)
+	}{
+		{
+			name: "no public keys or keyless options",
+			opts: &VerificationOptions{},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "at least one public key or keyless")
+			},
+		},
+		{
+			name: "invalid public key",
+			opts: &VerificationOptions{
+				PublicKeys: [][]byte{[]byte("not a key")},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing public key 0")
+			},
+		},
+		{
+			name: "keyless without identities",
+			opts: &VerificationOptions{
+				Keyless: &KeylessVerificationOptions{
+					CertificateAuthorities:   signer.caPEM,
+					TransparencyLogPublicKey: signer.logKeyPEM,
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "at least one identity")
+			},
+		},
+		{
+			name: "keyless identity without subject",
+			opts: &VerificationOptions{
+				Keyless: &KeylessVerificationOptions{
+					Identities:               []KeylessIdentity{{Issuer: testIssuer}},
+					CertificateAuthorities:   signer.caPEM,
+					TransparencyLogPublicKey: signer.logKeyPEM,
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "one of subject or subject regex")
+			},
+		},
+		{
+			name: "keyless identity with invalid regex",
+			opts: &VerificationOptions{
+				Keyless: &KeylessVerificationOptions{
+					Identities: []KeylessIdentity{{
+						Issuer:       testIssuer,
+						SubjectRegex: "(",
+					}},
+					CertificateAuthorities:   signer.caPEM,
+					TransparencyLogPublicKey: signer.logKeyPEM,
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name: "keyless without certificate authorities",
+			opts: &VerificationOptions{
+				Keyless: &KeylessVerificationOptions{
+					Identities:               []KeylessIdentity{{Issuer: testIssuer, Subject: testEmail}},
+					TransparencyLogPublicKey: signer.logKeyPEM,
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "no valid certificate authority certificates")
+			},
+		},
+		{
+			name: "keyless with invalid transparency log key",
+			opts: &VerificationOptions{
+				Keyless: &KeylessVerificationOptions{
+					Identities:             []KeylessIdentity{{Issuer: testIssuer, Subject: testEmail}},
+					CertificateAuthorities: signer.caPEM,
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing transparency log public key")
+			},
+		},
+		{
+			name: "success",
+			opts: &VerificationOptions{
+				PublicKeys: [][]byte{signer.keyPEM},
+				Keyless: &KeylessVerificationOptions{
+					Identities:               []KeylessIdentity{{IssuerRegex: ".*", Subject: testEmail}},
+					CertificateAuthorities:   signer.caPEM,
+					TransparencyLogPublicKey: signer.logKeyPEM,
+				},
+			},
+			assertions: func(t *testing.T, v *signatureVerifier, err error) {
+				require.NoError(t, err)
+				require.Len(t, v.publicKeys, 1)
+				require.NotNil(t, v.keyless)
+				require.Len(t, v.keyless.identities, 1)
+				require.NotNil(t, v.keyless.logKey)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v, err := newSignatureVerifier(testCase.opts)
+			testCase.assertions(t, v, err)
+		})
+	}
+}
+
+func TestIdentityMatcherMatches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		identity KeylessIdentity
+		issuer   string
+		subjects []string
+		matches  bool
+	}{
+		{
+			name:     "exact match",
+			identity: KeylessIdentity{Issuer: testIssuer, Subject: testEmail},
+			issuer:   testIssuer,
+			subjects: []string{testEmail},
+			matches:  true,
+		},
+		{
+			name:     "issuer mismatch",
+			identity: KeylessIdentity{Issuer: testIssuer, Subject: testEmail},
+			issuer:   "https://other.example.com",
+			subjects: []string{testEmail},
+		},
+		{
+			name:     "subject mismatch",
+			identity: KeylessIdentity{Issuer: testIssuer, Subject: testEmail},
+			issuer:   testIssuer,
+			subjects: []string{"someone-else@example.com"},
+		},
+		{
+			name: "regex match",
+			identity: KeylessIdentity{
+				IssuerRegex:  `^https://token\.`,
+				SubjectRegex: `^https://github\.com/example/.+$`,
+			},
+			issuer: testIssuer,
+			subjects: []string{
+				"someone@example.com",
+				"https://github.com/example/repo/.github/workflows/release.yaml@refs/heads/main",
+			},
+			matches: true,
+		},
+		{
+			name:     "regex mismatch",
+			identity: KeylessIdentity{Issuer: testIssuer, SubjectRegex: `@example\.org$`},
+			issuer:   testIssuer,
+			subjects: []string{testEmail},
+			matches:  false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m, err := newIdentityMatcher(testCase.identity)
+			require.NoError(t, err)
+			require.Equal(t, testCase.matches, m.matches(testCase.issuer, testCase.subjects))
+		})
+	}
+}
+
+func TestSignatureVerifierVerify(t *testing.T) {
+	signer := newTestSigner(t)
+	otherSigner := newTestSigner(t)
+
+	keyVerifier, err := newSignatureVerifier(&VerificationOptions{
+		PublicKeys: [][]byte{signer.keyPEM},
+	})
+	require.NoError(t, err)
+
+	keylessVerifier, err := newSignatureVerifier(&VerificationOptions{
+		Keyless: &KeylessVerificationOptions{
+			Identities:               []KeylessIdentity{{Issuer: testIssuer, Subject: testEmail}},
+			CertificateAuthorities:   signer.caPEM,
+			TransparencyLogPublicKey: signer.logKeyPEM,
+		},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		verifier   *signatureVerifier
+		sigs       []signature
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:     "no signatures",
+			verifier: keyVerifier,
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, "no signatures found")
+			},
+		},
+		{
+			name:     "signature for another digest",
+			verifier: keyVerifier,
+			sigs: []signature{
+				signer.signWithKey(t, "sha256:"+strings.Repeat("f", 64)),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "signed payload is for digest")
+			},
+		},
+		{
+			name:     "signature made with untrusted key",
+			verifier: keyVerifier,
+			sigs:     []signature{otherSigner.signWithKey(t, testDigest)},
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, "signature was not made with any trusted public key")
+			},
+		},
+		{
+			name:     "signature made with trusted key",
+			verifier: keyVerifier,
+			sigs: []signature{
+				otherSigner.signWithKey(t, testDigest),
+				signer.signWithKey(t, testDigest),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "several invalid signatures",
+			verifier: keyVerifier,
+			sigs: []signature{
+				otherSigner.signWithKey(t, testDigest),
+				otherSigner.signWithKey(t, testDigest),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "none of 2 signatures could be verified")
+			},
+		},
+		{
+			name:     "keyless signature without certificate",
+			verifier: keylessVerifier,
+			sigs:     []signature{signer.signWithKey(t, testDigest)},
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, "signature has no signing certificate")
+			},
+		},
+		{
+			name:     "keyless signature without bundle",
+			verifier: keylessVerifier,
+			sigs: func() []signature {
+				sig := signer.signKeyless(t, testDigest, testEmail)
+				sig.bundle = nil
+				return []signature{sig}
+			}(),
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, "signature has no transparency log bundle")
+			},
+		},
+		{
+			name:     "keyless signature with bundle from untrusted log",
+			verifier: keylessVerifier,
+			sigs: func() []signature {
+				sig := signer.signKeyless(t, testDigest, testEmail)
+				sig.bundle = otherSigner.newBundle(t, sig)
+				return []signature{sig}
+			}(),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "transparency log bundle is not trusted")
+			},
+		},
+		{
+			name:     "keyless signature with bundle for another signature",
+			verifier: keylessVerifier,
+			sigs: func() []signature {
+				sig := signer.signKeyless(t, testDigest, testEmail)
+				other := signer.signKeyless(t, testDigest, testEmail)
+				sig.bundle = other.bundle
+				return []signature{sig}
+			}(),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "transparency log entry does not match")
+			},
+		},
+		{
+			name:     "keyless signature with certificate from untrusted authority",
+			verifier: keylessVerifier,
+			sigs: func() []signature {
+				sig := otherSigner.signKeyless(t, testDigest, testEmail)
+				sig.bundle = signer.newBundle(t, sig)
+				return []signature{sig}
+			}(),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "signing certificate is not trusted")
+			},
+		},
+		{
+			name:     "keyless signature by untrusted identity",
+			verifier: keylessVerifier,
+			sigs: []signature{
+				signer.signKeyless(t, testDigest, "someone-else@example.com"),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not trusted")
+				require.ErrorContains(t, err, "someone-else@example.com")
+			},
+		},
+		{
+			name:     "keyless signature by trusted identity",
+			verifier: keylessVerifier,
+			sigs:     []signature{signer.signKeyless(t, testDigest, testEmail)},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, testCase.verifier.verify(testDigest, testCase.sigs))
+		})
+	}
+}
+
+func TestSignatureVerifierVerifyAttestations(t *testing.T) {
+	signer := newTestSigner(t)
+	otherSigner := newTestSigner(t)
+
+	keyVerifier, err := newSignatureVerifier(&VerificationOptions{
+		PublicKeys:                [][]byte{signer.keyPEM},
+		AttestationPredicateTypes: []string{testPredicateType},
+	})
+	require.NoError(t, err)
+
+	keylessVerifier, err := newSignatureVerifier(&VerificationOptions{
+		Keyless: &KeylessVerificationOptions{
+			Identities:               []KeylessIdentity{{Issuer: testIssuer, Subject: testEmail}},
+			CertificateAuthorities:   signer.caPEM,
+			TransparencyLogPublicKey: signer.logKeyPEM,
+		},
+		AttestationPredicateTypes: []string{testPredicateType},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		verifier   *signatureVerifier
+		atts       []attestation
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:     "no attestations",
+			verifier: keyVerifier,
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, `no "https://slsa.dev/provenance/v1" attestations found`)
+			},
+		},
+		{
+			name:     "attestation of another predicate type",
+			verifier: keyVerifier,
+			atts: []attestation{
+				signer.attestWithKey(t, testDigest, "https://spdx.dev/Document"),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.EqualError(t, err, `no "https://slsa.dev/provenance/v1" attestations found`)
+			},
+		},
+		{
+			name:     "attestation about another digest",
+			verifier: keyVerifier,
+			atts: []attestation{
+				signer.attestWithKey(
+					t,
+					"sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210",
+					testPredicateType,
+				),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "attestation is not about digest")
+			},
+		},
+		{
+			name:     "attestation made with untrusted key",
+			verifier: keyVerifier,
+			atts: []attestation{
+				otherSigner.attestWithKey(t, testDigest, testPredicateType),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "signature was not made with any trusted public key")
+			},
+		},
+		{
+			name:     "attestation made with trusted key",
+			verifier: keyVerifier,
+			atts: []attestation{
+				signer.attestWithKey(t, testDigest, "https://spdx.dev/Document"),
+				signer.attestWithKey(t, testDigest, testPredicateType),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "keyless attestation by untrusted identity",
+			verifier: keylessVerifier,
+			atts: []attestation{
+				signer.attestKeyless(t, testDigest, testPredicateType, "someone@example.com"),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not trusted")
+			},
+		},
+		{
+			name:     "keyless attestation with bundle from untrusted log",
+			verifier: keylessVerifier,
+			atts: []attestation{
+				otherSigner.attestKeyless(t, testDigest, testPredicateType, testEmail),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "transparency log bundle is not trusted")
+			},
+		},
+		{
+			name:     "keyless attestation by trusted identity",
+			verifier: keylessVerifier,
+			atts: []attestation{
+				signer.attestKeyless(t, testDigest, testPredicateType, testEmail),
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.verifier.verifyAttestations(testDigest, testCase.atts),
+			)
+		})
+	}
+}
+
+func TestSelectWithVerification(t *testing.T) {
+	signer := newTestSigner(t)
+	otherSigner := newTestSigner(t)
+
+	srv := httptest.NewServer(ociregistry.New(ociregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(srv.Close)
+	repoURL := fmt.Sprintf("%s/test/image", strings.TrimPrefix(srv.URL, "http://"))
+
+	signedDigest := pushTestImage(t, repoURL, "v1.0.0")
+	pushTestSignature(t, repoURL, signedDigest, signer.signWithKey(t, signedDigest))
+
+	unsignedDigest := pushTestImage(t, repoURL, "v1.1.0")
+
+	otherDigest := pushTestImage(t, repoURL, "v1.2.0")
+	pushTestSignature(t, repoURL, otherDigest, otherSigner.signWithKey(t, otherDigest))
+
+	keylessDigest := pushTestImage(t, repoURL, "v1.3.0")
+	pushTestSignature(t, repoURL, keylessDigest, signer.signKeyless(t, keylessDigest, testEmail))
+
+	for _, strategy := range []SelectionStrategy{
+		SelectionStrategySemVer,
+		SelectionStrategyLexical,
+		SelectionStrategyNewestBuild,
+	} {
+		t.Run(string(strategy), func(t *testing.T) {
+			s, err := NewSelector(
+				repoURL,
+				strategy,
+				&SelectorOptions{
+					// Exclude the tags under which signatures are stored.
+					AllowRegex: `^v`,
+					Verification: &VerificationOptions{
+						PublicKeys: [][]byte{signer.keyPEM},
+						Keyless: &KeylessVerificationOptions{
+							Identities:               []KeylessIdentity{{Issuer: testIssuer, Subject: testEmail}},
+							CertificateAuthorities:   signer.caPEM,
+							TransparencyLogPublicKey: signer.logKeyPEM,
+						},
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			images, err := s.Select(context.Background())
+			require.NoError(t, err)
+			require.Len(t, images, 4)
+
+			failures := make(map[string]string, len(images))
+			for _, img := range images {
+				failures[img.Tag] = img.VerificationFailure
+			}
+			require.Empty(t, failures["v1.0.0"])
+			require.Equal(t, "no signatures found", failures["v1.1.0"])
+			require.Equal(t, "signature was not made with any trusted public key", failures["v1.2.0"])
+			require.Empty(t, failures["v1.3.0"])
+		})
+	}
+
+	t.Run(string(SelectionStrategyDigest), func(t *testing.T) {
+		s, err := NewSelector(
+			repoURL,
+			SelectionStrategyDigest,
+			&SelectorOptions{
+				Constraint: "v1.1.0",
+				Verification: &VerificationOptions{
+					PublicKeys: [][]byte{signer.keyPEM},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		images, err := s.Select(context.Background())
+		require.NoError(t, err)
+		require.Len(t, images, 1)
+		require.Equal(t, unsignedDigest, images[0].Digest)
+		require.Equal(t, "no signatures found", images[0].VerificationFailure)
+	})
+
+	t.Run("discovery limit applies to verified images only", func(t *testing.T) {
+		s, err := NewSelector(
+			repoURL,
+			SelectionStrategySemVer,
+			&SelectorOptions{
+				AllowRegex:     `^v`,
+				DiscoveryLimit: 1,
+				Verification: &VerificationOptions{
+					PublicKeys: [][]byte{signer.keyPEM},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		images, err := s.Select(context.Background())
+		require.NoError(t, err)
+		// v1.3.0 is signed keylessly and v1.2.0 with an untrusted key, so they
+		// fail verification. Only one of them is reported, and it does not keep
+		// v1.0.0 from being discovered.
+		require.Len(t, images, 2)
+		require.Equal(t, "v1.3.0", images[0].Tag)
+		require.NotEmpty(t, images[0].VerificationFailure)
+		require.Equal(t, "v1.0.0", images[1].Tag)
+		require.Empty(t, images[1].VerificationFailure)
+	})
+
+	t.Run("attestations", func(t *testing.T) {
+		attestedRepoURL := fmt.Sprintf("%s/test/attested", strings.TrimPrefix(srv.URL, "http://"))
+
+		attestedDigest := pushTestImage(t, attestedRepoURL, "v1.0.0")
+		pushTestSignature(t, attestedRepoURL, attestedDigest, signer.signWithKey(t, attestedDigest))
+		pushTestAttestation(
+			t,
+			attestedRepoURL,
+			attestedDigest,
+			signer.attestWithKey(t, attestedDigest, testPredicateType),
+		)
+
+		unattestedDigest := pushTestImage(t, attestedRepoURL, "v1.1.0")
+		pushTestSignature(t, attestedRepoURL, unattestedDigest, signer.signWithKey(t, unattestedDigest))
+
+		s, err := NewSelector(
+			attestedRepoURL,
+			SelectionStrategySemVer,
+			&SelectorOptions{
+				AllowRegex: `^v`,
+				Verification: &VerificationOptions{
+					PublicKeys:                [][]byte{signer.keyPEM},
+					AttestationPredicateTypes: []string{testPredicateType},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		images, err := s.Select(context.Background())
+		require.NoError(t, err)
+		require.Len(t, images, 2)
+		require.Equal(t, "v1.1.0", images[0].Tag)
+		require.Equal(
+			t,
+			`no "https://slsa.dev/provenance/v1" attestations found`,
+			images[0].VerificationFailure,
+		)
+		require.Equal(t, "v1.0.0", images[1].Tag)
+		require.Empty(t, images[1].VerificationFailure)
+	})
+}
+
+// testSigner creates Cosign-compatible signatures using keys and certificates
+// generated at test time.
+type testSigner struct {
+	key       *ecdsa.PrivateKey
+	keyPEM    []byte
+	ca        *x509.Certificate
+	caKey     *ecdsa.PrivateKey
+	caPEM     []byte
+	logKey    *ecdsa.PrivateKey
+	logKeyPEM []byte
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	s := &testSigner{
+		key:    newTestKey(t),
+		caKey:  newTestKey(t),
+		logKey: newTestKey(t),
+	}
+	s.keyPEM = encodeTestPublicKey(t, &s.key.PublicKey)
+	s.logKeyPEM = encodeTestPublicKey(t, &s.logKey.PublicKey)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &s.caKey.PublicKey, s.caKey)
+	require.NoError(t, err)
+	s.ca, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	s.caPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return s
+}
+
+func (s *testSigner) signWithKey(t *testing.T, digest string) signature {
+	payload := newTestPayload(t, digest)
+	return signature{
+		payload:   payload,
+		signature: signTestMessage(t, s.key, payload),
+	}
+}
+
+func (s *testSigner) signKeyless(t *testing.T, digest, email string) signature {
+	key := newTestKey(t)
+	payload := newTestPayload(t, digest)
+	sig := signature{
+		payload:     payload,
+		signature:   signTestMessage(t, key, payload),
+		certificate: s.newSigningCertificate(t, key, email),
+	}
+	sig.bundle = s.newBundle(t, sig)
+	return sig
+}
+
+func (s *testSigner) newSigningCertificate(t *testing.T, key *ecdsa.PrivateKey, email string) []byte {
+	issuerExt, err := asn1.MarshalWithParams(testIssuer, "utf8")
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(time.Now().UnixNano()),
+		NotBefore:      time.Now().Add(-time.Minute),
+		NotAfter:       time.Now().Add(10 * time.Minute),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses: []string{email},
+		ExtraExtensions: []pkix.Extension{{
+			Id:    fulcioIssuerV2OID,
+			Value: issuerExt,
+		}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.ca, &key.PublicKey, s.caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func (s *testSigner) newBundle(t *testing.T, sig signature) []byte {
+	var entry hashedRekordEntry
+	entry.Kind = rekorHashedRekordKind
+	payloadHash := sha256.Sum256(sig.payload)
+	entry.Spec.Data.Hash.Algorithm = "sha256"
+	entry.Spec.Data.Hash.Value = hex.EncodeToString(payloadHash[:])
+	entry.Spec.Signature.Content = sig.signature
+	entry.Spec.Signature.PublicKey.Content = base64.StdEncoding.EncodeToString(sig.certificate)
+	body, err := json.Marshal(entry)
+	require.NoError(t, err)
+	return s.newBundleForEntry(t, body)
+}
+
+func (s *testSigner) newBundleForEntry(t *testing.T, body []byte) []byte {
+	bundle := transparencyLogBundle{
+		Payload: transparencyLogBundlePayload{
+			Body:           base64.StdEncoding.EncodeToString(body),
+			IntegratedTime: time.Now().Unix(),
+			LogID:          "test-log",
+			LogIndex:       42,
+		},
+	}
+	signed, err := json.Marshal(bundle.Payload)
+	require.NoError(t, err)
+	bundle.SignedEntryTimestamp, err = base64.StdEncoding.DecodeString(
+		signTestMessage(t, s.logKey, signed),
+	)
+	require.NoError(t, err)
+	bundleJSON, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	return bundleJSON
+}
+
+func (s *testSigner) attestWithKey(t *testing.T, digest, predicateType string) attestation {
+	payload := newTestStatement(t, digest, predicateType)
+	return attestation{
+		envelope: newTestEnvelope(t, payload, signTestMessage(
+			t,
+			s.key,
+			dssePreAuthEncoding(inTotoPayloadType, payload),
+		)),
+	}
+}
+
+func (s *testSigner) attestKeyless(t *testing.T, digest, predicateType, email string) attestation {
+	key := newTestKey(t)
+	certificate := s.newSigningCertificate(t, key, email)
+
+	payload := newTestStatement(t, digest, predicateType)
+	att := attestation{
+		envelope: newTestEnvelope(t, payload, signTestMessage(
+			t,
+			key,
+			dssePreAuthEncoding(inTotoPayloadType, payload),
+		)),
+		certificate: certificate,
+	}
+
+	var entry inTotoEntry
+	entry.Kind = rekorInTotoKind
+	payloadHash := sha256.Sum256(payload)
+	entry.Spec.Content.PayloadHash.Algorithm = "sha256"
+	entry.Spec.Content.PayloadHash.Value = hex.EncodeToString(payloadHash[:])
+	entry.Spec.Content.Envelope.Signatures = append(
+		entry.Spec.Content.Envelope.Signatures,
+		struct {
+			PublicKey string `json:"publicKey"`
+		}{PublicKey: base64.StdEncoding.EncodeToString(certificate)},
+	)
+	body, err := json.Marshal(entry)
+	require.NoError(t, err)
+	att.bundle = s.newBundleForEntry(t, body)
+	return att
+}
+
+func newTestStatement(t *testing.T, digest, predicateType string) []byte {
+	hash, err := v1.NewHash(digest)
+	require.NoError(t, err)
+	statement := inTotoStatement{
+		PredicateType: predicateType,
+		Subject: []inTotoSubject{{
+			Digest: map[string]string{hash.Algorithm: hash.Hex},
+		}},
+	}
+	statementJSON, err := json.Marshal(statement)
+	require.NoError(t, err)
+	return statementJSON
+}
+
+func newTestEnvelope(t *testing.T, payload []byte, sig string) []byte {
+	envelope := dsseEnvelope{
+		PayloadType: inTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+	}
+	envelope.Signatures = append(envelope.Signatures, struct {
+		Sig string `json:"sig"`
+	}{Sig: sig})
+	envelopeJSON, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	return envelopeJSON
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func encodeTestPublicKey(t *testing.T, key *ecdsa.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func newTestPayload(t *testing.T, digest string) []byte {
+	var payload signedPayload
+	payload.Critical.Type = cosignSignatureType
+	payload.Critical.Image.DockerManifestDigest = digest
+	payloadJSON, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return payloadJSON
+}
+
+func signTestMessage(t *testing.T, key *ecdsa.PrivateKey, message []byte) string {
+	digest := sha256.Sum256(message)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func pushTestImage(t *testing.T, repoURL, tag string) string {
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(fmt.Sprintf("%s:%s", repoURL, tag))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
+
+func pushTestAttestation(t *testing.T, repoURL, digest string, att attestation) {
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer(att.envelope, types.MediaType(dsseEnvelopeMediaType)),
+	})
+	require.NoError(t, err)
+	hash, err := v1.NewHash(digest)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(
+		fmt.Sprintf("%s:%s-%s%s", repoURL, hash.Algorithm, hash.Hex, cosignAttestationTagSuffix),
+	)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+}
+
+func pushTestSignature(t *testing.T, repoURL, digest string, sig signature) {
+	annotations := map[string]string{
+		cosignSignatureAnnotation: sig.signature,
+	}
+	if sig.certificate != nil {
+		annotations[cosignCertificateAnnotation] = string(sig.certificate)
+	}
+	if sig.bundle != nil {
+		annotations[cosignBundleAnnotation] = string(sig.bundle)
+	}
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(sig.payload, types.MediaType(cosignSimpleSigningMediaType)),
+		Annotations: annotations,
+	})
+	require.NoError(t, err)
+	hash, err := v1.NewHash(digest)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(
+		fmt.Sprintf("%s:%s-%s%s", repoURL, hash.Algorithm, hash.Hex, cosignSignatureTagSuffix),
+	)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+}
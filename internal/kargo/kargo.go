@@ -3,6 +3,7 @@ package kargo
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/oklog/ulid/v2"
@@ -59,6 +60,18 @@ func NewPromotion(
 			Freight: freight,
 		},
 	}
+	if len(stage.Spec.Steps) > 0 {
+		promotion.Spec.Steps = make([]kargoapi.PromotionStep, len(stage.Spec.Steps))
+		for i := range stage.Spec.Steps {
+			stage.Spec.Steps[i].DeepCopyInto(&promotion.Spec.Steps[i])
+		}
+	}
+	if len(stage.Spec.Vars) > 0 {
+		promotion.Spec.Vars = slices.Clone(stage.Spec.Vars)
+	}
+	if stage.Spec.PromotionTemplate != nil {
+		promotion.Spec.PromotionTemplate = stage.Spec.PromotionTemplate.DeepCopy()
+	}
 	return promotion
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -62,6 +63,63 @@ func TestNewPromotion(t *testing.T) {
 				require.Equal(t, testFreight[0:7], parts[2])
 			},
 		},
+		{
+			name: "Promote stage with steps",
+			stage: kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       "80b44831-ac8d-4900-9df9-ee95f80c0fae",
+					Name:      "test",
+					Namespace: "kargo-demo",
+				},
+				Spec: kargoapi.StageSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses: "fake-directive",
+							As:   "fake-alias",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"fake-key":"fake-value"}`),
+							},
+						},
+					},
+					Vars: []kargoapi.PromotionVariable{
+						{Name: "fake_var", Value: "fake-value"},
+					},
+				},
+			},
+			freight: testFreight,
+			assertions: func(t *testing.T, stage kargoapi.Stage, promo kargoapi.Promotion) {
+				require.Equal(t, stage.Spec.Vars, promo.Spec.Vars)
+				require.Equal(t, stage.Spec.Steps, promo.Spec.Steps)
+				// The steps must have been copied
+				promo.Spec.Steps[0].Config.Raw[0] = '['
+				require.NotEqual(t, stage.Spec.Steps, promo.Spec.Steps)
+			},
+		},
+		{
+			name: "Promote stage with promotion template",
+			stage: kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       "80b44831-ac8d-4900-9df9-ee95f80c0fae",
+					Name:      "test",
+					Namespace: "kargo-demo",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplate: &kargoapi.PromotionTemplateReference{
+						Name: "fake-template",
+						Parameters: []kargoapi.PromotionVariable{
+							{Name: "fake_param", Value: "fake-value"},
+						},
+					},
+				},
+			},
+			freight: testFreight,
+			assertions: func(t *testing.T, stage kargoapi.Stage, promo kargoapi.Promotion) {
+				require.Equal(t, stage.Spec.PromotionTemplate, promo.Spec.PromotionTemplate)
+				// The reference must have been copied
+				promo.Spec.PromotionTemplate.Parameters[0].Value = "other-value"
+				require.NotEqual(t, stage.Spec.PromotionTemplate, promo.Spec.PromotionTemplate)
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -14,6 +14,8 @@ import (
 	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	libargocd "github.com/akuity/kargo/internal/argocd"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/image"
 	"github.com/akuity/kargo/internal/logging"
 )
 
@@ -36,6 +38,8 @@ const (
 	StagesByWarehouseIndexField          = "warehouse"
 
 	ServiceAccountsByOIDCClaimsIndexField = "claims"
+
+	WarehousesBySubscribedURLsIndexField = "subscribedURLs"
 )
 
 // IndexEventsByInvolvedObjectAPIGroup sets up the indexing of Events by the
@@ -318,9 +322,13 @@ func IndexPromotionsByStageAndFreight(
 }
 
 // indexPromotionsByStageAndFreight is a client.IndexerFunc that indexes
-// Promotions by the Freight and Stage they reference.
+// Promotions by the Freight and Stage they reference. Dry-run Promotions are
+// not indexed, as they do not actually promote the Freight they reference.
 func indexPromotionsByStageAndFreight(obj client.Object) []string {
 	promo := obj.(*kargoapi.Promotion) // nolint: forcetypeassert
+	if promo.Spec.DryRun {
+		return nil
+	}
 	return []string{
 		StageAndFreightKey(promo.Spec.Stage, promo.Spec.Freight),
 	}
@@ -542,6 +550,51 @@ func indexServiceAccountsByOIDCClaims(obj client.Object) []string {
 	return refinedClaimValues
 }
 
+// IndexWarehousesBySubscribedURLs sets up indexing of Warehouses by the
+// normalized URLs of the repositories they subscribe to.
+//
+// It configures the cluster's field indexer to allow querying Warehouses using
+// the WarehousesBySubscribedURLsIndexField selector. Git repository URLs are
+// normalized using git.NormalizeURL, while image, OCI artifact, and OCI Helm
+// chart repository URLs are normalized using image.NormalizeURL.
+func IndexWarehousesBySubscribedURLs(ctx context.Context, clstr cluster.Cluster) error {
+	return clstr.GetFieldIndexer().IndexField(
+		ctx,
+		&kargoapi.Warehouse{},
+		WarehousesBySubscribedURLsIndexField,
+		indexWarehousesBySubscribedURLs,
+	)
+}
+
+// indexWarehousesBySubscribedURLs is a client.IndexerFunc that indexes
+// Warehouses by the normalized URLs of the repositories they subscribe to.
+func indexWarehousesBySubscribedURLs(obj client.Object) []string {
+	warehouse := obj.(*kargoapi.Warehouse) // nolint: forcetypeassert
+	var urls []string
+	addImageURL := func(repoURL string) {
+		if url, err := image.NormalizeURL(repoURL); err == nil {
+			urls = append(urls, url)
+		}
+	}
+	for _, sub := range warehouse.Spec.Subscriptions {
+		switch {
+		case sub.Git != nil:
+			urls = append(urls, git.NormalizeURL(sub.Git.RepoURL))
+		case sub.Image != nil:
+			addImageURL(sub.Image.RepoURL)
+		case sub.OCIArtifact != nil:
+			addImageURL(sub.OCIArtifact.RepoURL)
+		case sub.Chart != nil && strings.HasPrefix(sub.Chart.RepoURL, "oci://"):
+			addImageURL(strings.TrimPrefix(sub.Chart.RepoURL, "oci://"))
+		}
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+	slices.Sort(urls)
+	return slices.Compact(urls)
+}
+
 func isPromotionPhaseNonTerminal(promo *kargoapi.Promotion) bool {
 	return !promo.Status.Phase.IsTerminal()
 }
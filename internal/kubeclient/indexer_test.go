@@ -574,6 +574,9 @@ func TestIndexPromotionsByStageAndFreight(t *testing.T) {
 	}
 	res := indexPromotionsByStageAndFreight(promo)
 	require.Equal(t, []string{"fake-stage:fake-freight"}, res)
+
+	promo.Spec.DryRun = true
+	require.Nil(t, indexPromotionsByStageAndFreight(promo))
 }
 
 func TestFreightByWarehouseIndexer(t *testing.T) {
@@ -887,3 +890,72 @@ func TestIndexServiceAccountsByOIDCClaims(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexWarehousesBySubscribedURLs(t *testing.T) {
+	testCases := []struct {
+		name      string
+		warehouse *kargoapi.Warehouse
+		expected  []string
+	}{
+		{
+			name:      "Warehouse has no subscriptions",
+			warehouse: &kargoapi.Warehouse{},
+			expected:  nil,
+		},
+		{
+			name: "Warehouse has subscriptions",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{
+						{
+							Git: &kargoapi.GitSubscription{
+								RepoURL: "https://github.com/example/repo.git",
+							},
+						},
+						{
+							Image: &kargoapi.ImageSubscription{
+								RepoURL: "nginx",
+							},
+						},
+						{
+							Image: &kargoapi.ImageSubscription{
+								RepoURL: "docker.io/library/nginx",
+							},
+						},
+						{
+							Chart: &kargoapi.ChartSubscription{
+								RepoURL: "oci://ghcr.io/example/charts/app",
+							},
+						},
+						{
+							Chart: &kargoapi.ChartSubscription{
+								RepoURL: "https://charts.example.com",
+								Name:    "app",
+							},
+						},
+						{
+							OCIArtifact: &kargoapi.OCIArtifactSubscription{
+								RepoURL: "ghcr.io/example/artifact",
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"ghcr.io/example/artifact",
+				"ghcr.io/example/charts/app",
+				"https://github.com/example/repo",
+				"index.docker.io/library/nginx",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				indexWarehousesBySubscribedURLs(testCase.warehouse),
+			)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// DiscoveryCacheGit identifies the cache of Git repository mirrors.
+	DiscoveryCacheGit = "git"
+	// DiscoveryCacheImage identifies the cache of image metadata.
+	DiscoveryCacheImage = "image"
+	// DiscoveryCacheArtifact identifies the cache of generic OCI artifact
+	// metadata.
+	DiscoveryCacheArtifact = "artifact"
+	// DiscoveryCacheChart identifies the cache of Helm chart metadata and
+	// provenance verification outcomes.
+	DiscoveryCacheChart = "chart"
+)
+
+var discoveryCacheLookups = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "kargo_discovery_cache_lookups_total",
+		Help: "Number of artifact discovery cache lookups, partitioned by cache and result.",
+	},
+	[]string{"cache", "result"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(discoveryCacheLookups)
+}
+
+// RecordDiscoveryCacheHit records a hit on the named artifact discovery cache.
+func RecordDiscoveryCacheHit(cache string) {
+	discoveryCacheLookups.WithLabelValues(cache, "hit").Inc()
+}
+
+// RecordDiscoveryCacheMiss records a miss on the named artifact discovery
+// cache.
+func RecordDiscoveryCacheMiss(cache string) {
+	discoveryCacheLookups.WithLabelValues(cache, "miss").Inc()
+}
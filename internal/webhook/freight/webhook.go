@@ -183,7 +183,8 @@ func (w *webhook) ValidateCreate(
 		)
 	}
 
-	if len(freight.Commits) == 0 && len(freight.Images) == 0 && len(freight.Charts) == 0 {
+	if len(freight.Commits) == 0 && len(freight.Images) == 0 &&
+		len(freight.Charts) == 0 && len(freight.Artifacts) == 0 && len(freight.Files) == 0 {
 		return nil, apierrors.NewInvalid(
 			freightGroupKind,
 			freight.Name,
@@ -191,7 +192,7 @@ func (w *webhook) ValidateCreate(
 				field.Invalid(
 					field.NewPath(""),
 					freight,
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, artifact, or file",
 				),
 			},
 		)
@@ -344,15 +345,21 @@ func (a artifactType) FreightPath() string {
 		return "images"
 	case artifactTypeChart:
 		return "charts"
+	case artifactTypeOCIArtifact:
+		return "artifacts"
+	case artifactTypeFile:
+		return "files"
 	default:
 		return ""
 	}
 }
 
 const (
-	artifactTypeGit   artifactType = "git"
-	artifactTypeImage artifactType = "image"
-	artifactTypeChart artifactType = "chart"
+	artifactTypeGit         artifactType = "git"
+	artifactTypeImage       artifactType = "image"
+	artifactTypeChart       artifactType = "chart"
+	artifactTypeOCIArtifact artifactType = "ociArtifact"
+	artifactTypeFile        artifactType = "file"
 )
 
 type artifactSubscription struct {
@@ -393,6 +400,18 @@ func validateFreightArtifacts(
 				Type: artifactTypeChart,
 			}] = false
 		}
+		if repo.OCIArtifact != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.OCIArtifact.RepoURL,
+				Type: artifactTypeOCIArtifact,
+			}] = false
+		}
+		if repo.File != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.File.RepoURL,
+				Type: artifactTypeFile,
+			}] = false
+		}
 	}
 
 	// Mark the subscription as found for each artifact in the Freight, and count
@@ -463,6 +482,50 @@ func validateFreightArtifacts(
 			},
 		)
 	}
+	for _, artifact := range freight.Artifacts {
+		sub := artifactSubscription{
+			URL:  artifact.RepoURL,
+			Type: artifactTypeOCIArtifact,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("artifacts"),
+					artifact,
+					fmt.Sprintf("no subscription found for artifact repository in Warehouse %q", warehouse.Name),
+				),
+			},
+		)
+	}
+	for _, file := range freight.Files {
+		sub := artifactSubscription{
+			URL:  file.RepoURL,
+			Type: artifactTypeFile,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("files"),
+					file,
+					fmt.Sprintf("no subscription found for file repository in Warehouse %q", warehouse.Name),
+				),
+			},
+		)
+	}
 
 	// Check that each subscription is found exactly once.
 	for sub, found := range subscriptions {
@@ -538,5 +601,23 @@ func compareFreight(old, new *kargoapi.Freight) (*field.Path, any, bool) {
 		}
 	}
 
+	if len(old.Artifacts) != len(new.Artifacts) {
+		return field.NewPath("artifacts"), new.Artifacts, false
+	}
+	for i, artifact := range old.Artifacts {
+		if !artifact.DeepEquals(&new.Artifacts[i]) {
+			return field.NewPath("artifacts").Index(i), new.Artifacts[i], false
+		}
+	}
+
+	if len(old.Files) != len(new.Files) {
+		return field.NewPath("files"), new.Files, false
+	}
+	for i, file := range old.Files {
+		if !file.DeepEquals(&new.Files[i]) {
+			return field.NewPath("files").Index(i), new.Files[i], false
+		}
+	}
+
 	return nil, nil, true
 }
@@ -272,7 +272,7 @@ func TestValidateCreate(t *testing.T) {
 			},
 			assertions: func(t *testing.T, err error) {
 				require.ErrorContains(
-					t, err, "freight must contain at least one commit, image, or chart",
+					t, err, "freight must contain at least one commit, image, chart, artifact, or file",
 				)
 			},
 		},
@@ -964,6 +964,61 @@ func TestValidateFreightArtifacts(t *testing.T) {
 				require.ErrorContains(t, err, "no subscription found for Helm chart in Warehouse")
 			},
 		},
+		{
+			name: "Freight with artifact repository not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				Artifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no subscription found for artifact repository in Warehouse")
+			},
+		},
+		{
+			name: "Freight with duplicate OCI artifact for Warehouse subscription",
+			freight: &kargoapi.Freight{
+				Artifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-repo-url",
+					},
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{
+						{
+							OCIArtifact: &kargoapi.OCIArtifactSubscription{
+								RepoURL: "fake-repo-url",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "multiple artifacts found for subscription")
+			},
+		},
+		{
+			name: "Freight with file repository not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				Files: []kargoapi.File{
+					{
+						RepoURL: "s3://fake-bucket/",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no subscription found for file repository in Warehouse")
+			},
+		},
 		{
 			name: "success",
 			freight: &kargoapi.Freight{
@@ -996,6 +1051,16 @@ func TestValidateFreightArtifacts(t *testing.T) {
 						RepoURL: "fake-another-chart-repo-url",
 					},
 				},
+				Artifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-artifact-repo-url",
+					},
+				},
+				Files: []kargoapi.File{
+					{
+						RepoURL: "s3://fake-bucket/",
+					},
+				},
 			},
 			warehouse: &kargoapi.Warehouse{
 				Spec: kargoapi.WarehouseSpec{
@@ -1037,6 +1102,16 @@ func TestValidateFreightArtifacts(t *testing.T) {
 								RepoURL: "fake-another-chart-repo-url",
 							},
 						},
+						{
+							OCIArtifact: &kargoapi.OCIArtifactSubscription{
+								RepoURL: "fake-artifact-repo-url",
+							},
+						},
+						{
+							File: &kargoapi.FileSubscription{
+								RepoURL: "s3://fake-bucket/",
+							},
+						},
 					},
 				},
 			},
@@ -1164,6 +1239,46 @@ func TestCompareFreight(t *testing.T) {
 				require.False(t, eq)
 			},
 		},
+		{
+			name: "different number of artifacts",
+			old:  &kargoapi.Freight{Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1"}}},
+			new:  &kargoapi.Freight{},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("artifacts"), path)
+				require.Equal(t, freight.Artifacts, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different artifact contents",
+			old:  &kargoapi.Freight{Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Digest: "digest1"}}},
+			new:  &kargoapi.Freight{Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Digest: "digest2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("artifacts").Index(0), path)
+				require.Equal(t, freight.Artifacts[0], val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different number of files",
+			old:  &kargoapi.Freight{Files: []kargoapi.File{{RepoURL: "s3://bucket/"}}},
+			new:  &kargoapi.Freight{},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("files"), path)
+				require.Equal(t, freight.Files, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different file contents",
+			old:  &kargoapi.Freight{Files: []kargoapi.File{{RepoURL: "s3://bucket/", ETag: "etag1"}}},
+			new:  &kargoapi.Freight{Files: []kargoapi.File{{RepoURL: "s3://bucket/", ETag: "etag2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("files").Index(0), path)
+				require.Equal(t, freight.Files[0], val)
+				require.False(t, eq)
+			},
+		},
 	}
 
 	for _, tt := range tests {
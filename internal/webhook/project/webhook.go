@@ -188,7 +188,53 @@ func (w *webhook) validatePromotionPolicies(
 		}
 		stageNames[promotionPolicy.Stage] = struct{}{}
 	}
-	return nil
+	var errs field.ErrorList
+	for i, promotionPolicy := range promotionPolicies {
+		errs = append(
+			errs,
+			w.validatePromotionWindows(
+				f.Index(i).Child("windows"),
+				promotionPolicy.Windows,
+			)...,
+		)
+		errs = append(
+			errs,
+			w.validatePromotionFreezes(
+				f.Index(i).Child("freezes"),
+				promotionPolicy.Freezes,
+			)...,
+		)
+	}
+	return errs
+}
+
+func (w *webhook) validatePromotionWindows(
+	f *field.Path,
+	windows []kargoapi.PromotionWindow,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, window := range windows {
+		if err := window.Validate(); err != nil {
+			errs = append(errs, field.Invalid(f.Index(i), window, err.Error()))
+		}
+	}
+	return errs
+}
+
+func (w *webhook) validatePromotionFreezes(
+	f *field.Path,
+	freezes []kargoapi.PromotionFreeze,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, freeze := range freezes {
+		if !freeze.End.After(freeze.Start.Time) {
+			errs = append(
+				errs,
+				field.Invalid(f.Index(i).Child("end"), freeze.End, "end must be after start"),
+			)
+		}
+	}
+	return errs
 }
 
 // ensureNamespace is used to ensure the existence of a namespace with the same
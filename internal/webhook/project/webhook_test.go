@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -140,11 +141,70 @@ func TestValidateSpec(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "invalid promotion window",
+			spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage: "fake-stage",
+						Windows: []kargoapi.PromotionWindow{
+							{
+								Kind:     kargoapi.PromotionWindowKindDeny,
+								Schedule: "0 16 * * 5",
+								TimeZone: "Not/AZone",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.promotionPolicies[0].windows[0]", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "invalid time zone")
+			},
+		},
+		{
+			name: "invalid promotion freeze",
+			spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage: "fake-stage",
+						Freezes: []kargoapi.PromotionFreeze{
+							{
+								Start: metav1.NewTime(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)),
+								End:   metav1.NewTime(time.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC)),
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.promotionPolicies[0].freezes[0].end", errs[0].Field)
+				require.Equal(t, "end must be after start", errs[0].Detail)
+			},
+		},
 		{
 			name: "valid",
 			spec: &kargoapi.ProjectSpec{
 				PromotionPolicies: []kargoapi.PromotionPolicy{
-					{Stage: "fake-stage"},
+					{
+						Stage: "fake-stage",
+						Windows: []kargoapi.PromotionWindow{
+							{
+								Kind:     kargoapi.PromotionWindowKindDeny,
+								Schedule: "0 16 * * 5",
+								Duration: metav1.Duration{Duration: 64 * time.Hour},
+								TimeZone: "Europe/Berlin",
+							},
+						},
+						Freezes: []kargoapi.PromotionFreeze{
+							{
+								Start: metav1.NewTime(time.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC)),
+								End:   metav1.NewTime(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)),
+							},
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
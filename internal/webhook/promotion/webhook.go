@@ -2,7 +2,10 @@ package promotion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
@@ -24,6 +27,11 @@ import (
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
+// breakGlassVerb is the custom verb on Stages a subject must be permitted to
+// use in order to create a Promotion that its Project's PromotionPolicy does
+// not currently permit.
+const breakGlassVerb = "break-glass"
+
 var (
 	promotionGroupKind = schema.GroupKind{
 		Group: kargoapi.GroupVersion.Group,
@@ -55,6 +63,14 @@ type webhook struct {
 		types.NamespacedName,
 	) (*kargoapi.Stage, error)
 
+	getProjectFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error)
+
+	nowFn func() time.Time
+
 	validateProjectFn func(
 		context.Context,
 		client.Client,
@@ -68,6 +84,12 @@ type webhook struct {
 		action string,
 	) error
 
+	checkPromotionPolicyFn func(
+		ctx context.Context,
+		req admission.Request,
+		promo *kargoapi.Promotion,
+	) error
+
 	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 
 	createSubjectAccessReviewFn func(
@@ -110,8 +132,11 @@ func newWebhook(
 	}
 	w.getFreightFn = kargoapi.GetFreight
 	w.getStageFn = kargoapi.GetStage
+	w.getProjectFn = kargoapi.GetProject
+	w.nowFn = time.Now
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.authorizeFn = w.authorize
+	w.checkPromotionPolicyFn = w.checkPromotionPolicy
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
 	w.isRequestFromKargoControlplaneFn =
@@ -177,9 +202,10 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 			promo.Namespace,
 		)
 	}
-	if stage.Spec.PromotionMechanisms == nil {
+	if stage.IsControlFlow() {
 		return fmt.Errorf(
-			"Stage %q in namespace %q has no PromotionMechanisms",
+			"Stage %q in namespace %q has no PromotionMechanisms, Steps or "+
+				"PromotionTemplate",
 			promo.Spec.Stage,
 			promo.Namespace,
 		)
@@ -220,8 +246,24 @@ func (w *webhook) ValidateCreate(
 		return nil, fmt.Errorf("get admission request from context: %w", err)
 	}
 
-	// Record Promotion created event if the request doesn't come from Kargo controlplane
-	if !w.isRequestFromKargoControlplaneFn(req) {
+	// A dry run does not promote anything, so it is not subject to the
+	// promotion windows and freezes of the Project. Neither are Promotions
+	// created by the Kargo control plane. The controller creates them to roll
+	// back Stages, which must remain possible at any time, and the API server
+	// enforces the promotion policy itself, as only it knows the identity of
+	// the user on whose behalf it creates a Promotion. It does so for every
+	// endpoint that creates Promotions, including those that create arbitrary
+	// resources from manifests.
+	if !promo.Spec.DryRun && !w.isRequestFromKargoControlplaneFn(req) {
+		if err = w.checkPromotionPolicyFn(ctx, req, promo); err != nil {
+			return nil, err
+		}
+	}
+
+	// Record Promotion created event if the request doesn't come from Kargo
+	// controlplane. A dry run does not promote anything, so no event is recorded
+	// for it.
+	if !promo.Spec.DryRun && !w.isRequestFromKargoControlplaneFn(req) {
 		freight, err := w.getFreightFn(ctx, w.client, types.NamespacedName{
 			Namespace: promo.Namespace,
 			Name:      promo.Spec.Freight,
@@ -245,7 +287,7 @@ func (w *webhook) ValidateUpdate(
 	}
 
 	// PromotionSpecs are meant to be immutable
-	if promo.Spec != (oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
+	if !reflect.DeepEqual(promo.Spec, oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
 		return nil, apierrors.NewInvalid(
 			promotionGroupKind,
 			promo.Name,
@@ -331,6 +373,96 @@ func (w *webhook) authorize(
 	return nil
 }
 
+// checkPromotionPolicy returns an error if the PromotionPolicy of the Project
+// does not currently permit promotion to the Stage referenced by the provided
+// Promotion. A Promotion carrying the break-glass annotation is exempt from
+// this, provided the subject creating it is permitted to break-glass the Stage.
+func (w *webhook) checkPromotionPolicy(
+	ctx context.Context,
+	req admission.Request,
+	promo *kargoapi.Promotion,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	project, err := w.getProjectFn(ctx, w.client, promo.Namespace)
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+	if project == nil {
+		return nil
+	}
+	policy := project.GetPromotionPolicy(promo.Spec.Stage)
+	if policy == nil {
+		return nil
+	}
+	permitted, reason, err := policy.IsPromotionPermitted(w.nowFn())
+	if err != nil {
+		return fmt.Errorf("evaluate promotion policy: %w", err)
+	}
+	if permitted {
+		return nil
+	}
+
+	if _, ok := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]; !ok {
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			fmt.Errorf(
+				"promotion to Stage %q is not permitted at this time: %s",
+				promo.Spec.Stage,
+				reason,
+			),
+		)
+	}
+
+	accessReview := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			ResourceAttributes: &authzv1.ResourceAttributes{
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "stages",
+				Name:      promo.Spec.Stage,
+				Verb:      breakGlassVerb,
+				Namespace: promo.Namespace,
+			},
+		},
+	}
+	if err = w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+		logger.Error(err, "")
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.New(
+				"error creating SubjectAccessReview; refusing to create Promotion",
+			),
+		)
+	}
+	if !accessReview.Status.Allowed {
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			fmt.Errorf(
+				"subject %q is not permitted to %s Stage %q while promotion to it "+
+					"is not permitted: %s",
+				req.UserInfo.Username,
+				breakGlassVerb,
+				promo.Spec.Stage,
+				reason,
+			),
+		)
+	}
+
+	logger.Info(
+		"promotion policy overridden by break-glass annotation",
+		"promotion", promo.Name,
+		"stage", promo.Spec.Stage,
+		"user", req.UserInfo.Username,
+		"reason", reason,
+	)
+	return nil
+}
+
 func (w *webhook) recordPromotionCreatedEvent(
 	ctx context.Context,
 	req admission.Request,
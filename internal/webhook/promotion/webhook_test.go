@@ -5,6 +5,7 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	authnv1 "k8s.io/api/authentication/v1"
@@ -33,8 +34,11 @@ func TestNewWebhook(t *testing.T) {
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.getFreightFn)
 	require.NotNil(t, w.getStageFn)
+	require.NotNil(t, w.getProjectFn)
+	require.NotNil(t, w.nowFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.checkPromotionPolicyFn)
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.createSubjectAccessReviewFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
@@ -183,6 +187,75 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "promotion not permitted by promotion policy",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				checkPromotionPolicyFn: func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("something went wrong")
+				},
+				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
+					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
+				),
+			},
+			userInfo: &authnv1.UserInfo{
+				Username: "fake-user",
+			},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Empty(t, r.Events)
+			},
+		},
+		{
+			name: "controlplane request exempt from promotion policy",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				checkPromotionPolicyFn: func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("promotion policy should not be checked")
+				},
+				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
+					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
+				),
+			},
+			userInfo: &authnv1.UserInfo{
+				Username: serviceaccount.ServiceAccountUsernamePrefix + "kargo:kargo-controller",
+			},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, r.Events)
+			},
+		},
 		{
 			name: "record promotion created event on non-controlplane request",
 			webhook: &webhook{
@@ -198,6 +271,13 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
+				checkPromotionPolicyFn: func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -234,6 +314,13 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
+				checkPromotionPolicyFn: func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -505,3 +592,138 @@ func TestAuthorize(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPromotionPolicy(t *testing.T) {
+	testNow := time.Date(2024, time.December, 24, 12, 0, 0, 0, time.UTC)
+	frozenProject := &kargoapi.Project{
+		Spec: &kargoapi.ProjectSpec{
+			PromotionPolicies: []kargoapi.PromotionPolicy{{
+				Stage: "fake-stage",
+				Freezes: []kargoapi.PromotionFreeze{{
+					Start:  v1.NewTime(testNow.Add(-time.Hour)),
+					End:    v1.NewTime(testNow.Add(time.Hour)),
+					Reason: "holidays",
+				}},
+			}},
+		},
+	}
+	testCases := []struct {
+		name        string
+		project     *kargoapi.Project
+		annotations map[string]string
+		allowed     bool
+		assertions  func(*testing.T, error)
+	}{
+		{
+			name:    "no Project found",
+			project: nil,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "no PromotionPolicy for Stage",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						Stage: "other-stage",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "promotion permitted",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						Stage: "fake-stage",
+						Windows: []kargoapi.PromotionWindow{{
+							Kind:     kargoapi.PromotionWindowKindAllow,
+							Schedule: "0 8 * * *",
+							Duration: v1.Duration{Duration: 8 * time.Hour},
+						}},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "frozen",
+			project: frozenProject,
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not permitted at this time")
+				require.ErrorContains(t, err, "holidays")
+			},
+		},
+		{
+			name:    "frozen with break-glass annotation by unauthorized subject",
+			project: frozenProject,
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "hotfix",
+			},
+			allowed: false,
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not permitted to break-glass")
+			},
+		},
+		{
+			name:    "frozen with break-glass annotation by authorized subject",
+			project: frozenProject,
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "hotfix",
+			},
+			allowed: true,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return testCase.project, nil
+				},
+				nowFn: func() time.Time {
+					return testNow
+				},
+				createSubjectAccessReviewFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+					require.Equal(t, breakGlassVerb, review.Spec.ResourceAttributes.Verb)
+					review.Status.Allowed = testCase.allowed
+					return nil
+				},
+			}
+			testCase.assertions(
+				t,
+				w.checkPromotionPolicy(
+					context.Background(),
+					admission.Request{},
+					&kargoapi.Promotion{
+						ObjectMeta: v1.ObjectMeta{
+							Name:        "fake-promotion",
+							Namespace:   "fake-namespace",
+							Annotations: testCase.annotations,
+						},
+						Spec: kargoapi.PromotionSpec{
+							Stage: "fake-stage",
+						},
+					},
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ValidatePromotionSteps validates the provided PromotionSteps beyond what
+// can be expressed using the OpenAPI schema of the resources embedding them.
+func ValidatePromotionSteps(
+	f *field.Path,
+	steps []kargoapi.PromotionStep,
+) field.ErrorList {
+	var errs field.ErrorList
+	// Make sure the same alias is not used by multiple steps, as this would
+	// make the output of all but the last of those steps inaccessible. Steps
+	// that are part of a parallel step group share the same namespace as all
+	// other steps.
+	seenAliases := make(map[string]struct{}, len(steps))
+	validateAlias := func(aliasPath *field.Path, alias string) {
+		if alias == "" {
+			return
+		}
+		if _, seen := seenAliases[alias]; seen {
+			errs = append(
+				errs,
+				field.Invalid(
+					aliasPath,
+					alias,
+					fmt.Sprintf(
+						"step alias %q used multiple times in %s",
+						alias,
+						f.String(),
+					),
+				),
+			)
+		}
+		seenAliases[alias] = struct{}{}
+	}
+	for i, step := range steps {
+		stepPath := f.Index(i)
+		if step.Parallel == nil {
+			if step.Uses == "" {
+				errs = append(
+					errs,
+					field.Required(
+						stepPath.Child("uses"),
+						"one of uses or parallel must be specified",
+					),
+				)
+			}
+			validateAlias(stepPath.Child("as"), step.As)
+			continue
+		}
+		// A parallel step group does not execute a directive of its own and
+		// therefore has no use for anything other than continueOnError
+		if step.Uses != "" || step.As != "" || step.Config != nil ||
+			step.Timeout != nil || step.Retry != nil {
+			errs = append(
+				errs,
+				field.Forbidden(
+					stepPath.Child("parallel"),
+					"parallel may only be combined with continueOnError",
+				),
+			)
+		}
+		groupPath := stepPath.Child("parallel", "steps")
+		for j, child := range step.Parallel.Steps {
+			validateAlias(groupPath.Index(j).Child("as"), child.As)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestValidatePromotionSteps(t *testing.T) {
+	testCases := []struct {
+		name       string
+		steps      []kargoapi.PromotionStep
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "alias used multiple times",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-directive", As: "fake-alias"},
+				{Uses: "fake-directive"},
+				{Uses: "fake-directive", As: "fake-alias"},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "steps[2].as",
+							BadValue: "fake-alias",
+							Detail:   `step alias "fake-alias" used multiple times in steps`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "alias used multiple times in parallel step group",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-directive", As: "fake-alias"},
+				{
+					Parallel: &kargoapi.PromotionStepGroup{
+						Steps: []kargoapi.PromotionParallelStep{
+							{Uses: "fake-directive"},
+							{Uses: "fake-directive", As: "fake-alias"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "steps[1].parallel.steps[1].as",
+							BadValue: "fake-alias",
+							Detail:   `step alias "fake-alias" used multiple times in steps`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name:  "neither uses nor parallel specified",
+			steps: []kargoapi.PromotionStep{{As: "fake-alias"}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "steps[0].uses",
+							BadValue: "",
+							Detail:   "one of uses or parallel must be specified",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "parallel combined with uses",
+			steps: []kargoapi.PromotionStep{
+				{
+					Uses: "fake-directive",
+					Parallel: &kargoapi.PromotionStepGroup{
+						Steps: []kargoapi.PromotionParallelStep{
+							{Uses: "fake-directive"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeForbidden,
+							Field:    "steps[0].parallel",
+							BadValue: "",
+							Detail:   "parallel may only be combined with continueOnError",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "success",
+			steps: []kargoapi.PromotionStep{
+				{Uses: "fake-directive", As: "fake-alias"},
+				{Uses: "fake-directive"},
+				{
+					ContinueOnError: true,
+					Parallel: &kargoapi.PromotionStepGroup{
+						Steps: []kargoapi.PromotionParallelStep{
+							{Uses: "fake-directive", As: "other-alias"},
+							{Uses: "fake-directive"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				ValidatePromotionSteps(field.NewPath("steps"), testCase.steps),
+			)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package promotiontemplate
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libWebhook "github.com/akuity/kargo/internal/webhook"
+)
+
+var promotionTemplateGroupKind = schema.GroupKind{
+	Group: kargoapi.GroupVersion.Group,
+	Kind:  "PromotionTemplate",
+}
+
+type webhook struct {
+	client client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	validateProjectFn func(
+		context.Context,
+		client.Client,
+		schema.GroupKind,
+		client.Object,
+	) error
+
+	validateCreateOrUpdateFn func(*kargoapi.PromotionTemplate) (admission.Warnings, error)
+
+	validateSpecFn func(*field.Path, *kargoapi.PromotionTemplateSpec) field.ErrorList
+}
+
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := newWebhook(mgr.GetClient())
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kargoapi.PromotionTemplate{}).
+		WithValidator(w).
+		Complete()
+}
+
+func newWebhook(kubeClient client.Client) *webhook {
+	w := &webhook{
+		client: kubeClient,
+	}
+	w.validateProjectFn = libWebhook.ValidateProject
+	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
+	w.validateSpecFn = w.validateSpec
+	return w
+}
+
+func (w *webhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	template := obj.(*kargoapi.PromotionTemplate) // nolint: forcetypeassert
+	if err := w.validateProjectFn(
+		ctx,
+		w.client,
+		promotionTemplateGroupKind,
+		template,
+	); err != nil {
+		return nil, err
+	}
+	return w.validateCreateOrUpdateFn(template)
+}
+
+func (w *webhook) ValidateUpdate(
+	_ context.Context,
+	_ runtime.Object,
+	newObj runtime.Object,
+) (admission.Warnings, error) {
+	template := newObj.(*kargoapi.PromotionTemplate) // nolint: forcetypeassert
+	return w.validateCreateOrUpdateFn(template)
+}
+
+func (w *webhook) ValidateDelete(
+	context.Context,
+	runtime.Object,
+) (admission.Warnings, error) {
+	// No-op
+	return nil, nil
+}
+
+func (w *webhook) validateCreateOrUpdate(
+	template *kargoapi.PromotionTemplate,
+) (admission.Warnings, error) {
+	if errs :=
+		w.validateSpecFn(field.NewPath("spec"), &template.Spec); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(
+			promotionTemplateGroupKind,
+			template.Name,
+			errs,
+		)
+	}
+	return nil, nil
+}
+
+func (w *webhook) validateSpec(
+	f *field.Path,
+	spec *kargoapi.PromotionTemplateSpec,
+) field.ErrorList {
+	if spec == nil { // nil spec is caught by declarative validations
+		return nil
+	}
+	errs := w.validateParameters(f.Child("parameters"), spec.Parameters)
+	return append(errs, libWebhook.ValidatePromotionSteps(f.Child("steps"), spec.Steps)...)
+}
+
+func (w *webhook) validateParameters(
+	f *field.Path,
+	params []kargoapi.PromotionTemplateParameter,
+) field.ErrorList {
+	var errs field.ErrorList
+	seenNames := make(map[string]struct{}, len(params))
+	for i, param := range params {
+		if _, seen := seenNames[param.Name]; seen {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i).Child("name"),
+					param.Name,
+					fmt.Sprintf(
+						"parameter %q declared multiple times in %s",
+						param.Name,
+						f.String(),
+					),
+				),
+			)
+		}
+		seenNames[param.Name] = struct{}{}
+		// A default value would never be used for a parameter that must be
+		// specified by every Stage referencing the PromotionTemplate
+		if param.Required && param.Default != "" {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i).Child("default"),
+					param.Default,
+					fmt.Sprintf(
+						"required parameter %q may not have a default value",
+						param.Name,
+					),
+				),
+			)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,324 @@
+package promotiontemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewWebhook(t *testing.T) {
+	kubeClient := fake.NewClientBuilder().Build()
+	w := newWebhook(kubeClient)
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.validateCreateOrUpdateFn)
+	require.NotNil(t, w.validateSpecFn)
+}
+
+func TestValidateCreate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error validating project",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error validating promotion template",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(
+					*kargoapi.PromotionTemplate,
+				) (admission.Warnings, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(
+					*kargoapi.PromotionTemplate,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.webhook.ValidateCreate(
+				context.Background(),
+				&kargoapi.PromotionTemplate{},
+			)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error validating promotion template",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(
+					*kargoapi.PromotionTemplate,
+				) (admission.Warnings, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(
+					*kargoapi.PromotionTemplate,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.webhook.ValidateUpdate(
+				context.Background(),
+				nil,
+				&kargoapi.PromotionTemplate{},
+			)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func TestValidateDelete(t *testing.T) {
+	w := &webhook{}
+	_, err := w.ValidateDelete(context.Background(), nil)
+	require.NoError(t, err, nil)
+}
+
+func TestValidateCreateOrUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error validating spec",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.PromotionTemplateSpec,
+				) field.ErrorList {
+					return field.ErrorList{{}}
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.PromotionTemplateSpec,
+				) field.ErrorList {
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.webhook.validateCreateOrUpdate(
+				&kargoapi.PromotionTemplate{},
+			)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func TestValidateSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       *kargoapi.PromotionTemplateSpec
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "invalid",
+			spec: &kargoapi.PromotionTemplateSpec{
+				Parameters: []kargoapi.PromotionTemplateParameter{
+					{Name: "path"},
+					{Name: "path"},
+				},
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "fake-directive", As: "fake-step"},
+					{Uses: "fake-directive", As: "fake-step"},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.parameters[1].name",
+							BadValue: "path",
+							Detail: `parameter "path" declared multiple times in ` +
+								"spec.parameters",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.steps[1].as",
+							BadValue: "fake-step",
+							Detail:   `step alias "fake-step" used multiple times in spec.steps`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			spec: &kargoapi.PromotionTemplateSpec{
+				Parameters: []kargoapi.PromotionTemplateParameter{
+					{Name: "path", Required: true},
+					{Name: "branch", Default: "main"},
+				},
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "fake-directive", As: "fake-step"},
+					{Uses: "fake-directive"},
+					{Uses: "fake-directive"},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateSpec(field.NewPath("spec"), testCase.spec),
+			)
+		})
+	}
+}
+
+func TestValidateParameters(t *testing.T) {
+	testCases := []struct {
+		name       string
+		params     []kargoapi.PromotionTemplateParameter
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "required parameter with default",
+			params: []kargoapi.PromotionTemplateParameter{
+				{Name: "branch", Required: true, Default: "main"},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "parameters[0].default",
+							BadValue: "main",
+							Detail: `required parameter "branch" may not have a ` +
+								"default value",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "success",
+			params: []kargoapi.PromotionTemplateParameter{
+				{Name: "branch", Default: "main"},
+				{Name: "path", Required: true},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateParameters(field.NewPath("parameters"), testCase.params),
+			)
+		})
+	}
+}
@@ -195,6 +195,48 @@ func (w *webhook) validateSpec(
 		return nil
 	}
 	errs := w.validateRequestedFreight(f.Child("requestedFreight"), spec.RequestedFreight)
+	if spec.PromotionMechanisms != nil && len(spec.Steps) > 0 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f,
+				spec,
+				fmt.Sprintf(
+					"%s.promotionMechanisms and %s.steps are mutually exclusive",
+					f.String(),
+					f.String(),
+				),
+			),
+		)
+	}
+	if spec.PromotionTemplate != nil &&
+		(spec.PromotionMechanisms != nil || len(spec.Steps) > 0) {
+		errs = append(
+			errs,
+			field.Invalid(
+				f,
+				spec,
+				fmt.Sprintf(
+					"%s.promotionTemplate is mutually exclusive with "+
+						"%s.promotionMechanisms and %s.steps",
+					f.String(),
+					f.String(),
+					f.String(),
+				),
+			),
+		)
+	}
+	errs = append(errs, libWebhook.ValidatePromotionSteps(f.Child("steps"), spec.Steps)...)
+	errs = append(errs, w.validateVars(f.Child("vars"), spec.Vars)...)
+	if spec.PromotionTemplate != nil {
+		errs = append(
+			errs,
+			w.validateVars(
+				f.Child("promotionTemplate", "parameters"),
+				spec.PromotionTemplate.Parameters,
+			)...,
+		)
+	}
 	return append(
 		errs,
 		w.validatePromotionMechanisms(
@@ -204,6 +246,32 @@ func (w *webhook) validateSpec(
 	)
 }
 
+func (w *webhook) validateVars(
+	f *field.Path,
+	vars []kargoapi.PromotionVariable,
+) field.ErrorList {
+	// Make sure the same variable is not declared multiple times, as this
+	// would make the value of all but the last declaration inaccessible
+	seenNames := make(map[string]struct{}, len(vars))
+	for i, v := range vars {
+		if _, seen := seenNames[v.Name]; seen {
+			return field.ErrorList{
+				field.Invalid(
+					f.Index(i).Child("name"),
+					v.Name,
+					fmt.Sprintf(
+						"variable %q declared multiple times in %s",
+						v.Name,
+						f.String(),
+					),
+				),
+			}
+		}
+		seenNames[v.Name] = struct{}{}
+	}
+	return nil
+}
+
 func (w *webhook) validateRequestedFreight(
 	f *field.Path,
 	reqs []kargoapi.FreightRequest,
@@ -1064,6 +1064,93 @@ func TestValidateSpec(t *testing.T) {
 			},
 		},
 
+		{
+			name: "promotion mechanisms and steps",
+			spec: &kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+				},
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{}},
+				},
+				Steps: []kargoapi.PromotionStep{{Uses: "fake-directive"}},
+			},
+			assertions: func(t *testing.T, spec *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec",
+							BadValue: spec,
+							Detail: "spec.promotionMechanisms and spec.steps are " +
+								"mutually exclusive",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "promotion template and steps",
+			spec: &kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+				},
+				Steps: []kargoapi.PromotionStep{{Uses: "fake-directive"}},
+				PromotionTemplate: &kargoapi.PromotionTemplateReference{
+					Name: "fake-template",
+				},
+			},
+			assertions: func(t *testing.T, spec *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec",
+							BadValue: spec,
+							Detail: "spec.promotionTemplate is mutually exclusive " +
+								"with spec.promotionMechanisms and spec.steps",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "promotion template with duplicate parameters",
+			spec: &kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+				},
+				PromotionTemplate: &kargoapi.PromotionTemplateReference{
+					Name: "fake-template",
+					Parameters: []kargoapi.PromotionVariable{
+						{Name: "branch", Value: "main"},
+						{Name: "branch", Value: "dev"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.promotionTemplate.parameters[1].name",
+							BadValue: "branch",
+							Detail: `variable "branch" declared multiple times in ` +
+								"spec.promotionTemplate.parameters",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
 		{
 			name: "valid",
 			spec: &kargoapi.StageSpec{
@@ -1151,6 +1238,56 @@ func TestValidateRequestedFreight(t *testing.T) {
 	}
 }
 
+func TestValidateVars(t *testing.T) {
+	testCases := []struct {
+		name       string
+		vars       []kargoapi.PromotionVariable
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "variable declared multiple times",
+			vars: []kargoapi.PromotionVariable{
+				{Name: "foo", Value: "bar"},
+				{Name: "baz", Value: "qux"},
+				{Name: "foo", Value: "quux"},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "vars[2].name",
+							BadValue: "foo",
+							Detail:   `variable "foo" declared multiple times in vars`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "success",
+			vars: []kargoapi.PromotionVariable{
+				{Name: "foo", Value: "bar"},
+				{Name: "baz", Value: "${{ .vars.foo }}"},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateVars(field.NewPath("vars"), testCase.vars),
+			)
+		})
+	}
+}
+
 func TestValidatePromotionMechanisms(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -3,6 +3,7 @@ package warehouse
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -128,7 +129,15 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	return append(
+		errs,
+		w.validateFreightMatchRules(
+			f.Child("freightMatchRules"),
+			spec.FreightMatchRules,
+			spec.Subscriptions,
+		)...,
+	)
 }
 
 func (w *webhook) validateSubs(
@@ -146,6 +155,80 @@ func (w *webhook) validateSubs(
 	return errs
 }
 
+func (w *webhook) validateFreightMatchRules(
+	f *field.Path,
+	rules []kargoapi.FreightMatchRule,
+	subs []kargoapi.RepoSubscription,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, rule := range rules {
+		ruleF := f.Index(i)
+		errs = append(
+			errs,
+			validateFreightMatchArtifact(ruleF.Child("source"), rule.Source, subs)...,
+		)
+		errs = append(
+			errs,
+			validateFreightMatchArtifact(ruleF.Child("target"), rule.Target, subs)...,
+		)
+		if rule.Source.Kind == rule.Target.Kind &&
+			rule.Source.RepoURL == rule.Target.RepoURL &&
+			rule.Source.Name == rule.Target.Name {
+			errs = append(
+				errs,
+				field.Invalid(
+					ruleF.Child("target"),
+					rule.Target.RepoURL,
+					"must not reference the same subscription as source",
+				),
+			)
+		}
+	}
+	return errs
+}
+
+func validateFreightMatchArtifact(
+	f *field.Path,
+	ref kargoapi.FreightMatchArtifact,
+	subs []kargoapi.RepoSubscription,
+) field.ErrorList {
+	if ref.Kind != kargoapi.FreightMatchArtifactKindChart && ref.Name != "" {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("name"),
+				ref.Name,
+				"must be empty unless kind is Chart",
+			),
+		}
+	}
+	for _, sub := range subs {
+		var found bool
+		switch ref.Kind {
+		case kargoapi.FreightMatchArtifactKindGit:
+			found = sub.Git != nil && sub.Git.RepoURL == ref.RepoURL
+		case kargoapi.FreightMatchArtifactKindImage:
+			found = sub.Image != nil && sub.Image.RepoURL == ref.RepoURL
+		case kargoapi.FreightMatchArtifactKindChart:
+			found = sub.Chart != nil && sub.Chart.RepoURL == ref.RepoURL &&
+				sub.Chart.Name == ref.Name
+		case kargoapi.FreightMatchArtifactKindOCIArtifact:
+			found = sub.OCIArtifact != nil && sub.OCIArtifact.RepoURL == ref.RepoURL
+		case kargoapi.FreightMatchArtifactKindFile:
+			found = sub.File != nil && sub.File.RepoURL == ref.RepoURL
+		}
+		if found {
+			return nil
+		}
+	}
+	return field.ErrorList{
+		field.Invalid(
+			f.Child("repoURL"),
+			ref.RepoURL,
+			fmt.Sprintf("no %s subscription found for this repoURL", ref.Kind),
+		),
+	}
+}
+
 func (w *webhook) validateSub(
 	f *field.Path,
 	sub kargoapi.RepoSubscription,
@@ -165,6 +248,14 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
 	}
+	if sub.OCIArtifact != nil {
+		repoTypes++
+		errs = append(errs, w.validateOCIArtifactSub(f.Child("ociArtifact"), *sub.OCIArtifact, seen)...)
+	}
+	if sub.File != nil {
+		repoTypes++
+		errs = append(errs, w.validateFileSub(f.Child("file"), *sub.File, seen)...)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -172,7 +263,9 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					"exactly one of %s.git, %s.image, %s.chart, %s.ociArtifact, or %s.file must be non-empty",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -195,6 +288,22 @@ func (w *webhook) validateGitSub(
 	); err != nil {
 		errs = append(errs, err)
 	}
+	for _, p := range []struct {
+		child    string
+		patterns []string
+	}{
+		{"allowAuthors", sub.AllowAuthors},
+		{"ignoreAuthors", sub.IgnoreAuthors},
+		{"allowCommitters", sub.AllowCommitters},
+		{"ignoreCommitters", sub.IgnoreCommitters},
+		{"ignoreMessages", sub.IgnoreMessages},
+	} {
+		for i, pattern := range p.patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				errs = append(errs, field.Invalid(f.Child(p.child).Index(i), pattern, err.Error()))
+			}
+		}
+	}
 	if err := seen.addGit(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
@@ -218,12 +327,121 @@ func (w *webhook) validateImageSub(
 			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
 		}
 	}
+	if sub.Verification != nil {
+		errs = append(errs, validateImageVerification(f.Child("verification"), *sub.Verification)...)
+	}
+	errs = append(
+		errs,
+		validateImageMetadataMatchExpressions(f.Child("labelMatchExpressions"), sub.LabelMatchExpressions)...,
+	)
+	errs = append(
+		errs,
+		validateImageMetadataMatchExpressions(f.Child("annotationMatchExpressions"), sub.AnnotationMatchExpressions)...,
+	)
 	if err := seen.addImage(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
 	return errs
 }
 
+func validateImageMetadataMatchExpressions(
+	f *field.Path,
+	exprs []kargoapi.ImageMetadataMatchExpression,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, expr := range exprs {
+		exprPath := f.Index(i)
+		switch expr.Operator {
+		case kargoapi.ImageMetadataMatchOperatorExists, kargoapi.ImageMetadataMatchOperatorDoesNotExist:
+			if len(expr.Values) > 0 {
+				errs = append(
+					errs,
+					field.Forbidden(
+						exprPath.Child("values"),
+						fmt.Sprintf("values must be empty for operator %s", expr.Operator),
+					),
+				)
+			}
+		default:
+			if len(expr.Values) == 0 {
+				errs = append(
+					errs,
+					field.Required(
+						exprPath.Child("values"),
+						fmt.Sprintf("values must be non-empty for operator %s", expr.Operator),
+					),
+				)
+			}
+		}
+		if expr.Operator != kargoapi.ImageMetadataMatchOperatorMatches {
+			continue
+		}
+		for j, value := range expr.Values {
+			if _, err := regexp.Compile(value); err != nil {
+				errs = append(errs, field.Invalid(exprPath.Child("values").Index(j), value, err.Error()))
+			}
+		}
+	}
+	return errs
+}
+
+func validateImageVerification(
+	f *field.Path,
+	verification kargoapi.ImageVerification,
+) field.ErrorList {
+	var errs field.ErrorList
+	if verification.PublicKeysSecret == "" && verification.Keyless == nil {
+		errs = append(
+			errs,
+			field.Invalid(
+				f,
+				verification,
+				fmt.Sprintf(
+					"at least one of %s.publicKeysSecret or %s.keyless must be non-empty",
+					f.String(),
+					f.String(),
+				),
+			),
+		)
+	}
+	if verification.Keyless == nil {
+		return errs
+	}
+	identitiesPath := f.Child("keyless", "identities")
+	for i, identity := range verification.Keyless.Identities {
+		identityPath := identitiesPath.Index(i)
+		if identity.Issuer == "" && identity.IssuerRegex == "" {
+			errs = append(
+				errs,
+				field.Required(identityPath, "one of issuer or issuerRegex must be non-empty"),
+			)
+		}
+		if identity.Subject == "" && identity.SubjectRegex == "" {
+			errs = append(
+				errs,
+				field.Required(identityPath, "one of subject or subjectRegex must be non-empty"),
+			)
+		}
+		if identity.IssuerRegex != "" {
+			if _, err := regexp.Compile(identity.IssuerRegex); err != nil {
+				errs = append(
+					errs,
+					field.Invalid(identityPath.Child("issuerRegex"), identity.IssuerRegex, err.Error()),
+				)
+			}
+		}
+		if identity.SubjectRegex != "" {
+			if _, err := regexp.Compile(identity.SubjectRegex); err != nil {
+				errs = append(
+					errs,
+					field.Invalid(identityPath.Child("subjectRegex"), identity.SubjectRegex, err.Error()),
+				)
+			}
+		}
+	}
+	return errs
+}
+
 func (w *webhook) validateChartSub(
 	f *field.Path,
 	sub kargoapi.ChartSubscription,
@@ -263,6 +481,96 @@ func (w *webhook) validateChartSub(
 	return errs
 }
 
+func (w *webhook) validateOCIArtifactSub(
+	f *field.Path,
+	sub kargoapi.OCIArtifactSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	switch sub.SelectionStrategy {
+	case kargoapi.OCIArtifactSelectionStrategyDigest:
+		// With the Digest strategy, the semverConstraint field names the tag to
+		// track instead of holding a constraint.
+		if sub.SemverConstraint == "" {
+			errs = append(
+				errs,
+				field.Required(
+					f.Child("semverConstraint"),
+					"must specify the tag to track when selectionStrategy is Digest",
+				),
+			)
+		}
+	case kargoapi.OCIArtifactSelectionStrategySemVer, "":
+		if err := validateSemverConstraint(
+			f.Child("semverConstraint"),
+			sub.SemverConstraint,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if sub.AllowTags != "" {
+		if _, err := regexp.Compile(sub.AllowTags); err != nil {
+			errs = append(errs, field.Invalid(f.Child("allowTags"), sub.AllowTags, err.Error()))
+		}
+	}
+	if err := seen.addOCIArtifact(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func (w *webhook) validateFileSub(
+	f *field.Path,
+	sub kargoapi.FileSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if sub.SelectionStrategy == kargoapi.FileSelectionStrategySemVer || sub.SelectionStrategy == "" {
+		if err := validateSemverConstraint(
+			f.Child("semverConstraint"),
+			sub.SemverConstraint,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if sub.VersionRegex != "" {
+		if _, err := regexp.Compile(sub.VersionRegex); err != nil {
+			errs = append(errs, field.Invalid(f.Child("versionRegex"), sub.VersionRegex, err.Error()))
+		}
+	}
+	if sub.AllowFiles != "" {
+		if _, err := regexp.Compile(sub.AllowFiles); err != nil {
+			errs = append(errs, field.Invalid(f.Child("allowFiles"), sub.AllowFiles, err.Error()))
+		}
+	}
+	if !strings.HasPrefix(sub.RepoURL, "s3://") {
+		if sub.Endpoint != "" {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("endpoint"),
+					sub.Endpoint,
+					"must be empty unless repoURL starts with s3://",
+				),
+			)
+		}
+		if sub.Region != "" {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("region"),
+					sub.Region,
+					"must be empty unless repoURL starts with s3://",
+				),
+			)
+		}
+	}
+	if err := seen.addFile(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
 func validateSemverConstraint(
 	f *field.Path,
 	semverConstraint string,
@@ -318,3 +626,28 @@ func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *f
 	s[k] = p
 	return nil
 }
+
+func (s uniqueSubSet) addOCIArtifact(sub kargoapi.OCIArtifactSubscription, p *field.Path) error {
+	// As with images, the normalization of Helm chart repository URLs is used
+	// to ensure the uniqueness of the artifact repository reference.
+	k := subscriptionKey{kind: "ociArtifact", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for artifact repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addFile(sub kargoapi.FileSubscription, p *field.Path) error {
+	// The endpoint is part of the key because buckets of the same name may
+	// exist in different S3-compatible object stores.
+	k := subscriptionKey{
+		kind: "file",
+		id:   sub.Endpoint + ":" + strings.TrimSuffix(sub.RepoURL, "/"),
+	}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for files already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
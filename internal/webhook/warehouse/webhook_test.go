@@ -301,7 +301,8 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].image, or spec.subscriptions[0].chart " +
+								"spec.subscriptions[0].image, spec.subscriptions[0].chart, " +
+								"spec.subscriptions[0].ociArtifact, or spec.subscriptions[0].file " +
 								"must be non-empty",
 						},
 						{
@@ -343,6 +344,123 @@ func TestValidateSpec(t *testing.T) {
 	}
 }
 
+func TestValidateFreightMatchRules(t *testing.T) {
+	subs := []kargoapi.RepoSubscription{
+		{Git: &kargoapi.GitSubscription{RepoURL: "https://github.com/example/repo"}},
+		{Image: &kargoapi.ImageSubscription{RepoURL: "example/image"}},
+		{Chart: &kargoapi.ChartSubscription{RepoURL: "https://charts.example.com", Name: "chart"}},
+	}
+	testCases := []struct {
+		name       string
+		rules      []kargoapi.FreightMatchRule
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no rules",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "invalid",
+			rules: []kargoapi.FreightMatchRule{
+				{
+					Source: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindImage,
+						RepoURL: "example/other-image",
+					},
+					Target: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindGit,
+						RepoURL: "https://github.com/example/repo",
+						Name:    "bogus",
+					},
+				},
+				{
+					Source: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindChart,
+						RepoURL: "https://charts.example.com",
+						Name:    "chart",
+					},
+					Target: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindChart,
+						RepoURL: "https://charts.example.com",
+						Name:    "chart",
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "freightMatchRules[0].source.repoURL",
+							BadValue: "example/other-image",
+							Detail:   "no Image subscription found for this repoURL",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "freightMatchRules[0].target.name",
+							BadValue: "bogus",
+							Detail:   "must be empty unless kind is Chart",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "freightMatchRules[1].target",
+							BadValue: "https://charts.example.com",
+							Detail:   "must not reference the same subscription as source",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			rules: []kargoapi.FreightMatchRule{
+				{
+					Source: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindImage,
+						RepoURL: "example/image",
+					},
+					Target: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindGit,
+						RepoURL: "https://github.com/example/repo",
+					},
+				},
+				{
+					Source: kargoapi.FreightMatchArtifact{
+						Kind:    kargoapi.FreightMatchArtifactKindChart,
+						RepoURL: "https://charts.example.com",
+						Name:    "chart",
+					},
+					Target: kargoapi.FreightMatchArtifact{
+						Kind:       kargoapi.FreightMatchArtifactKindImage,
+						RepoURL:    "example/image",
+						TrimPrefix: "v",
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateFreightMatchRules(
+					field.NewPath("freightMatchRules"),
+					testCase.rules,
+					subs,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateSubs(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -400,8 +518,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].image, or " +
-								"subs[0].chart must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].image, " +
+								"subs[0].chart, subs[0].ociArtifact, or subs[0].file must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -456,6 +574,12 @@ func TestValidateSub(t *testing.T) {
 				Chart: &kargoapi.ChartSubscription{
 					SemverConstraint: "bogus",
 				},
+				OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					SemverConstraint: "bogus",
+				},
+				File: &kargoapi.FileSubscription{
+					SemverConstraint: "bogus",
+				},
 			},
 			seen: uniqueSubSet{
 				subscriptionKey{
@@ -464,7 +588,7 @@ func TestValidateSub(t *testing.T) {
 				}: field.NewPath("spec.subscriptions[0].git"),
 			},
 			assertions: func(t *testing.T, sub kargoapi.RepoSubscription, errs field.ErrorList) {
-				require.Len(t, errs, 5)
+				require.Len(t, errs, 7)
 				require.Equal(
 					t,
 					field.ErrorList{
@@ -489,11 +613,22 @@ func TestValidateSub(t *testing.T) {
 							Field:    "sub.chart.semverConstraint",
 							BadValue: "bogus",
 						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "sub.ociArtifact.semverConstraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "sub.file.semverConstraint",
+							BadValue: "bogus",
+						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.image, or sub.chart must be non-empty",
+							Detail: "exactly one of sub.git, sub.image, sub.chart, sub.ociArtifact, " +
+								"or sub.file must be non-empty",
 						},
 					},
 					errs,
@@ -535,6 +670,8 @@ func TestValidateGitSub(t *testing.T) {
 			sub: kargoapi.GitSubscription{
 				RepoURL:          "bogus",
 				SemverConstraint: "bogus",
+				IgnoreAuthors:    []string{"renovate", "("},
+				IgnoreMessages:   []string{"["},
 			},
 			seen: uniqueSubSet{
 				subscriptionKey{
@@ -551,6 +688,18 @@ func TestValidateGitSub(t *testing.T) {
 							Field:    "git.semverConstraint",
 							BadValue: "bogus",
 						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "git.ignoreAuthors[1]",
+							BadValue: "(",
+							Detail:   "error parsing regexp: missing closing ): `(`",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "git.ignoreMessages[0]",
+							BadValue: "[",
+							Detail:   "error parsing regexp: missing closing ]: `[`",
+						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "git",
@@ -655,6 +804,142 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateImageMetadataMatchExpressions(t *testing.T) {
+	errs := validateImageMetadataMatchExpressions(
+		field.NewPath("labelMatchExpressions"),
+		[]kargoapi.ImageMetadataMatchExpression{
+			{
+				Key:      "valid",
+				Operator: kargoapi.ImageMetadataMatchOperatorMatches,
+				Values:   []string{"^https://github.com/example/"},
+			},
+			{
+				Key:      "no-values",
+				Operator: kargoapi.ImageMetadataMatchOperatorIn,
+			},
+			{
+				Key:      "unexpected-values",
+				Operator: kargoapi.ImageMetadataMatchOperatorExists,
+				Values:   []string{"true"},
+			},
+			{
+				Key:      "invalid-regex",
+				Operator: kargoapi.ImageMetadataMatchOperatorMatches,
+				Values:   []string{"^valid$", "("},
+			},
+		},
+	)
+	require.Len(t, errs, 3)
+	require.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	require.Equal(t, "labelMatchExpressions[1].values", errs[0].Field)
+	require.Equal(t, field.ErrorTypeForbidden, errs[1].Type)
+	require.Equal(t, "labelMatchExpressions[2].values", errs[1].Field)
+	require.Equal(t, field.ErrorTypeInvalid, errs[2].Type)
+	require.Equal(t, "labelMatchExpressions[3].values[1]", errs[2].Field)
+}
+
+func TestValidateImageVerification(t *testing.T) {
+	testCases := []struct {
+		name         string
+		verification kargoapi.ImageVerification
+		assertions   func(*testing.T, field.ErrorList)
+	}{
+		{
+			name:         "neither public keys nor keyless",
+			verification: kargoapi.ImageVerification{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "verification", errs[0].Field)
+				require.Equal(
+					t,
+					"at least one of verification.publicKeysSecret or verification.keyless must be non-empty",
+					errs[0].Detail,
+				)
+			},
+		},
+		{
+			name: "both public keys and keyless",
+			verification: kargoapi.ImageVerification{
+				PublicKeysSecret: "fake-keys",
+				Keyless: &kargoapi.KeylessVerification{
+					TrustedRootSecret: "fake-roots",
+					Identities: []kargoapi.KeylessIdentity{{
+						Issuer:  "https://token.example.com",
+						Subject: "bot@example.com",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "invalid keyless identities",
+			verification: kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					TrustedRootSecret: "fake-roots",
+					Identities: []kargoapi.KeylessIdentity{
+						{},
+						{
+							IssuerRegex:  "(",
+							SubjectRegex: "(",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 4)
+				require.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+				require.Equal(t, "verification.keyless.identities[0]", errs[0].Field)
+				require.Equal(t, "one of issuer or issuerRegex must be non-empty", errs[0].Detail)
+				require.Equal(t, field.ErrorTypeRequired, errs[1].Type)
+				require.Equal(t, "verification.keyless.identities[0]", errs[1].Field)
+				require.Equal(t, "one of subject or subjectRegex must be non-empty", errs[1].Detail)
+				require.Equal(t, field.ErrorTypeInvalid, errs[2].Type)
+				require.Equal(t, "verification.keyless.identities[1].issuerRegex", errs[2].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[3].Type)
+				require.Equal(t, "verification.keyless.identities[1].subjectRegex", errs[3].Field)
+			},
+		},
+		{
+			name: "valid public keys",
+			verification: kargoapi.ImageVerification{
+				PublicKeysSecret: "fake-keys",
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "valid keyless",
+			verification: kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					TrustedRootSecret: "fake-roots",
+					Identities: []kargoapi.KeylessIdentity{{
+						Issuer:       "https://token.actions.githubusercontent.com",
+						SubjectRegex: "^https://github.com/example/",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				validateImageVerification(
+					field.NewPath("verification"),
+					testCase.verification,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -792,6 +1077,232 @@ func TestValidateChartSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCIArtifactSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCIArtifactSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid semverConstraint and allowTags",
+			sub: kargoapi.OCIArtifactSubscription{
+				SemverConstraint: "bogus",
+				AllowTags:        "(",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "ociArtifact.semverConstraint", errs[0].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[1].Type)
+				require.Equal(t, "ociArtifact.allowTags", errs[1].Field)
+			},
+		},
+
+		{
+			name: "digest selection strategy without tag",
+			sub: kargoapi.OCIArtifactSubscription{
+				SelectionStrategy: kargoapi.OCIArtifactSelectionStrategyDigest,
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "ociArtifact.semverConstraint",
+							BadValue: "",
+							Detail:   "must specify the tag to track when selectionStrategy is Digest",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "duplicate",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL: "fake-url",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "ociArtifact",
+					id:   "fake-url",
+				}: field.NewPath("spec.subscriptions[0].ociArtifact"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "ociArtifact",
+							BadValue: "fake-url",
+							Detail: "subscription for artifact repository already exists at " +
+								"\"spec.subscriptions[0].ociArtifact\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			sub: kargoapi.OCIArtifactSubscription{
+				SelectionStrategy: kargoapi.OCIArtifactSelectionStrategyDigest,
+				SemverConstraint:  "latest",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateOCIArtifactSub(
+					field.NewPath("ociArtifact"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateFileSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.FileSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid semverConstraint, versionRegex, and allowFiles",
+			sub: kargoapi.FileSubscription{
+				RepoURL:          "s3://fake-bucket/",
+				SemverConstraint: "bogus",
+				VersionRegex:     "(",
+				AllowFiles:       "(",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 3)
+				require.Equal(t, "file.semverConstraint", errs[0].Field)
+				require.Equal(t, "file.versionRegex", errs[1].Field)
+				require.Equal(t, "file.allowFiles", errs[2].Field)
+			},
+		},
+
+		{
+			name: "endpoint and region with HTTP repoURL",
+			sub: kargoapi.FileSubscription{
+				RepoURL:  "https://example.com/files/",
+				Endpoint: "https://minio.example.com",
+				Region:   "eu-west-1",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "file.endpoint",
+							BadValue: "https://minio.example.com",
+							Detail:   "must be empty unless repoURL starts with s3://",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "file.region",
+							BadValue: "eu-west-1",
+							Detail:   "must be empty unless repoURL starts with s3://",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "duplicate",
+			sub: kargoapi.FileSubscription{
+				RepoURL: "s3://fake-bucket/app/",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "file",
+					id:   ":s3://fake-bucket/app",
+				}: field.NewPath("spec.subscriptions[0].file"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "file",
+							BadValue: "s3://fake-bucket/app/",
+							Detail:   "subscription for files already exists at \"spec.subscriptions[0].file\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "same bucket in different object stores",
+			sub: kargoapi.FileSubscription{
+				RepoURL:  "s3://fake-bucket/app/",
+				Endpoint: "https://minio.example.com",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "file",
+					id:   ":s3://fake-bucket/app",
+				}: field.NewPath("spec.subscriptions[0].file"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "valid",
+			sub: kargoapi.FileSubscription{
+				RepoURL:           "https://example.com/files/",
+				SelectionStrategy: kargoapi.FileSelectionStrategyNewestModified,
+				SemverConstraint:  "ignored-by-this-strategy",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateFileSub(
+					field.NewPath("file"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateSemverConstraint(t *testing.T) {
 	testCases := []struct {
 		name             string
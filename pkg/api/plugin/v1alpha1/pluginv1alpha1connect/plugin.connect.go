@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: plugin/v1alpha1/plugin.proto
+
+package pluginv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/plugin/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DirectivePluginServiceName is the fully-qualified name of the DirectivePluginService service.
+	DirectivePluginServiceName = "akuity.io.kargo.plugin.v1alpha1.DirectivePluginService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DirectivePluginServiceGetNameProcedure is the fully-qualified name of the
+	// DirectivePluginService's GetName RPC.
+	DirectivePluginServiceGetNameProcedure = "/akuity.io.kargo.plugin.v1alpha1.DirectivePluginService/GetName"
+	// DirectivePluginServiceRunProcedure is the fully-qualified name of the DirectivePluginService's
+	// Run RPC.
+	DirectivePluginServiceRunProcedure = "/akuity.io.kargo.plugin.v1alpha1.DirectivePluginService/Run"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	directivePluginServiceServiceDescriptor       = v1alpha1.File_plugin_v1alpha1_plugin_proto.Services().ByName("DirectivePluginService")
+	directivePluginServiceGetNameMethodDescriptor = directivePluginServiceServiceDescriptor.Methods().ByName("GetName")
+	directivePluginServiceRunMethodDescriptor     = directivePluginServiceServiceDescriptor.Methods().ByName("Run")
+)
+
+// DirectivePluginServiceClient is a client for the
+// akuity.io.kargo.plugin.v1alpha1.DirectivePluginService service.
+type DirectivePluginServiceClient interface {
+	// GetName returns the name of the directive implemented by the plugin.
+	GetName(context.Context, *connect.Request[v1alpha1.GetNameRequest]) (*connect.Response[v1alpha1.GetNameResponse], error)
+	// Run executes the directive implemented by the plugin using the provided
+	// step context.
+	Run(context.Context, *connect.Request[v1alpha1.RunRequest]) (*connect.Response[v1alpha1.RunResponse], error)
+}
+
+// NewDirectivePluginServiceClient constructs a client for the
+// akuity.io.kargo.plugin.v1alpha1.DirectivePluginService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDirectivePluginServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DirectivePluginServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &directivePluginServiceClient{
+		getName: connect.NewClient[v1alpha1.GetNameRequest, v1alpha1.GetNameResponse](
+			httpClient,
+			baseURL+DirectivePluginServiceGetNameProcedure,
+			connect.WithSchema(directivePluginServiceGetNameMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		run: connect.NewClient[v1alpha1.RunRequest, v1alpha1.RunResponse](
+			httpClient,
+			baseURL+DirectivePluginServiceRunProcedure,
+			connect.WithSchema(directivePluginServiceRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// directivePluginServiceClient implements DirectivePluginServiceClient.
+type directivePluginServiceClient struct {
+	getName *connect.Client[v1alpha1.GetNameRequest, v1alpha1.GetNameResponse]
+	run     *connect.Client[v1alpha1.RunRequest, v1alpha1.RunResponse]
+}
+
+// GetName calls akuity.io.kargo.plugin.v1alpha1.DirectivePluginService.GetName.
+func (c *directivePluginServiceClient) GetName(ctx context.Context, req *connect.Request[v1alpha1.GetNameRequest]) (*connect.Response[v1alpha1.GetNameResponse], error) {
+	return c.getName.CallUnary(ctx, req)
+}
+
+// Run calls akuity.io.kargo.plugin.v1alpha1.DirectivePluginService.Run.
+func (c *directivePluginServiceClient) Run(ctx context.Context, req *connect.Request[v1alpha1.RunRequest]) (*connect.Response[v1alpha1.RunResponse], error) {
+	return c.run.CallUnary(ctx, req)
+}
+
+// DirectivePluginServiceHandler is an implementation of the
+// akuity.io.kargo.plugin.v1alpha1.DirectivePluginService service.
+type DirectivePluginServiceHandler interface {
+	// GetName returns the name of the directive implemented by the plugin.
+	GetName(context.Context, *connect.Request[v1alpha1.GetNameRequest]) (*connect.Response[v1alpha1.GetNameResponse], error)
+	// Run executes the directive implemented by the plugin using the provided
+	// step context.
+	Run(context.Context, *connect.Request[v1alpha1.RunRequest]) (*connect.Response[v1alpha1.RunResponse], error)
+}
+
+// NewDirectivePluginServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDirectivePluginServiceHandler(svc DirectivePluginServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	directivePluginServiceGetNameHandler := connect.NewUnaryHandler(
+		DirectivePluginServiceGetNameProcedure,
+		svc.GetName,
+		connect.WithSchema(directivePluginServiceGetNameMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	directivePluginServiceRunHandler := connect.NewUnaryHandler(
+		DirectivePluginServiceRunProcedure,
+		svc.Run,
+		connect.WithSchema(directivePluginServiceRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/akuity.io.kargo.plugin.v1alpha1.DirectivePluginService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DirectivePluginServiceGetNameProcedure:
+			directivePluginServiceGetNameHandler.ServeHTTP(w, r)
+		case DirectivePluginServiceRunProcedure:
+			directivePluginServiceRunHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDirectivePluginServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDirectivePluginServiceHandler struct{}
+
+func (UnimplementedDirectivePluginServiceHandler) GetName(context.Context, *connect.Request[v1alpha1.GetNameRequest]) (*connect.Response[v1alpha1.GetNameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.plugin.v1alpha1.DirectivePluginService.GetName is not implemented"))
+}
+
+func (UnimplementedDirectivePluginServiceHandler) Run(context.Context, *connect.Request[v1alpha1.RunRequest]) (*connect.Response[v1alpha1.RunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.plugin.v1alpha1.DirectivePluginService.Run is not implemented"))
+}
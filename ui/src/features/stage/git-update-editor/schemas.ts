@@ -11,7 +11,7 @@ export const requestedFreightSchema = z.object({
 });
 
 export const pullRequestMechanismSchema = z.object({
-  type: z.enum(['github', 'gitlab'])
+  type: z.enum(['github', 'gitlab', 'bitbucket'])
 });
 
 export const renderImageUpdateSchema = z.object({
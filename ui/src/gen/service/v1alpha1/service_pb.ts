@@ -2103,6 +2103,16 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
     case: "raw";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * receiver_url is the base URL at which the Project's webhook receiver
+   * accepts push events. The name of the provider sending the events (e.g.
+   * github) must be appended to it as a final path segment. It is empty if the
+   * receiver is not enabled for the Project.
+   *
+   * @generated from field: string receiver_url = 3 [json_name = "receiverURL"];
+   */
+  receiverUrl = "";
+
   constructor(data?: PartialMessage<GetProjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2113,6 +2123,7 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project", kind: "message", T: Project, oneof: "result" },
     { no: 2, name: "raw", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "result" },
+    { no: 3, name: "receiver_url", jsonName: "receiverURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectResponse {
@@ -2523,6 +2534,16 @@ export class PromoteToStageRequest extends Message<PromoteToStageRequest> {
    */
   freightAlias = "";
 
+  /**
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun = false;
+
+  /**
+   * @generated from field: string break_glass = 6;
+   */
+  breakGlass = "";
+
   constructor(data?: PartialMessage<PromoteToStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2535,6 +2556,8 @@ export class PromoteToStageRequest extends Message<PromoteToStageRequest> {
     { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "freight_alias", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "break_glass", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteToStageRequest {
@@ -2615,6 +2638,11 @@ export class PromoteDownstreamRequest extends Message<PromoteDownstreamRequest>
    */
   freightAlias = "";
 
+  /**
+   * @generated from field: string break_glass = 5;
+   */
+  breakGlass = "";
+
   constructor(data?: PartialMessage<PromoteDownstreamRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2627,6 +2655,7 @@ export class PromoteDownstreamRequest extends Message<PromoteDownstreamRequest>
     { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "freight_alias", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "break_glass", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteDownstreamRequest {